@@ -0,0 +1,217 @@
+package anyutil
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// DefaultMaxAnys is the maximum number of Any messages UnpackInterfaces
+	// unpacks when UnpackOptions.MaxAnys is not set.
+	DefaultMaxAnys = 1024
+	// DefaultMaxDepth is the maximum nesting depth of Any messages within
+	// other Any messages when UnpackOptions.MaxDepth is not set.
+	DefaultMaxDepth = 16
+)
+
+var (
+	// ErrMaxAnys is returned when a message contains more Any messages
+	// than allowed by UnpackOptions.MaxAnys.
+	ErrMaxAnys = errors.New("too many Any messages")
+	// ErrMaxDepth is returned when Any messages are nested deeper than
+	// allowed by UnpackOptions.MaxDepth.
+	ErrMaxDepth = errors.New("Any messages nested too deeply")
+	// ErrNotImplemented is returned when an Any contains a message which does
+	// not implement the interface accepted by the field holding it.
+	ErrNotImplemented = errors.New("message does not implement interface")
+)
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// UnpackOptions configures UnpackInterfaces.
+type UnpackOptions struct {
+	// FileResolver and TypeResolver are passed to Unpack for every Any.
+	FileResolver protodesc.Resolver
+	TypeResolver protoregistry.MessageTypeResolver
+
+	// MaxAnys limits the total number of Any messages which can be unpacked,
+	// including nested ones. Defaults to DefaultMaxAnys.
+	MaxAnys int
+
+	// MaxDepth limits how deeply Any messages can be nested inside the
+	// messages packed in other Any messages. Defaults to DefaultMaxDepth.
+	MaxDepth int
+}
+
+// Unpacked is an Any found by UnpackInterfaces along with the message it packs.
+type Unpacked struct {
+	// Path is the field path of the Any relative to the root message,
+	// e.g. "messages[0].msgs[1]".
+	Path string
+	// Interface is the accepts_interface annotation of the field holding
+	// the Any, or empty if the field is not annotated.
+	Interface string
+	Any       *anypb.Any
+	Message   proto.Message
+}
+
+// UnpackError reports the field path of the Any that could not be unpacked.
+type UnpackError struct {
+	Path string
+	Err  error
+}
+
+func (e *UnpackError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e *UnpackError) Unwrap() error {
+	return e.Err
+}
+
+// UnpackInterfaces walks msg and eagerly unpacks every Any found in it,
+// including the Anys found inside the messages packed in other Anys.
+// If the field holding an Any is annotated with accepts_interface,
+// the packed message must list that interface in its implements_interface option.
+// The unpacked messages are returned in traversal order, which visits the
+// fields by number and the map entries by key, so that the result, and the
+// Anys exceeding MaxAnys, are deterministic; on failure
+// an *UnpackError reporting the offending field path is returned.
+func UnpackInterfaces(msg proto.Message, opts UnpackOptions) ([]Unpacked, error) {
+	if opts.MaxAnys <= 0 {
+		opts.MaxAnys = DefaultMaxAnys
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = DefaultMaxDepth
+	}
+	w := &unpackWalker{opts: opts}
+	if err := w.visitMessage(nil, msg.ProtoReflect(), "", 0); err != nil {
+		return nil, err
+	}
+	return w.unpacked, nil
+}
+
+type unpackWalker struct {
+	opts     UnpackOptions
+	unpacked []Unpacked
+}
+
+func (w *unpackWalker) visitMessage(fd protoreflect.FieldDescriptor, m protoreflect.Message, path string, depth int) error {
+	if m.Descriptor().FullName() == anyFullName {
+		return w.unpackAny(fd, m, path, depth)
+	}
+	return w.walk(m, path, depth)
+}
+
+func (w *unpackWalker) walk(m protoreflect.Message, path string, depth int) error {
+	// the fields are visited in field number order and the map entries in key
+	// order, so that the unpacked Anys, and the ones exceeding MaxAnys, do not
+	// depend on the random order of Range
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Number() < fields[j].Number() })
+
+	for _, fd := range fields {
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+				continue
+			}
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if err := w.visitMessage(fd, list.Get(i).Message(), fmt.Sprintf("%s[%d]", fieldPath, i), depth); err != nil {
+					return err
+				}
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				continue
+			}
+			entries := v.Map()
+			var keys []protoreflect.MapKey
+			entries.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			sort.Slice(keys, func(i, j int) bool {
+				return runtime.LessMapKey(keys[i].Interface(), keys[j].Interface())
+			})
+			for _, k := range keys {
+				if err := w.visitMessage(fd, entries.Get(k).Message(), fmt.Sprintf("%s[%v]", fieldPath, k.Interface()), depth); err != nil {
+					return err
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if err := w.visitMessage(fd, v.Message(), fieldPath, depth); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *unpackWalker) unpackAny(fd protoreflect.FieldDescriptor, m protoreflect.Message, path string, depth int) error {
+	if len(w.unpacked) >= w.opts.MaxAnys {
+		return &UnpackError{Path: path, Err: ErrMaxAnys}
+	}
+	if depth >= w.opts.MaxDepth {
+		return &UnpackError{Path: path, Err: ErrMaxDepth}
+	}
+
+	anyMsg, ok := m.Interface().(*anypb.Any)
+	if !ok {
+		// dynamicpb or other non-generated Any implementations
+		fields := m.Descriptor().Fields()
+		anyMsg = &anypb.Any{
+			TypeUrl: m.Get(fields.ByName("type_url")).String(),
+			Value:   m.Get(fields.ByName("value")).Bytes(),
+		}
+	}
+
+	packed, err := Unpack(anyMsg, w.opts.FileResolver, w.opts.TypeResolver)
+	if err != nil {
+		return &UnpackError{Path: path, Err: err}
+	}
+
+	var iface string
+	if fd != nil {
+		iface = proto.GetExtension(fd.Options(), cosmos_proto.E_AcceptsInterface).(string)
+	}
+	if iface != "" && !implementsInterface(packed.ProtoReflect().Descriptor(), iface) {
+		return &UnpackError{Path: path, Err: fmt.Errorf("%w: %s does not implement %s", ErrNotImplemented, packed.ProtoReflect().Descriptor().FullName(), iface)}
+	}
+
+	w.unpacked = append(w.unpacked, Unpacked{
+		Path:      path,
+		Interface: iface,
+		Any:       anyMsg,
+		Message:   packed,
+	})
+	return w.visitMessage(nil, packed.ProtoReflect(), path, depth+1)
+}
+
+func implementsInterface(desc protoreflect.MessageDescriptor, iface string) bool {
+	for _, impl := range proto.GetExtension(desc.Options(), cosmos_proto.E_ImplementsInterface).([]string) {
+		if impl == iface {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package anyutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+)
+
+func mustNew(t *testing.T, msg proto.Message) *anypb.Any {
+	any, err := anyutil.New(msg)
+	require.NoError(t, err)
+	return any
+}
+
+func TestUnpackInterfaces(t *testing.T) {
+	send := &cosmostest.MsgSend{FromAddress: "a", ToAddress: "b", Amount: "10"}
+	exec := &cosmostest.MsgExec{
+		Grantee: "c",
+		Msgs:    []*anypb.Any{mustNew(t, send)},
+	}
+	tx := &cosmostest.Tx{
+		Messages:   []*anypb.Any{mustNew(t, send), mustNew(t, exec)},
+		Extensions: map[string]*anypb.Any{"memo": mustNew(t, &cosmostest.Memo{Text: "hi"})},
+	}
+
+	unpacked, err := anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{})
+	require.NoError(t, err)
+
+	paths := make(map[string]string)
+	for _, u := range unpacked {
+		paths[u.Path] = u.Interface
+	}
+	require.Equal(t, map[string]string{
+		"messages[0]":         "cosmostest.Msg",
+		"messages[1]":         "cosmostest.Msg",
+		"messages[1].msgs[0]": "cosmostest.Msg",
+		"extensions[memo]":    "",
+	}, paths)
+	for _, u := range unpacked {
+		if u.Path == "messages[1].msgs[0]" {
+			require.True(t, proto.Equal(send, u.Message))
+		}
+	}
+
+	// the same thing using dynamicpb for both the root and the packed messages.
+	bz, err := proto.Marshal(tx)
+	require.NoError(t, err)
+	dyn := dynamicpb.NewMessage(tx.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(bz, dyn))
+	dynUnpacked, err := anyutil.UnpackInterfaces(dyn, anyutil.UnpackOptions{
+		FileResolver: protoregistry.GlobalFiles,
+		TypeResolver: &protoregistry.Types{},
+	})
+	require.NoError(t, err)
+	require.Len(t, dynUnpacked, len(unpacked))
+}
+
+func TestUnpackInterfacesNotImplemented(t *testing.T) {
+	exec := &cosmostest.MsgExec{
+		Msgs: []*anypb.Any{
+			mustNew(t, &cosmostest.MsgSend{}),
+			mustNew(t, &cosmostest.Memo{Text: "not a msg"}),
+		},
+	}
+	tx := &cosmostest.Tx{Messages: []*anypb.Any{mustNew(t, exec)}}
+
+	_, err := anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{})
+	require.ErrorIs(t, err, anyutil.ErrNotImplemented)
+	var unpackErr *anyutil.UnpackError
+	require.True(t, errors.As(err, &unpackErr))
+	require.Equal(t, "messages[0].msgs[1]", unpackErr.Path)
+}
+
+func TestUnpackInterfacesUnknownType(t *testing.T) {
+	tx := &cosmostest.Tx{Messages: []*anypb.Any{{TypeUrl: "/cosmostest.Unknown"}}}
+
+	_, err := anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{})
+	var unpackErr *anyutil.UnpackError
+	require.True(t, errors.As(err, &unpackErr))
+	require.Equal(t, "messages[0]", unpackErr.Path)
+}
+
+func TestUnpackInterfacesLimits(t *testing.T) {
+	nested := mustNew(t, &cosmostest.MsgSend{})
+	for i := 0; i < 4; i++ {
+		nested = mustNew(t, &cosmostest.MsgExec{Msgs: []*anypb.Any{nested}})
+	}
+	tx := &cosmostest.Tx{Messages: []*anypb.Any{nested, mustNew(t, &cosmostest.MsgSend{})}}
+
+	unpacked, err := anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{})
+	require.NoError(t, err)
+	require.Len(t, unpacked, 6)
+
+	_, err = anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{MaxDepth: 4})
+	require.ErrorIs(t, err, anyutil.ErrMaxDepth)
+	var unpackErr *anyutil.UnpackError
+	require.True(t, errors.As(err, &unpackErr))
+	require.Equal(t, "messages[0].msgs[0].msgs[0].msgs[0].msgs[0]", unpackErr.Path)
+
+	_, err = anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{MaxAnys: 5})
+	require.ErrorIs(t, err, anyutil.ErrMaxAnys)
+	require.True(t, errors.As(err, &unpackErr))
+	require.Equal(t, "messages[1]", unpackErr.Path)
+}
+
+func TestUnpackInterfacesOrder(t *testing.T) {
+	memo := mustNew(t, &cosmostest.Memo{Text: "memo"})
+	tx := &cosmostest.Tx{
+		Messages: []*anypb.Any{
+			mustNew(t, &cosmostest.MsgSend{}),
+			mustNew(t, &cosmostest.MsgExec{Msgs: []*anypb.Any{mustNew(t, &cosmostest.MsgSend{})}}),
+		},
+		Extensions: map[string]*anypb.Any{"c": memo, "a": memo, "b": memo},
+	}
+
+	// the fields are visited by number and the map entries by key, on every run
+	for i := 0; i < 10; i++ {
+		unpacked, err := anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{})
+		require.NoError(t, err)
+		var paths []string
+		for _, u := range unpacked {
+			paths = append(paths, u.Path)
+		}
+		require.Equal(t, []string{"messages[0]", "messages[1]", "messages[1].msgs[0]", "extensions[a]", "extensions[b]", "extensions[c]"}, paths)
+
+		_, err = anyutil.UnpackInterfaces(tx, anyutil.UnpackOptions{MaxAnys: 4})
+		var unpackErr *anyutil.UnpackError
+		require.True(t, errors.As(err, &unpackErr))
+		require.Equal(t, "extensions[b]", unpackErr.Path)
+	}
+}
@@ -0,0 +1,415 @@
+package breaking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/declaration"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Category groups the rules by the kind of compatibility they protect.
+type Category string
+
+const (
+	// CategoryWire changes break the decoding of previously encoded messages.
+	CategoryWire Category = "WIRE"
+	// CategoryCosmos changes break the contracts expressed by cosmos_proto annotations.
+	CategoryCosmos Category = "COSMOS"
+	// CategoryAPI changes keep the wire encoding, but break the JSON encoding or
+	// the generated code.
+	CategoryAPI Category = "API"
+)
+
+// Rule identifies the kind of a breaking Change.
+type Rule string
+
+const (
+	RuleMessageRemoved          Rule = "MESSAGE_REMOVED"
+	RuleFieldRemoved            Rule = "FIELD_REMOVED"
+	RuleFieldNumberChanged      Rule = "FIELD_NUMBER_CHANGED"
+	RuleFieldTypeChanged        Rule = "FIELD_TYPE_CHANGED"
+	RuleFieldCardinalityChanged Rule = "FIELD_CARDINALITY_CHANGED"
+	RuleFieldOneofChanged       Rule = "FIELD_ONEOF_CHANGED"
+	RuleEnumRemoved             Rule = "ENUM_REMOVED"
+	RuleEnumValueRemoved        Rule = "ENUM_VALUE_REMOVED"
+	RuleExtensionRemoved        Rule = "EXTENSION_REMOVED"
+	RuleExtendeeChanged         Rule = "EXTENDEE_CHANGED"
+
+	RuleFieldNameChanged     Rule = "FIELD_NAME_CHANGED"
+	RuleFieldJSONNameChanged Rule = "FIELD_JSON_NAME_CHANGED"
+	RuleFieldPresenceChanged Rule = "FIELD_PRESENCE_CHANGED"
+
+	RuleInterfaceRemoved        Rule = "INTERFACE_REMOVED"
+	RuleInterfaceNotImplemented Rule = "INTERFACE_NOT_IMPLEMENTED"
+	RuleAcceptsInterfaceChanged Rule = "ACCEPTS_INTERFACE_CHANGED"
+	RuleScalarRemoved           Rule = "SCALAR_REMOVED"
+	RuleScalarFieldTypeChanged  Rule = "SCALAR_FIELD_TYPE_CHANGED"
+	RuleFieldScalarChanged      Rule = "FIELD_SCALAR_CHANGED"
+)
+
+var ruleCategories = map[Rule]Category{
+	RuleMessageRemoved:          CategoryWire,
+	RuleFieldRemoved:            CategoryWire,
+	RuleFieldNumberChanged:      CategoryWire,
+	RuleFieldTypeChanged:        CategoryWire,
+	RuleFieldCardinalityChanged: CategoryWire,
+	RuleFieldOneofChanged:       CategoryWire,
+	RuleEnumRemoved:             CategoryWire,
+	RuleEnumValueRemoved:        CategoryWire,
+	RuleExtensionRemoved:        CategoryWire,
+	RuleExtendeeChanged:         CategoryWire,
+	RuleFieldNameChanged:        CategoryAPI,
+	RuleFieldJSONNameChanged:    CategoryAPI,
+	RuleFieldPresenceChanged:    CategoryAPI,
+	RuleInterfaceRemoved:        CategoryCosmos,
+	RuleInterfaceNotImplemented: CategoryCosmos,
+	RuleAcceptsInterfaceChanged: CategoryCosmos,
+	RuleScalarRemoved:           CategoryCosmos,
+	RuleScalarFieldTypeChanged:  CategoryCosmos,
+	RuleFieldScalarChanged:      CategoryCosmos,
+}
+
+// Change is a breaking change found by Compare.
+type Change struct {
+	Rule     Rule     `json:"rule"`
+	Category Category `json:"category"`
+	// File is the path of the file declaring the element in the previous version.
+	File string `json:"file"`
+	// Element is the fully-qualified name of the changed message, field, extension,
+	// enum, enum value, interface or scalar.
+	Element string `json:"element"`
+	Message string `json:"message"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", c.File, c.Element, c.Message, c.Rule)
+}
+
+// CompareFileDescriptorSets compares the previous and current versions of a set of files.
+func CompareFileDescriptorSets(previous, current *descriptorpb.FileDescriptorSet) ([]Change, error) {
+	prevFiles, err := protodesc.NewFiles(previous)
+	if err != nil {
+		return nil, fmt.Errorf("previous: %w", err)
+	}
+	curFiles, err := protodesc.NewFiles(current)
+	if err != nil {
+		return nil, fmt.Errorf("current: %w", err)
+	}
+	return Compare(prevFiles, curFiles), nil
+}
+
+// Compare returns the breaking changes between the previous and current versions
+// of a set of files. Elements are matched by fully-qualified name, so moving them
+// between files is not a breaking change. The changes are sorted by file and element.
+func Compare(previous, current *protoregistry.Files) []Change {
+	prev, cur := index(previous), index(current)
+	c := &comparer{prev: prev, cur: cur}
+
+	for _, name := range sortedKeys(prev.interfaces) {
+		if _, ok := cur.interfaces[name]; !ok {
+			c.report(RuleInterfaceRemoved, prev.interfaces[name], name, "interface declaration was removed")
+		}
+	}
+	for _, name := range sortedKeys(prev.scalars) {
+		prevDecl := prev.scalars[name]
+		curDecl, ok := cur.scalars[name]
+		if !ok {
+			c.report(RuleScalarRemoved, prevDecl.file, name, "scalar declaration was removed")
+			continue
+		}
+		if !sameScalarTypes(prevDecl.desc.FieldType, curDecl.desc.FieldType) {
+			c.report(RuleScalarFieldTypeChanged, prevDecl.file, name, "scalar field_type changed from %s to %s",
+				scalarTypes(prevDecl.desc.FieldType), scalarTypes(curDecl.desc.FieldType))
+		}
+	}
+	for _, name := range sortedKeys(prev.messages) {
+		prevMsg := prev.messages[name]
+		curMsg, ok := cur.messages[name]
+		if !ok {
+			c.report(RuleMessageRemoved, prevMsg.ParentFile(), name, "message was removed")
+			continue
+		}
+		c.compareMessage(prevMsg, curMsg)
+	}
+	for _, name := range sortedKeys(prev.enums) {
+		prevEnum := prev.enums[name]
+		curEnum, ok := cur.enums[name]
+		if !ok {
+			c.report(RuleEnumRemoved, prevEnum.ParentFile(), name, "enum was removed")
+			continue
+		}
+		c.compareEnum(prevEnum, curEnum)
+	}
+	for _, name := range sortedKeys(prev.extensions) {
+		prevExt := prev.extensions[name]
+		curExt, ok := cur.extensions[name]
+		if !ok {
+			c.report(RuleExtensionRemoved, prevExt.ParentFile(), name, "extension %d of %s was removed", prevExt.Number(), prevExt.ContainingMessage().FullName())
+			continue
+		}
+		c.compareExtension(prevExt, curExt)
+	}
+
+	sort.SliceStable(c.changes, func(i, j int) bool {
+		a, b := c.changes[i], c.changes[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Element < b.Element
+	})
+	return c.changes
+}
+
+type scalarDecl struct {
+	file protoreflect.FileDescriptor
+	desc *cosmos_proto.ScalarDescriptor
+}
+
+type descriptors struct {
+	messages   map[string]protoreflect.MessageDescriptor
+	enums      map[string]protoreflect.EnumDescriptor
+	extensions map[string]protoreflect.ExtensionDescriptor
+	interfaces map[string]protoreflect.FileDescriptor
+	scalars    map[string]scalarDecl
+}
+
+func index(files *protoregistry.Files) descriptors {
+	d := descriptors{
+		messages:   make(map[string]protoreflect.MessageDescriptor),
+		enums:      make(map[string]protoreflect.EnumDescriptor),
+		extensions: make(map[string]protoreflect.ExtensionDescriptor),
+		interfaces: make(map[string]protoreflect.FileDescriptor),
+		scalars:    make(map[string]scalarDecl),
+	}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for _, decl := range declaration.Interfaces(fd) {
+			d.interfaces[declaration.FullName(fd, decl.Name)] = fd
+		}
+		for _, decl := range declaration.Scalars(fd) {
+			d.scalars[declaration.FullName(fd, decl.Name)] = scalarDecl{file: fd, desc: decl}
+		}
+		d.addEnums(fd.Enums())
+		d.addExtensions(fd.Extensions())
+		d.addMessages(fd.Messages())
+		return true
+	})
+	return d
+}
+
+func (d descriptors) addMessages(msgs protoreflect.MessageDescriptors) {
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		d.messages[string(md.FullName())] = md
+		d.addEnums(md.Enums())
+		d.addExtensions(md.Extensions())
+		d.addMessages(md.Messages())
+	}
+}
+
+func (d descriptors) addExtensions(exts protoreflect.ExtensionDescriptors) {
+	for i := 0; i < exts.Len(); i++ {
+		d.extensions[string(exts.Get(i).FullName())] = exts.Get(i)
+	}
+}
+
+func (d descriptors) addEnums(enums protoreflect.EnumDescriptors) {
+	for i := 0; i < enums.Len(); i++ {
+		d.enums[string(enums.Get(i).FullName())] = enums.Get(i)
+	}
+}
+
+type comparer struct {
+	prev, cur descriptors
+	changes   []Change
+}
+
+func (c *comparer) report(rule Rule, file protoreflect.FileDescriptor, element string, format string, args ...interface{}) {
+	c.changes = append(c.changes, Change{
+		Rule:     rule,
+		Category: ruleCategories[rule],
+		File:     file.Path(),
+		Element:  element,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *comparer) compareMessage(prev, cur protoreflect.MessageDescriptor) {
+	file := prev.ParentFile()
+	curImpls := implementedInterfaces(cur)
+	for _, iface := range implementedInterfaces(prev) {
+		if !contains(curImpls, iface) {
+			c.report(RuleInterfaceNotImplemented, file, string(prev.FullName()), "message no longer implements %s", iface)
+		}
+	}
+
+	prevFields, curFields := prev.Fields(), cur.Fields()
+	for i := 0; i < prevFields.Len(); i++ {
+		prevField := prevFields.Get(i)
+		curField := curFields.ByNumber(prevField.Number())
+		name := string(prevField.FullName())
+		if curField == nil {
+			if renamed := curFields.ByName(prevField.Name()); renamed != nil {
+				c.report(RuleFieldNumberChanged, file, name, "field number changed from %d to %d", prevField.Number(), renamed.Number())
+			} else if !cur.ReservedRanges().Has(prevField.Number()) {
+				c.report(RuleFieldRemoved, file, name, "field %d was removed without reserving its number", prevField.Number())
+			}
+			continue
+		}
+
+		if prevField.Name() != curField.Name() {
+			c.report(RuleFieldNameChanged, file, name, "field %d was renamed to %s", prevField.Number(), curField.Name())
+		} else if prevField.JSONName() != curField.JSONName() {
+			c.report(RuleFieldJSONNameChanged, file, name, "JSON name changed from %s to %s", prevField.JSONName(), curField.JSONName())
+		}
+		if prevOneof, curOneof := oneofName(prevField), oneofName(curField); prevOneof != curOneof {
+			c.report(RuleFieldOneofChanged, file, name, "field moved from %s to %s", oneofPlace(prevOneof), oneofPlace(curOneof))
+		} else if prevField.HasPresence() != curField.HasPresence() && !prevField.IsList() && !curField.IsList() {
+			c.report(RuleFieldPresenceChanged, file, name, "field presence changed from %s to %s", presence(prevField), presence(curField))
+		}
+		c.compareField(file, name, prevField, curField)
+	}
+}
+
+// compareExtension compares the extensions with the same full name.
+func (c *comparer) compareExtension(prev, cur protoreflect.ExtensionDescriptor) {
+	file, name := prev.ParentFile(), string(prev.FullName())
+	if prev.ContainingMessage().FullName() != cur.ContainingMessage().FullName() {
+		c.report(RuleExtendeeChanged, file, name, "extended message changed from %s to %s", prev.ContainingMessage().FullName(), cur.ContainingMessage().FullName())
+	}
+	if prev.Number() != cur.Number() {
+		c.report(RuleFieldNumberChanged, file, name, "field number changed from %d to %d", prev.Number(), cur.Number())
+	}
+	c.compareField(file, name, prev, cur)
+}
+
+// compareField compares the types and annotations of the fields or extensions
+// prev and cur, which are the same element.
+func (c *comparer) compareField(file protoreflect.FileDescriptor, name string, prev, cur protoreflect.FieldDescriptor) {
+	if prev.IsList() != cur.IsList() {
+		c.report(RuleFieldCardinalityChanged, file, name, "field cardinality changed from %s to %s", cardinality(prev), cardinality(cur))
+	} else if prevType, curType := fieldType(prev), fieldType(cur); prevType != curType {
+		c.report(RuleFieldTypeChanged, file, name, "field type changed from %s to %s", prevType, curType)
+	}
+
+	prevIface := proto.GetExtension(prev.Options(), cosmos_proto.E_AcceptsInterface).(string)
+	curIface := proto.GetExtension(cur.Options(), cosmos_proto.E_AcceptsInterface).(string)
+	if prevIface != curIface {
+		c.report(RuleAcceptsInterfaceChanged, file, name, "accepts_interface changed from %s to %s", orNone(prevIface), orNone(curIface))
+	}
+
+	prevScalar := proto.GetExtension(prev.Options(), cosmos_proto.E_Scalar).(string)
+	curScalar := proto.GetExtension(cur.Options(), cosmos_proto.E_Scalar).(string)
+	if prevScalar != curScalar {
+		c.report(RuleFieldScalarChanged, file, name, "scalar changed from %s to %s", orNone(prevScalar), orNone(curScalar))
+	}
+}
+
+func (c *comparer) compareEnum(prev, cur protoreflect.EnumDescriptor) {
+	prevValues, curValues := prev.Values(), cur.Values()
+	for i := 0; i < prevValues.Len(); i++ {
+		v := prevValues.Get(i)
+		if curValues.ByNumber(v.Number()) == nil && !cur.ReservedRanges().Has(v.Number()) {
+			c.report(RuleEnumValueRemoved, prev.ParentFile(), string(v.FullName()), "enum value %d was removed without reserving its number", v.Number())
+		}
+	}
+}
+
+func implementedInterfaces(md protoreflect.MessageDescriptor) []string {
+	return proto.GetExtension(md.Options(), cosmos_proto.E_ImplementsInterface).([]string)
+}
+
+func cardinality(fd protoreflect.FieldDescriptor) string {
+	if fd.IsList() {
+		return "repeated"
+	}
+	return "singular"
+}
+
+// oneofName returns the name of the oneof of fd, or an empty string if fd is
+// not a member of a oneof or is a proto3 optional field, whose synthetic oneof
+// is not declared in the schema.
+func oneofName(fd protoreflect.FieldDescriptor) string {
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return string(oneof.Name())
+	}
+	return ""
+}
+
+func oneofPlace(name string) string {
+	if name == "" {
+		return "outside of oneofs"
+	}
+	return "oneof " + name
+}
+
+func presence(fd protoreflect.FieldDescriptor) string {
+	if fd.HasPresence() {
+		return "explicit"
+	}
+	return "implicit"
+}
+
+// fieldType returns a description of the type of fd which changes whenever
+// its encoding changes, e.g. "int64", "message a.b.C" or "map<string, bytes>".
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", fieldType(fd.MapKey()), fieldType(fd.MapValue()))
+	}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return fmt.Sprintf("%s %s", fd.Kind(), fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return fmt.Sprintf("%s %s", fd.Kind(), fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+func sameScalarTypes(a, b []cosmos_proto.ScalarType) bool {
+	return scalarTypes(a) == scalarTypes(b)
+}
+
+// scalarTypes returns a canonical description of the field types of a scalar.
+func scalarTypes(types []cosmos_proto.ScalarType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	sort.Strings(names)
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,76 @@
+package breaking
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testdata/old.binpb and testdata/new.binpb are generated from the files in
+// testdata/old and testdata/new with:
+//
+//	protoc -I breaking/testdata/old -I proto --include_imports -o breaking/testdata/old.binpb \
+//	  a/b/interfaces.proto a/b/scalars.proto a/b/types.proto
+func loadSet(t *testing.T, name string) *descriptorpb.FileDescriptorSet {
+	bz, err := os.ReadFile(name)
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(bz, set))
+	return set
+}
+
+func TestCompare(t *testing.T) {
+	changes, err := CompareFileDescriptorSets(loadSet(t, "testdata/old.binpb"), loadSet(t, "testdata/new.binpb"))
+	require.NoError(t, err)
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+	require.Equal(t, []string{
+		"a/b/interfaces.proto: a.b.Authorization: interface declaration was removed (INTERFACE_REMOVED)",
+		"a/b/scalars.proto: a.b.Hash: scalar field_type changed from [SCALAR_TYPE_STRING] to [SCALAR_TYPE_BYTES] (SCALAR_FIELD_TYPE_CHANGED)",
+		"a/b/types.proto: a.b.Fields.gains_presence: field presence changed from implicit to explicit (FIELD_PRESENCE_CHANGED)",
+		"a/b/types.proto: a.b.Fields.into_oneof: field moved from outside of oneofs to oneof choice (FIELD_ONEOF_CHANGED)",
+		"a/b/types.proto: a.b.Fields.json: JSON name changed from jsonName to otherName (FIELD_JSON_NAME_CHANGED)",
+		"a/b/types.proto: a.b.Fields.loses_presence: field presence changed from explicit to implicit (FIELD_PRESENCE_CHANGED)",
+		"a/b/types.proto: a.b.Fields.out_of_oneof: field moved from oneof choice to outside of oneofs (FIELD_ONEOF_CHANGED)",
+		"a/b/types.proto: a.b.Fields.renamed: field 6 was renamed to new_name (FIELD_NAME_CHANGED)",
+		"a/b/types.proto: a.b.MsgExec.grant: accepts_interface changed from a.b.Authorization to none (ACCEPTS_INTERFACE_CHANGED)",
+		"a/b/types.proto: a.b.MsgSend: message no longer implements a.b.Msg (INTERFACE_NOT_IMPLEMENTED)",
+		"a/b/types.proto: a.b.MsgSend.amount: scalar changed from a.b.Int to a.b.Dec (FIELD_SCALAR_CHANGED)",
+		"a/b/types.proto: a.b.MsgSend.memos: field cardinality changed from repeated to singular (FIELD_CARDINALITY_CHANGED)",
+		"a/b/types.proto: a.b.MsgSend.note: field 6 was removed without reserving its number (FIELD_REMOVED)",
+		"a/b/types.proto: a.b.MsgSend.renumbered: field number changed from 8 to 9 (FIELD_NUMBER_CHANGED)",
+		"a/b/types.proto: a.b.MsgSend.sequence: field type changed from uint64 to int64 (FIELD_TYPE_CHANGED)",
+		"a/b/types.proto: a.b.Removed: message was removed (MESSAGE_REMOVED)",
+		"a/b/types.proto: a.b.STATUS_DELETED: enum value 3 was removed without reserving its number (ENUM_VALUE_REMOVED)",
+		"a/b/types.proto: a.b.moved_option: extended message changed from google.protobuf.MessageOptions to google.protobuf.FieldOptions (EXTENDEE_CHANGED)",
+		"a/b/types.proto: a.b.removed_option: extension 50001 of google.protobuf.MessageOptions was removed (EXTENSION_REMOVED)",
+		"a/b/types.proto: a.b.renumbered_option: field number changed from 50004 to 50005 (FIELD_NUMBER_CHANGED)",
+		"a/b/types.proto: a.b.retyped_option: field type changed from string to bytes (FIELD_TYPE_CHANGED)",
+	}, got)
+
+	bz, err := json.Marshal(changes[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"rule": "INTERFACE_REMOVED",
+		"category": "COSMOS",
+		"file": "a/b/interfaces.proto",
+		"element": "a.b.Authorization",
+		"message": "interface declaration was removed"
+	}`, string(bz))
+	require.Equal(t, CategoryWire, changes[len(changes)-1].Category)
+	require.Equal(t, CategoryAPI, changes[2].Category)
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	// identical sets have no breaking changes
+	changes, err := CompareFileDescriptorSets(loadSet(t, "testdata/new.binpb"), loadSet(t, "testdata/new.binpb"))
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
@@ -0,0 +1,15 @@
+/*
+Package breaking detects breaking changes between two versions of a set of
+protobuf files.
+
+Besides wire-level changes, such as fields or extensions changing number, type
+or oneof or being removed without reserving their number, it reports the changes
+to cosmos_proto annotations which break clients: messages no longer implementing
+an interface, fields changing their accepts_interface or scalar, and interface
+and scalar declarations being removed or changing their field_type.
+
+The changes keeping the wire encoding but breaking the JSON encoding or the
+generated code, fields being renamed, changing their JSON name or gaining or
+losing presence with proto3 optional, are reported in the API category.
+*/
+package breaking
@@ -0,0 +1,69 @@
+// Command cosmos-proto-breaking reports the breaking changes between two
+// FileDescriptorSets, built with protoc --include_imports -o:
+//
+//	cosmos-proto-breaking -against=previous.binpb [-json] current.binpb
+//
+// It exits with status 1 when breaking changes are found.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/cosmos/cosmos-proto/breaking"
+)
+
+func main() {
+	var (
+		against    string
+		jsonOutput bool
+	)
+	flag.StringVar(&against, "against", "", "FileDescriptorSet of the previous version")
+	flag.BoolVar(&jsonOutput, "json", false, "print the changes as a JSON array")
+	flag.Parse()
+	if against == "" || flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	changes, err := breaking.CompareFileDescriptorSets(readSet(against), readSet(flag.Arg(0)))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if jsonOutput {
+		if changes == nil {
+			changes = []breaking.Change{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(changes); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		for _, c := range changes {
+			fmt.Println(c)
+		}
+	}
+	if len(changes) > 0 {
+		os.Exit(1)
+	}
+}
+
+func readSet(path string) *descriptorpb.FileDescriptorSet {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(bz, set); err != nil {
+		log.Fatalf("unmarshaling %s: %v", path, err)
+	}
+	return set
+}
@@ -0,0 +1,106 @@
+// Command protoc-gen-cosmos-jsonschema is a protoc plugin generating, for every file
+// to generate, the schemas of the protobuf JSON mapping of its messages and of the
+// messages they reference, honoring cosmos_proto scalars and interfaces:
+//
+//	protoc --cosmos-jsonschema_out=. -I . NAME_OF_FILE.proto
+//	protoc --cosmos-jsonschema_out=. --cosmos-jsonschema_opt=format=openapi,version=1.0.0 -I . NAME_OF_FILE.proto
+//
+// JSON Schema documents are written to NAME_OF_FILE.schema.json and OpenAPI 3.1
+// documents to NAME_OF_FILE.openapi.json.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/cosmos/cosmos-proto/jsonschema"
+)
+
+func main() {
+	if err := run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", filepath.Base(os.Args[0]), err)
+		os.Exit(1)
+	}
+}
+
+func run(in io.Reader, out io.Writer) error {
+	bz, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(bz, req); err != nil {
+		return err
+	}
+
+	resp, err := generate(req)
+	if err != nil {
+		resp = &pluginpb.CodeGeneratorResponse{Error: proto.String(err.Error())}
+	}
+	// proto3 optional fields are mapped like the other fields
+	resp.SupportedFeatures = proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL))
+	bz, err = proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(bz)
+	return err
+}
+
+func generate(req *pluginpb.CodeGeneratorRequest) (*pluginpb.CodeGeneratorResponse, error) {
+	format, ext, version := jsonschema.FormatJSONSchema, ".schema.json", "0.0.0"
+	for _, param := range strings.Split(req.GetParameter(), ",") {
+		switch {
+		case param == "", param == "format=jsonschema":
+		case param == "format=openapi":
+			format, ext = jsonschema.FormatOpenAPI, ".openapi.json"
+		case strings.HasPrefix(param, "version="):
+			version = strings.TrimPrefix(param, "version=")
+		default:
+			return nil, fmt.Errorf("unknown parameter %q", param)
+		}
+	}
+
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: req.ProtoFile})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pluginpb.CodeGeneratorResponse{}
+	for _, path := range req.FileToGenerate {
+		fd, err := files.FindFileByPath(path)
+		if err != nil {
+			return nil, err
+		}
+		g := jsonschema.NewGenerator(files, format)
+		msgs := fd.Messages()
+		for i := 0; i < msgs.Len(); i++ {
+			g.Add(msgs.Get(i))
+		}
+
+		var doc interface{}
+		if format == jsonschema.FormatOpenAPI {
+			doc = g.OpenAPIDocument(string(fd.Package()), version)
+		} else {
+			doc = g.JSONSchemaDocument()
+		}
+		content, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
+			Name:    proto.String(strings.TrimSuffix(path, ".proto") + ext),
+			Content: proto.String(string(content) + "\n"),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/cosmos/cosmos-proto/jsonschema"
+)
+
+func runRequest(t *testing.T, param string, files ...string) *pluginpb.CodeGeneratorResponse {
+	bz, err := os.ReadFile("../protoc-gen-go-pulsar/testdata/testprotos.binpb")
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(bz, set))
+	req := &pluginpb.CodeGeneratorRequest{FileToGenerate: files, Parameter: proto.String(param), ProtoFile: set.File}
+	bz, err = proto.Marshal(req)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, run(bytes.NewReader(bz), &out))
+	resp := &pluginpb.CodeGeneratorResponse{}
+	require.NoError(t, proto.Unmarshal(out.Bytes(), resp))
+	return resp
+}
+
+func TestRun(t *testing.T) {
+	// bank.proto has a proto3 optional field, which protoc only sends to the
+	// plugins supporting them
+	resp := runRequest(t, "", "internal/testprotos/cosmostest/bank.proto")
+	require.Empty(t, resp.GetError())
+	require.Equal(t, uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL), resp.GetSupportedFeatures())
+	require.Len(t, resp.File, 1)
+	require.Equal(t, "internal/testprotos/cosmostest/bank.schema.json", resp.File[0].GetName())
+
+	doc := &jsonschema.Schema{}
+	require.NoError(t, json.Unmarshal([]byte(resp.File[0].GetContent()), doc))
+	minted := doc.Defs["cosmostest.Supply"].Properties["minted"]
+	require.Equal(t, "string", minted.Type)
+	require.Equal(t, "cosmostest.Int", minted.Format)
+
+	resp = runRequest(t, "format=unknown", "internal/testprotos/cosmostest/bank.proto")
+	require.Equal(t, `unknown parameter "format=unknown"`, resp.GetError())
+	require.Equal(t, uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL), resp.GetSupportedFeatures())
+}
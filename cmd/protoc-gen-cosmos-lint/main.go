@@ -0,0 +1,113 @@
+// Command protoc-gen-cosmos-lint checks the cosmos_proto annotations of protobuf files.
+//
+// It runs as a protoc plugin, failing the generation of the files to generate which
+// break the conventions checked by the lint package:
+//
+//	protoc --cosmos-lint_out=. -I . NAME_OF_FILE.proto
+//
+// or standalone on a FileDescriptorSet, built with --include_imports and
+// --include_source_info to report source locations, exiting with status 1 on issues:
+//
+//	protoc-gen-cosmos-lint -descriptor_set_in=set.binpb [NAME_OF_FILE.proto...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/cosmos/cosmos-proto/lint"
+)
+
+func main() {
+	if len(os.Args) > 1 {
+		runStandalone()
+		return
+	}
+
+	if err := runPlugin(); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", filepath.Base(os.Args[0]), err)
+		os.Exit(1)
+	}
+}
+
+// runPlugin handles the CodeGeneratorRequest directly instead of using protogen,
+// which requires a Go package for every file.
+func runPlugin() error {
+	in, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(in, req); err != nil {
+		return err
+	}
+
+	out, err := proto.Marshal(lintRequest(req))
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// lintRequest lints the files to generate of req, the issues being reported as
+// the error of the response.
+func lintRequest(req *pluginpb.CodeGeneratorRequest) *pluginpb.CodeGeneratorResponse {
+	resp := &pluginpb.CodeGeneratorResponse{
+		// proto3 optional fields are linted like the other fields
+		SupportedFeatures: proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)),
+	}
+	set := &descriptorpb.FileDescriptorSet{File: req.ProtoFile}
+	issues, err := lint.LintFileDescriptorSet(set, req.FileToGenerate...)
+	switch {
+	case err != nil:
+		resp.Error = proto.String(err.Error())
+	case len(issues) > 0:
+		resp.Error = proto.String(formatIssues(issues))
+	}
+	return resp
+}
+
+func runStandalone() {
+	var descriptorSetIn string
+	flag.StringVar(&descriptorSetIn, "descriptor_set_in", "", "FileDescriptorSet to lint, all its files are linted unless file paths are given as arguments")
+	flag.Parse()
+	if descriptorSetIn == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	bz, err := os.ReadFile(descriptorSetIn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(bz, set); err != nil {
+		log.Fatalf("unmarshaling %s: %v", descriptorSetIn, err)
+	}
+	issues, err := lint.LintFileDescriptorSet(set, flag.Args()...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(issues) > 0 {
+		fmt.Fprintln(os.Stderr, formatIssues(issues))
+		os.Exit(1)
+	}
+}
+
+func formatIssues(issues []lint.Issue) string {
+	lines := make([]string, len(issues))
+	for i, issue := range issues {
+		lines[i] = issue.String()
+	}
+	return strings.Join(lines, "\n")
+}
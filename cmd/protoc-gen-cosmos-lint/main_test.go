@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func loadRequest(t *testing.T, files ...string) *pluginpb.CodeGeneratorRequest {
+	bz, err := os.ReadFile("../../lint/testdata/lint.binpb")
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(bz, set))
+	return &pluginpb.CodeGeneratorRequest{FileToGenerate: files, ProtoFile: set.File}
+}
+
+func TestLintRequest(t *testing.T) {
+	// a/b/types.proto has proto3 optional fields, which protoc only sends to the
+	// plugins supporting them
+	resp := lintRequest(loadRequest(t, "a/b/types.proto"))
+	require.Equal(t, uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL), resp.GetSupportedFeatures())
+	require.Contains(t, resp.GetError(), "a/b/types.proto:34:38: scalar a.b.Hash is used on a.b.MsgInvalid.optional_hash of type string, expected bytes (SCALAR_TYPE_MISMATCH)")
+	require.NotContains(t, resp.GetError(), "MsgValid")
+
+	resp = lintRequest(loadRequest(t, "c/types.proto"))
+	require.Equal(t, uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL), resp.GetSupportedFeatures())
+	require.Empty(t, resp.GetError())
+}
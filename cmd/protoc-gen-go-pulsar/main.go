@@ -3,10 +3,16 @@ package main
 import (
 	"flag"
 	"fmt"
+	"go/token"
 	"log"
+	"os"
 	"strings"
 
 	_ "github.com/cosmos/cosmos-proto/features/fastreflection"
+	_ "github.com/cosmos/cosmos-proto/features/fieldmask"
+	_ "github.com/cosmos/cosmos-proto/features/genid"
+	_ "github.com/cosmos/cosmos-proto/features/grpc"
+	_ "github.com/cosmos/cosmos-proto/features/interfaces"
 	_ "github.com/cosmos/cosmos-proto/features/protoc"
 	"github.com/cosmos/cosmos-proto/generator"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -35,32 +41,140 @@ func (o ObjectSet) Set(s string) error {
 	return nil
 }
 
-func main() {
-	var features string
-	poolable := make(ObjectSet)
+// ScalarTypes maps cosmos_proto scalar names to the Go types
+// used for the fields annotated with them.
+type ScalarTypes map[string]protogen.GoIdent
+
+func (s ScalarTypes) String() string {
+	return fmt.Sprintf("%#v", s)
+}
+
+// Set parses a mapping in the form scalar=import/path.Type,
+// e.g. cosmos.Int=cosmossdk.io/math.Int.
+func (s ScalarTypes) Set(v string) error {
+	idx := strings.IndexByte(v, '=')
+	if idx < 0 {
+		return fmt.Errorf("invalid scalar mapping, expected scalar=import/path.Type: %q", v)
+	}
+	scalar, typ := v[:idx], v[idx+1:]
+	dot := strings.LastIndexByte(typ, '.')
+	if scalar == "" || dot < 0 {
+		return fmt.Errorf("invalid scalar mapping, expected scalar=import/path.Type: %q", v)
+	}
+	s[scalar] = protogen.GoIdent{
+		GoImportPath: protogen.GoImportPath(typ[0:dot]),
+		GoName:       typ[dot+1:],
+	}
+	return nil
+}
+
+// Globs is a list of globs, set by repeating the parameter.
+type Globs []string
+
+func (g *Globs) String() string {
+	return strings.Join(*g, ",")
+}
+
+func (g *Globs) Set(s string) error {
+	*g = append(*g, s)
+	return nil
+}
+
+// FieldRenames maps fully-qualified field names to the Go names of the fields.
+type FieldRenames map[protoreflect.FullName]string
+
+func (r FieldRenames) String() string {
+	return fmt.Sprintf("%#v", r)
+}
+
+// Set parses a rename in the form field=GoName, e.g. cosmos.bank.v1beta1.Metadata.type=TypeName.
+func (r FieldRenames) Set(v string) error {
+	idx := strings.IndexByte(v, '=')
+	if idx < 0 {
+		return fmt.Errorf("invalid field rename, expected full.field.name=GoName: %q", v)
+	}
+	field, goName := protoreflect.FullName(v[:idx]), v[idx+1:]
+	if !field.IsValid() || !token.IsIdentifier(goName) || !token.IsExported(goName) {
+		return fmt.Errorf("invalid field rename, expected full.field.name=GoName: %q", v)
+	}
+	if generator.IsReservedFieldName(goName) {
+		return fmt.Errorf("invalid field rename %q: %s conflicts with protoreflect.Message", v, goName)
+	}
+	r[field] = goName
+	return nil
+}
+
+// flags are the parameters of the plugin.
+type flags struct {
+	features      string
+	poolable      ObjectSet
+	scalarTypes   ScalarTypes
+	filter        generator.MessageFilter
+	reservedNames string
+	codec         string
+	renames       FieldRenames
+	split         bool
+	stableRange   bool
+}
+
+func newFlagSet(fl *flags) *flag.FlagSet {
+	fl.poolable = make(ObjectSet)
+	fl.scalarTypes = make(ScalarTypes)
+	fl.renames = make(FieldRenames)
 
 	var f flag.FlagSet
-	f.Var(poolable, "pool", "use memory pooling for this object")
-	f.StringVar(&features, "features", "all", "list of features to generate (separated by '+')")
+	f.Var(fl.poolable, "pool", "use memory pooling for this object")
+	f.Var(fl.scalarTypes, "scalar", "map a cosmos_proto scalar to a custom Go type implementing runtime.CustomType (scalar=import/path.Type)")
+	f.StringVar(&fl.features, "features", "all", "list of features to generate (separated by '+')")
+	f.Var((*Globs)(&fl.filter.Include), "include", "only generate the fast reflection of the messages whose full name matches this glob, e.g. cosmos.bank.*")
+	f.Var((*Globs)(&fl.filter.Exclude), "exclude", "do not generate the fast reflection of the messages whose full name matches this glob, e.g. cosmos.bank.v1beta1.Query*")
+	f.StringVar(&fl.reservedNames, "reserved_names", string(generator.ReservedNamesSuffix), "handling of the fields conflicting with the methods of protoreflect.Message: suffix, fail or separate")
+	f.BoolVar(&fl.split, "split_features", false, "generate each feature into its own file, e.g. x.pulsar_fast.go, excluded from the build by the tag pulsar_no_<feature>")
+	f.StringVar(&fl.codec, "codec", string(generator.CodecUnrolled), "generation of the size, marshal and unmarshal methods of the messages: unrolled or table")
+	f.BoolVar(&fl.stableRange, "stable_range", false, "make the fast reflection Range visit the populated fields by number and the entries of maps by key")
+	f.Var(fl.renames, "rename", "set the Go name of a field, e.g. to avoid a conflict with protoreflect.Message (full.field.name=GoName)")
+	return &f
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		runStandalone()
+		return
+	}
 
+	var fl flags
+	f := newFlagSet(&fl)
 	protogen.Options{ParamFunc: f.Set}.Run(func(plugin *protogen.Plugin) error {
-		processedMessages := make(map[protoreflect.FullName]struct{})
-		for _, file := range plugin.Files {
-			if !file.Generate {
-				continue
-			}
-			for _, message := range file.Messages {
-				rewriteMessageField(message, processedMessages)
-			}
-		}
-		return generateAllFiles(plugin, strings.Split(features, "+"), poolable)
+		return generate(plugin, &fl)
 	})
 }
 
+func generate(plugin *protogen.Plugin, fl *flags) error {
+	ext := &generator.Extensions{Poolable: fl.poolable, ScalarTypes: fl.scalarTypes}
+	if len(fl.filter.Include) > 0 || len(fl.filter.Exclude) > 0 {
+		ext.Filter = &fl.filter
+	}
+	mode, err := generator.ParseReservedNamesMode(fl.reservedNames)
+	if err != nil {
+		return err
+	}
+	ext.ReservedNames = mode
+	codec, err := generator.ParseCodecMode(fl.codec)
+	if err != nil {
+		return err
+	}
+	ext.Codec = codec
+	ext.StableRange = fl.stableRange
+
+	if err := rewriteFieldNames(plugin, ext, fl.renames); err != nil {
+		return err
+	}
+	return generateAllFiles(plugin, strings.Split(fl.features, "+"), ext, fl.split)
+}
+
 var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
-func generateAllFiles(plugin *protogen.Plugin, featureNames []string, poolable ObjectSet) error {
-	ext := &generator.Extensions{Poolable: poolable}
+func generateAllFiles(plugin *protogen.Plugin, featureNames []string, ext *generator.Extensions, split bool) error {
 	gen, err := generator.NewGenerator(plugin.Files, featureNames, ext)
 	if err != nil {
 		return err
@@ -70,6 +184,10 @@ func generateAllFiles(plugin *protogen.Plugin, featureNames []string, poolable O
 		if !file.Generate {
 			continue
 		}
+		if split {
+			gen.GenerateFeatureFiles(plugin, file)
+			continue
+		}
 
 		gf := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+".pulsar.go", file.GoImportPath)
 		gf.P("// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.")
@@ -83,26 +201,36 @@ func generateAllFiles(plugin *protogen.Plugin, featureNames []string, poolable O
 	return nil
 }
 
-var reservedFieldNames = map[string]struct{}{
-	"Descriptor":   {},
-	"Type":         {},
-	"New":          {},
-	"Interface":    {},
-	"Range":        {},
-	"Has":          {},
-	"Clear":        {},
-	"Get":          {},
-	"Set":          {},
-	"Mutable":      {},
-	"NewField":     {},
-	"WhichOneof":   {},
-	"GetUnknown":   {},
-	"SetUnknown":   {},
-	"IsValid":      {},
-	"ProtoMethods": {},
+// rewriteFieldNames applies the renames to the fields of the files to generate, then
+// handles the fields whose Go name conflicts with a method of protoreflect.Message
+// according to ext.ReservedNames. The messages filtered out of fast reflection
+// have no conflicting fields.
+func rewriteFieldNames(plugin *protogen.Plugin, ext *generator.Extensions, renames FieldRenames) error {
+	processed := make(map[protoreflect.FullName]struct{})
+	renamed := make(map[protoreflect.FullName]bool)
+	var conflicts []string
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		for _, message := range file.Messages {
+			rewriteMessageField(message, ext, renames, processed, renamed, &conflicts)
+		}
+	}
+
+	for field := range renames {
+		if !renamed[field] {
+			return fmt.Errorf("cannot rename %s: no such field in the files to generate", field)
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("fields conflicting with the methods of protoreflect.Message, rename them with the rename parameter or use reserved_names=suffix or reserved_names=separate:\n%s",
+			strings.Join(conflicts, "\n"))
+	}
+	return nil
 }
 
-func rewriteMessageField(message *protogen.Message, processed map[protoreflect.FullName]struct{}) {
+func rewriteMessageField(message *protogen.Message, ext *generator.Extensions, renames FieldRenames, processed map[protoreflect.FullName]struct{}, renamed map[protoreflect.FullName]bool, conflicts *[]string) {
 	// skip already processed messages, useful for recursive messages
 	if _, done := processed[message.Desc.FullName()]; done {
 		return
@@ -111,18 +239,26 @@ func rewriteMessageField(message *protogen.Message, processed map[protoreflect.F
 	if message.Desc.IsMapEntry() {
 		return
 	}
+	processed[message.Desc.FullName()] = struct{}{}
 
 	for _, field := range message.Fields {
-		_, reserved := reservedFieldNames[field.GoName]
-		if !reserved {
+		if goName, ok := renames[field.Desc.FullName()]; ok {
+			field.GoName = goName
+			renamed[field.Desc.FullName()] = true
+		}
+		if !generator.IsReservedFieldName(field.GoName) || !ext.Filter.Match(message.Desc.FullName()) {
 			continue
 		}
-		log.Printf("Message %s contains the reserved field name %s which conflicts with protoreflect.Message interface implementation.\nThis field will be suffixed with an underscore '_'.\nIf you can change the message field name, please do so.\nIn a future iteration of pulsar we may make a breaking change to this practice in order to be compliant with field naming of the original golang protobuf implementation.", message.Desc.FullName(), field.Desc.FullName())
-		field.GoName = field.GoName + "_"
+		switch ext.ReservedNames {
+		case generator.ReservedNamesFail:
+			*conflicts = append(*conflicts, fmt.Sprintf("%s: %s", field.Desc.FullName(), field.GoName))
+		case generator.ReservedNamesSuffix:
+			log.Printf("Message %s contains the reserved field name %s which conflicts with protoreflect.Message interface implementation.\nThis field will be suffixed with an underscore '_'.\nIf you can change the message field name, please do so.\nIn a future iteration of pulsar we may make a breaking change to this practice in order to be compliant with field naming of the original golang protobuf implementation.", message.Desc.FullName(), field.Desc.FullName())
+			field.GoName = field.GoName + "_"
+		}
 	}
-	processed[message.Desc.FullName()] = struct{}{}
 
 	for _, nestedMessage := range message.Messages {
-		rewriteMessageField(nestedMessage, processed)
+		rewriteMessageField(nestedMessage, ext, renames, processed, renamed, conflicts)
 	}
 }
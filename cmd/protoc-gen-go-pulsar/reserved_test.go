@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gotest.tools/v3/golden"
+)
+
+// reserved.binpb contains internal/testprotos/reservedtest/reserved.proto.
+const reservedProto = "internal/testprotos/reservedtest/reserved.proto"
+
+func generateReserved(t *testing.T, param string) (string, error) {
+	t.Helper()
+	bz, err := os.ReadFile("testdata/reserved.binpb")
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(bz, set))
+	version, err := parseVersion("3.21.9")
+	require.NoError(t, err)
+
+	files, err := generateFromSet(set, []string{reservedProto}, "features=protoc+fast+interfaces+grpc,"+param, version)
+	if err != nil {
+		return "", err
+	}
+	require.Len(t, files, 1)
+	return files[0].GetContent(), nil
+}
+
+func TestReservedNames(t *testing.T) {
+	t.Run("suffix", func(t *testing.T) {
+		content, err := generateReserved(t, "reserved_names=suffix")
+		require.NoError(t, err)
+		golden.Assert(t, content, "reserved_suffix.pulsar.go.golden")
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		// the conflicts left after renaming are suffixed
+		content, err := generateReserved(t, "rename=reservedtest.Reserved.type=Kind_,rename=reservedtest.Reserved.range=Ranges,rename=reservedtest.Plain.type_url=URL")
+		require.NoError(t, err)
+		golden.Assert(t, content, "reserved_rename.pulsar.go.golden")
+	})
+
+	t.Run("separate", func(t *testing.T) {
+		content, err := generateReserved(t, "reserved_names=separate")
+		require.NoError(t, err)
+		want, err := os.ReadFile(filepath.Join("..", "..", strings.TrimSuffix(reservedProto, ".proto")+".pulsar.go"))
+		require.NoError(t, err)
+		require.Equal(t, string(want), content)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		_, err := generateReserved(t, "reserved_names=fail")
+		require.Error(t, err)
+		golden.Assert(t, err.Error(), "reserved_fail.golden")
+
+		// renaming all the conflicting fields fixes the generation
+		_, err = generateReserved(t, "reserved_names=fail,"+
+			"rename=reservedtest.Reserved.type=TypeName,rename=reservedtest.Reserved.range=Ranges,rename=reservedtest.Reserved.get=Gets,"+
+			"rename=reservedtest.Reserved.set=Child,rename=reservedtest.Reserved.new=NewName,rename=reservedtest.Reserved.clear=ClearCount")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := generateReserved(t, "reserved_names=unknown")
+		require.ErrorContains(t, err, `unknown reserved names mode "unknown"`)
+		_, err = generateReserved(t, "rename=reservedtest.Reserved.type=Type")
+		require.ErrorContains(t, err, "Type conflicts with protoreflect.Message")
+		_, err = generateReserved(t, "rename=reservedtest.Reserved.type=type")
+		require.ErrorContains(t, err, "invalid field rename")
+		_, err = generateReserved(t, "rename=reservedtest.Reserved.unknown=Unknown")
+		require.ErrorContains(t, err, "cannot rename reservedtest.Reserved.unknown: no such field")
+	})
+}
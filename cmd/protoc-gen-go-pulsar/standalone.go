@@ -0,0 +1,289 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// runStandalone generates the files of a FileDescriptorSet or buf image in-process,
+// without protoc or buf:
+//
+//	protoc-gen-go-pulsar -descriptor_set_in=set.binpb -param=features=protoc+fast -out=. cosmos/bank/
+func runStandalone() {
+	var descriptorSetIn, param, out, compilerVersion string
+	flag.StringVar(&descriptorSetIn, "descriptor_set_in", "", "FileDescriptorSet, built with --include_imports, or buf image containing the files to generate")
+	flag.StringVar(&param, "param", "", "plugin parameter, as passed to protoc with --go-pulsar_opt, e.g. features=protoc+fast,paths=source_relative")
+	flag.StringVar(&out, "out", ".", "directory where the files are generated")
+	flag.StringVar(&compilerVersion, "compiler_version", "", "protoc version reported in the generated files, e.g. 3.21.9")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s -descriptor_set_in=FILE [flags] [PATTERN...]\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintln(flag.CommandLine.Output(), "Generates the files of the set whose path matches one of the patterns, either a path.Match")
+		fmt.Fprintln(flag.CommandLine.Output(), "glob or a directory. The patterns default to the non-import files of a buf image.")
+		fmt.Fprintln(flag.CommandLine.Output())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if descriptorSetIn == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	bz, err := os.ReadFile(descriptorSetIn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// a buf image is wire compatible with a FileDescriptorSet
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(bz, set); err != nil {
+		log.Fatalf("unmarshaling %s: %v", descriptorSetIn, err)
+	}
+	var version *pluginpb.Version
+	if compilerVersion != "" {
+		if version, err = parseVersion(compilerVersion); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	files, err := generateFromSet(set, flag.Args(), param, version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, file := range files {
+		name := filepath.Join(out, filepath.FromSlash(file.GetName()))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(file.GetContent()), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generateFromSet runs the same generation as the plugin on the files of set matching
+// patterns, and returns the generated files.
+func generateFromSet(set *descriptorpb.FileDescriptorSet, patterns []string, param string, version *pluginpb.Version) ([]*pluginpb.CodeGeneratorResponse_File, error) {
+	protoFiles, err := sortFiles(set.File)
+	if err != nil {
+		return nil, err
+	}
+	toGenerate, err := selectFiles(protoFiles, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate:  toGenerate,
+		ProtoFile:       protoFiles,
+		CompilerVersion: version,
+	}
+	if param != "" {
+		req.Parameter = proto.String(param)
+	}
+
+	var fl flags
+	f := newFlagSet(&fl)
+	plugin, err := protogen.Options{ParamFunc: f.Set}.New(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := generate(plugin, &fl); err != nil {
+		return nil, err
+	}
+	resp := plugin.Response()
+	if resp.Error != nil {
+		return nil, errors.New(resp.GetError())
+	}
+	for _, file := range resp.File {
+		if file.GetInsertionPoint() != "" {
+			return nil, fmt.Errorf("%s: insertion points are not supported", file.GetName())
+		}
+	}
+	return resp.File, nil
+}
+
+// sortFiles orders files so that every file follows its dependencies, as
+// expected in a CodeGeneratorRequest.
+func sortFiles(files []*descriptorpb.FileDescriptorProto) ([]*descriptorpb.FileDescriptorProto, error) {
+	byPath := make(map[string]*descriptorpb.FileDescriptorProto, len(files))
+	for _, file := range files {
+		byPath[file.GetName()] = file
+	}
+
+	sorted := make([]*descriptorpb.FileDescriptorProto, 0, len(files))
+	visited := make(map[string]bool, len(files))
+	var visit func(file *descriptorpb.FileDescriptorProto) error
+	visit = func(file *descriptorpb.FileDescriptorProto) error {
+		if visited[file.GetName()] {
+			return nil
+		}
+		visited[file.GetName()] = true
+		for _, dep := range file.Dependency {
+			depFile, ok := byPath[dep]
+			if !ok {
+				return fmt.Errorf("%s: import %s not found in the descriptor set, build it with --include_imports", file.GetName(), dep)
+			}
+			if err := visit(depFile); err != nil {
+				return err
+			}
+		}
+		sorted = append(sorted, file)
+		return nil
+	}
+	for _, file := range files {
+		if err := visit(file); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// selectFiles returns the paths of the files matching one of the patterns, or
+// the non-import files of a buf image when no pattern is given.
+func selectFiles(files []*descriptorpb.FileDescriptorProto, patterns []string) ([]string, error) {
+	var selected []string
+	if len(patterns) == 0 {
+		var isImage bool
+		for _, file := range files {
+			isImport, ok := bufImageIsImport(file)
+			isImage = isImage || ok
+			if ok && !isImport {
+				selected = append(selected, file.GetName())
+			}
+		}
+		if !isImage {
+			return nil, errors.New("the files to generate must be selected with patterns when the input is not a buf image")
+		}
+		return selected, nil
+	}
+
+	matched := make([]bool, len(patterns))
+	for _, file := range files {
+		var match bool
+		for i, pattern := range patterns {
+			ok, err := matchPattern(pattern, file.GetName())
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				match, matched[i] = true, true
+			}
+		}
+		if match {
+			selected = append(selected, file.GetName())
+		}
+	}
+	for i, pattern := range patterns {
+		if !matched[i] {
+			return nil, fmt.Errorf("pattern %q does not match any file", pattern)
+		}
+	}
+	return selected, nil
+}
+
+// matchPattern reports whether name matches the path.Match glob pattern
+// or is in the directory pattern.
+func matchPattern(pattern, name string) (bool, error) {
+	ok, err := path.Match(pattern, name)
+	if err != nil {
+		return false, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	dir := strings.TrimSuffix(pattern, "/")
+	return ok || strings.HasPrefix(name, dir+"/"), nil
+}
+
+const (
+	// bufImageExtensionNumber is the number of the buf_extension field of the files of
+	// a buf image (buf.alpha.image.v1.ImageFile), which follows the fields of
+	// FileDescriptorProto.
+	bufImageExtensionNumber = 8042
+	// bufImageIsImportNumber is the number of its is_import field.
+	bufImageIsImportNumber = 1
+)
+
+// bufImageIsImport returns the is_import field of the buf image extension of
+// file, ok being false when file has no such extension.
+func bufImageIsImport(file *descriptorpb.FileDescriptorProto) (isImport, ok bool) {
+	unknown := file.ProtoReflect().GetUnknown()
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return false, false
+		}
+		unknown = unknown[n:]
+		if num != bufImageExtensionNumber || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, unknown)
+			if n < 0 {
+				return false, false
+			}
+			unknown = unknown[n:]
+			continue
+		}
+		ext, n := protowire.ConsumeBytes(unknown)
+		if n < 0 {
+			return false, false
+		}
+		unknown = unknown[n:]
+		ok = true
+		for len(ext) > 0 {
+			num, typ, n := protowire.ConsumeTag(ext)
+			if n < 0 {
+				return false, false
+			}
+			ext = ext[n:]
+			if num == bufImageIsImportNumber && typ == protowire.VarintType {
+				v, n := protowire.ConsumeVarint(ext)
+				if n < 0 {
+					return false, false
+				}
+				isImport = protowire.DecodeBool(v)
+				ext = ext[n:]
+				continue
+			}
+			n = protowire.ConsumeFieldValue(num, typ, ext)
+			if n < 0 {
+				return false, false
+			}
+			ext = ext[n:]
+		}
+	}
+	return isImport, ok
+}
+
+// parseVersion parses a protoc version such as 3.21.9 or 4.22.0-rc2.
+func parseVersion(s string) (*pluginpb.Version, error) {
+	v, suffix, _ := strings.Cut(s, "-")
+	parts := strings.Split(strings.TrimPrefix(v, "v"), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid compiler version %q, expected major.minor.patch", s)
+	}
+	var nums [3]int32
+	for i, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid compiler version %q: %w", s, err)
+		}
+		nums[i] = int32(n)
+	}
+	version := &pluginpb.Version{
+		Major: proto.Int32(nums[0]),
+		Minor: proto.Int32(nums[1]),
+		Patch: proto.Int32(nums[2]),
+	}
+	if suffix != "" {
+		version.Suffix = proto.String(suffix)
+	}
+	return version, nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const modulePath = "github.com/cosmos/cosmos-proto/"
+
+// testprotos.binpb contains testpb, cosmostest, splittest and querytest, built with source info.
+func loadSet(t *testing.T) *descriptorpb.FileDescriptorSet {
+	t.Helper()
+	bz, err := os.ReadFile("testdata/testprotos.binpb")
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(bz, set))
+	return set
+}
+
+// TestGenerateFromSet checks that the standalone mode generates the checked-in files.
+func TestGenerateFromSet(t *testing.T) {
+	version, err := parseVersion("3.21.9")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		patterns []string
+		param    string
+		want     []string
+	}{
+		{
+			name:     "glob",
+			patterns: []string{"testpb/*.proto"},
+			param:    "features=protoc+fast+interfaces+grpc+genid+fieldmask",
+			want:     []string{"testpb/1.pulsar.go", "testpb/2.pulsar.go"},
+		},
+		{
+			name:     "directory",
+			patterns: []string{"internal/testprotos/cosmostest"},
+			param: "features=protoc+fast+interfaces+grpc," +
+				"scalar=cosmostest.Int=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Int," +
+				"scalar=cosmostest.Checksum=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Checksum",
+			want: []string{
+				"internal/testprotos/cosmostest/bank.pulsar.go",
+				"internal/testprotos/cosmostest/interfaces.pulsar.go",
+				"internal/testprotos/cosmostest/scalars.pulsar.go",
+				"internal/testprotos/cosmostest/tx.pulsar.go",
+			},
+		},
+		{
+			name:     "split features",
+			patterns: []string{"runtime/grpccodec/internal/testprotos/splittest/split.proto"},
+			param:    "features=protoc+fast+interfaces+grpc,split_features=true",
+			want: []string{
+				"runtime/grpccodec/internal/testprotos/splittest/split.pulsar.go",
+				"runtime/grpccodec/internal/testprotos/splittest/split.pulsar_fast.go",
+				"runtime/grpccodec/internal/testprotos/splittest/split.pulsar_grpc.go",
+				"runtime/grpccodec/internal/testprotos/splittest/split.pulsar_interfaces.go",
+				"runtime/grpccodec/internal/testprotos/splittest/split.pulsar_no_fast.go",
+			},
+		},
+		{
+			name:     "nested module",
+			patterns: []string{"runtime/grpccodec/internal/testprotos/querytest/query.proto"},
+			param:    "features=protoc+fast+grpc",
+			want:     []string{"runtime/grpccodec/internal/testprotos/querytest/query.pulsar.go"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			files, err := generateFromSet(loadSet(t), tc.patterns, tc.param, version)
+			require.NoError(t, err)
+
+			var names []string
+			for _, file := range files {
+				name := strings.TrimPrefix(file.GetName(), modulePath)
+				names = append(names, name)
+
+				want, err := os.ReadFile(filepath.Join("..", "..", filepath.FromSlash(name)))
+				require.NoError(t, err)
+				require.Equal(t, string(want), file.GetContent(), "%s is not up to date", name)
+			}
+			require.ElementsMatch(t, tc.want, names)
+		})
+	}
+}
+
+// TestSplitFeaturesSkipsEmptyFiles checks that the features generating nothing for a
+// file have no file.
+func TestSplitFeaturesSkipsEmptyFiles(t *testing.T) {
+	files, err := generateFromSet(loadSet(t), []string{"testpb/1.proto"}, "features=protoc+fast+interfaces+grpc,split_features=true", nil)
+	require.NoError(t, err)
+	var names []string
+	for _, file := range files {
+		names = append(names, strings.TrimPrefix(file.GetName(), modulePath))
+	}
+	require.ElementsMatch(t, []string{"testpb/1.pulsar.go", "testpb/1.pulsar_fast.go", "testpb/1.pulsar_no_fast.go"}, names)
+}
+
+// TestInterfacesOfImports checks that the implementations of an interface declared in
+// a file which is not generated are not checked against its Go type, which may not exist.
+func TestInterfacesOfImports(t *testing.T) {
+	files, err := generateFromSet(loadSet(t), []string{"internal/testprotos/cosmostest/tx.proto"}, "features=protoc+fast+interfaces", nil)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Contains(t, files[0].GetContent(), "func (*MsgSend) IsCosmostestMsg() {}")
+	require.NotContains(t, files[0].GetContent(), "var _ Msg = (*MsgSend)(nil)")
+}
+
+// TestGenidCollisions checks that the generation fails on genid constants with the
+// same name, here A_B_C_field_* for the field c of A.B and the field B_C of A.
+func TestGenidCollisions(t *testing.T) {
+	field := func(name string) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(1),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+	}
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("collision.proto"),
+		Package: proto.String("collision"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/collision")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:       proto.String("A"),
+			Field:      []*descriptorpb.FieldDescriptorProto{field("B_C")},
+			NestedType: []*descriptorpb.DescriptorProto{{Name: proto.String("B"), Field: []*descriptorpb.FieldDescriptorProto{field("c")}}},
+		}},
+	}
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}}
+
+	_, err := generateFromSet(set, []string{"collision.proto"}, "features=protoc+genid", nil)
+	require.EqualError(t, err, "collision.proto: genid constants A_B_C_field_* of collision.A.B.c collide with the ones of collision.A.B_C, one of them must be renamed")
+
+	// the constants are only generated with the genid feature
+	_, err = generateFromSet(set, []string{"collision.proto"}, "features=protoc", nil)
+	require.NoError(t, err)
+}
+
+func TestGenerateFromSetErrors(t *testing.T) {
+	_, err := generateFromSet(loadSet(t), nil, "", nil)
+	require.ErrorContains(t, err, "must be selected with patterns")
+
+	_, err = generateFromSet(loadSet(t), []string{"testpb/*.proto", "unknown/"}, "", nil)
+	require.ErrorContains(t, err, `pattern "unknown/" does not match any file`)
+
+	_, err = generateFromSet(loadSet(t), []string{"testpb/["}, "", nil)
+	require.ErrorContains(t, err, "invalid pattern")
+
+	_, err = generateFromSet(loadSet(t), []string{"testpb/"}, "features=unknown", nil)
+	require.ErrorContains(t, err, `unknown feature: "unknown"`)
+
+	_, err = generateFromSet(loadSet(t), []string{"testpb/"}, "exclude=testpb.[", nil)
+	require.ErrorContains(t, err, `invalid message filter "testpb.["`)
+
+	_, err = generateFromSet(loadSet(t), []string{"internal/testprotos/cosmostest/"},
+		"scalar=cosmostest.Int=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Int,exclude=cosmostest.Coin", nil)
+	require.ErrorContains(t, err, "message cosmostest.Coin is filtered out of fast reflection, but its field amount is mapped to the custom type")
+
+	set := loadSet(t)
+	var withoutImports []*descriptorpb.FileDescriptorProto
+	for _, file := range set.File {
+		if !strings.HasPrefix(file.GetName(), "google/") {
+			withoutImports = append(withoutImports, file)
+		}
+	}
+	set.File = withoutImports
+	_, err = generateFromSet(set, []string{"testpb/"}, "", nil)
+	require.ErrorContains(t, err, "not found in the descriptor set")
+}
+
+// TestBufImage checks that the files of a buf image which are not imports are generated by default.
+func TestBufImage(t *testing.T) {
+	set := loadSet(t)
+	// reverse the files to check that they are sorted
+	for i, j := 0, len(set.File)-1; i < j; i, j = i+1, j-1 {
+		set.File[i], set.File[j] = set.File[j], set.File[i]
+	}
+	for _, file := range set.File {
+		var ext []byte
+		ext = protowire.AppendTag(ext, bufImageIsImportNumber, protowire.VarintType)
+		ext = protowire.AppendVarint(ext, protowire.EncodeBool(!strings.HasPrefix(file.GetName(), "testpb/")))
+		var unknown []byte
+		unknown = protowire.AppendTag(unknown, bufImageExtensionNumber, protowire.BytesType)
+		unknown = protowire.AppendBytes(unknown, ext)
+		file.ProtoReflect().SetUnknown(unknown)
+	}
+
+	files, err := generateFromSet(set, nil, "features=protoc+fast", nil)
+	require.NoError(t, err)
+	var names []string
+	for _, file := range files {
+		names = append(names, strings.TrimPrefix(file.GetName(), modulePath))
+	}
+	require.ElementsMatch(t, []string{"testpb/1.pulsar.go", "testpb/2.pulsar.go"}, names)
+}
+
+func TestParseVersion(t *testing.T) {
+	v, err := parseVersion("4.22.0-rc2")
+	require.NoError(t, err)
+	require.Equal(t, int32(4), v.GetMajor())
+	require.Equal(t, int32(22), v.GetMinor())
+	require.Equal(t, int32(0), v.GetPatch())
+	require.Equal(t, "rc2", v.GetSuffix())
+
+	_, err = parseVersion("3.21")
+	require.Error(t, err)
+	_, err = parseVersion("3.x.1")
+	require.Error(t, err)
+}
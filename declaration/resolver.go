@@ -0,0 +1,211 @@
+// Package declaration finds the InterfaceDescriptor and ScalarDescriptor
+// declarations made with the cosmos_proto declare_interface and declare_scalar
+// file options.
+package declaration
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// InterfacesFile and ScalarsFile are the names of the files expected to
+	// declare the interfaces and scalars of a package, as documented by
+	// cosmos.proto.
+	InterfacesFile = "interfaces.proto"
+	ScalarsFile    = "scalars.proto"
+)
+
+// Files is the subset of *protoregistry.Files used by a Resolver.
+type Files interface {
+	FindFileByPath(path string) (protoreflect.FileDescriptor, error)
+	RangeFiles(f func(protoreflect.FileDescriptor) bool)
+	NumFiles() int
+}
+
+// Resolver finds declarations by fully-qualified name. For a name a.b.C it
+// first looks in the file a/b/interfaces.proto or a/b/scalars.proto, as
+// documented by cosmos.proto, and falls back to scanning all the files.
+// Found declarations are cached; the results of full scans are reused until
+// files are added to the registry. A Resolver is safe for concurrent use.
+//
+// The returned descriptors are shared and must not be modified.
+type Resolver struct {
+	files Files
+
+	mu         sync.RWMutex
+	interfaces map[string]*cosmos_proto.InterfaceDescriptor
+	scalars    map[string]*cosmos_proto.ScalarDescriptor
+	// scannedFiles is the number of files at the last full scan, or -1
+	scannedFiles int
+}
+
+// NewResolver returns a Resolver of the declarations made in files.
+func NewResolver(files Files) *Resolver {
+	return &Resolver{
+		files:        files,
+		interfaces:   make(map[string]*cosmos_proto.InterfaceDescriptor),
+		scalars:      make(map[string]*cosmos_proto.ScalarDescriptor),
+		scannedFiles: -1,
+	}
+}
+
+var global = NewResolver(protoregistry.GlobalFiles)
+
+// FindInterface finds an interface declaration in protoregistry.GlobalFiles.
+func FindInterface(name string) (*cosmos_proto.InterfaceDescriptor, error) {
+	return global.FindInterface(name)
+}
+
+// FindScalar finds a scalar declaration in protoregistry.GlobalFiles.
+func FindScalar(name string) (*cosmos_proto.ScalarDescriptor, error) {
+	return global.FindScalar(name)
+}
+
+// FindInterface returns the declaration of the interface with the given
+// fully-qualified name, or an error wrapping protoregistry.NotFound.
+func (r *Resolver) FindInterface(name string) (*cosmos_proto.InterfaceDescriptor, error) {
+	r.mu.RLock()
+	decl, ok := r.interfaces[name]
+	r.mu.RUnlock()
+	if ok {
+		return decl, nil
+	}
+
+	if fd := r.conventionalFile(name, InterfacesFile); fd != nil {
+		for _, d := range Interfaces(fd) {
+			if FullName(fd, d.Name) == name {
+				r.mu.Lock()
+				r.interfaces[name] = d
+				r.mu.Unlock()
+				return d, nil
+			}
+		}
+	}
+
+	r.scan()
+	r.mu.RLock()
+	decl, ok = r.interfaces[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("interface %s: %w", name, protoregistry.NotFound)
+	}
+	return decl, nil
+}
+
+// FindScalar returns the declaration of the scalar with the given
+// fully-qualified name, or an error wrapping protoregistry.NotFound.
+func (r *Resolver) FindScalar(name string) (*cosmos_proto.ScalarDescriptor, error) {
+	r.mu.RLock()
+	decl, ok := r.scalars[name]
+	r.mu.RUnlock()
+	if ok {
+		return decl, nil
+	}
+
+	if fd := r.conventionalFile(name, ScalarsFile); fd != nil {
+		for _, d := range Scalars(fd) {
+			if FullName(fd, d.Name) == name {
+				r.mu.Lock()
+				r.scalars[name] = d
+				r.mu.Unlock()
+				return d, nil
+			}
+		}
+	}
+
+	r.scan()
+	r.mu.RLock()
+	decl, ok = r.scalars[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("scalar %s: %w", name, protoregistry.NotFound)
+	}
+	return decl, nil
+}
+
+// conventionalFile returns the file expected to declare name, e.g. a/b/scalars.proto
+// for the scalar a.b.C, if it exists and belongs to the package of name.
+func (r *Resolver) conventionalFile(name, base string) protoreflect.FileDescriptor {
+	dot := strings.LastIndexByte(name, '.')
+	if dot < 0 {
+		return nil
+	}
+	pkg := protoreflect.FullName(name[:dot])
+	fd, err := r.files.FindFileByPath(ConventionalPath(pkg, base))
+	if err != nil || fd.Package() != pkg {
+		return nil
+	}
+	return fd
+}
+
+// scan indexes the declarations of all the files, unless they were already
+// indexed and no file has been added since.
+func (r *Resolver) scan() {
+	n := r.files.NumFiles()
+	r.mu.RLock()
+	upToDate := r.scannedFiles == n
+	r.mu.RUnlock()
+	if upToDate {
+		return
+	}
+
+	interfaces := make(map[string]*cosmos_proto.InterfaceDescriptor)
+	scalars := make(map[string]*cosmos_proto.ScalarDescriptor)
+	r.files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for _, d := range Interfaces(fd) {
+			interfaces[FullName(fd, d.Name)] = d
+		}
+		for _, d := range Scalars(fd) {
+			scalars[FullName(fd, d.Name)] = d
+		}
+		return true
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, d := range interfaces {
+		if _, ok := r.interfaces[name]; !ok {
+			r.interfaces[name] = d
+		}
+	}
+	for name, d := range scalars {
+		if _, ok := r.scalars[name]; !ok {
+			r.scalars[name] = d
+		}
+	}
+	r.scannedFiles = n
+}
+
+// ConventionalPath returns the path of the file expected to declare the
+// interfaces or scalars of pkg, e.g. a/b/interfaces.proto for the file
+// InterfacesFile of the package a.b.
+func ConventionalPath(pkg protoreflect.FullName, file string) string {
+	return path.Join(strings.ReplaceAll(string(pkg), ".", "/"), file)
+}
+
+// Interfaces returns the interfaces declared by fd.
+func Interfaces(fd protoreflect.FileDescriptor) []*cosmos_proto.InterfaceDescriptor {
+	return proto.GetExtension(fd.Options(), cosmos_proto.E_DeclareInterface).([]*cosmos_proto.InterfaceDescriptor)
+}
+
+// Scalars returns the scalars declared by fd.
+func Scalars(fd protoreflect.FileDescriptor) []*cosmos_proto.ScalarDescriptor {
+	return proto.GetExtension(fd.Options(), cosmos_proto.E_DeclareScalar).([]*cosmos_proto.ScalarDescriptor)
+}
+
+// FullName returns the fully-qualified name of the interface or scalar declared
+// by fd with the given name.
+func FullName(fd protoreflect.FileDescriptor, name string) string {
+	if fd.Package() == "" {
+		return name
+	}
+	return string(fd.Package()) + "." + name
+}
@@ -0,0 +1,146 @@
+package declaration
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+)
+
+// countingFiles counts the full scans of the registry.
+type countingFiles struct {
+	*protoregistry.Files
+	scans int32
+}
+
+func (f *countingFiles) RangeFiles(fn func(protoreflect.FileDescriptor) bool) {
+	atomic.AddInt32(&f.scans, 1)
+	f.Files.RangeFiles(fn)
+}
+
+func newFile(t *testing.T, files *protoregistry.Files, path, pkg string, interfaces []string, scalars []string) {
+	opts := &descriptorpb.FileOptions{}
+	var ifaceDecls []*cosmos_proto.InterfaceDescriptor
+	for _, name := range interfaces {
+		ifaceDecls = append(ifaceDecls, &cosmos_proto.InterfaceDescriptor{Name: name})
+	}
+	var scalarDecls []*cosmos_proto.ScalarDescriptor
+	for _, name := range scalars {
+		scalarDecls = append(scalarDecls, &cosmos_proto.ScalarDescriptor{
+			Name:      name,
+			FieldType: []cosmos_proto.ScalarType{cosmos_proto.ScalarType_SCALAR_TYPE_STRING},
+		})
+	}
+	proto.SetExtension(opts, cosmos_proto.E_DeclareInterface, ifaceDecls)
+	proto.SetExtension(opts, cosmos_proto.E_DeclareScalar, scalarDecls)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String(path),
+		Package: proto.String(pkg),
+		Syntax:  proto.String("proto3"),
+		Options: opts,
+	}, files)
+	require.NoError(t, err)
+	require.NoError(t, files.RegisterFile(fd))
+}
+
+func TestResolverConventionalFiles(t *testing.T) {
+	files := &countingFiles{Files: &protoregistry.Files{}}
+	newFile(t, files.Files, "a/b/interfaces.proto", "a.b", []string{"Msg", "Authorization"}, nil)
+	newFile(t, files.Files, "a/b/scalars.proto", "a.b", nil, []string{"Int"})
+	newFile(t, files.Files, "a/b/other.proto", "a.b", []string{"Misplaced"}, []string{"Dec"})
+	r := NewResolver(files)
+
+	iface, err := r.FindInterface("a.b.Authorization")
+	require.NoError(t, err)
+	require.Equal(t, "Authorization", iface.Name)
+	scalar, err := r.FindScalar("a.b.Int")
+	require.NoError(t, err)
+	require.Equal(t, "Int", scalar.Name)
+	require.Equal(t, int32(0), files.scans, "conventional declarations do not need a full scan")
+
+	// declarations outside of the conventional files are found by a full scan
+	iface, err = r.FindInterface("a.b.Misplaced")
+	require.NoError(t, err)
+	require.Equal(t, "Misplaced", iface.Name)
+	scalar, err = r.FindScalar("a.b.Dec")
+	require.NoError(t, err)
+	require.Equal(t, "Dec", scalar.Name)
+	require.Equal(t, int32(1), files.scans)
+
+	// misses only scan again once files are added
+	_, err = r.FindScalar("c.Missing")
+	require.True(t, errors.Is(err, protoregistry.NotFound))
+	_, err = r.FindInterface("Missing")
+	require.True(t, errors.Is(err, protoregistry.NotFound))
+	require.Equal(t, int32(1), files.scans)
+
+	newFile(t, files.Files, "c/types.proto", "c", nil, []string{"Missing"})
+	scalar, err = r.FindScalar("c.Missing")
+	require.NoError(t, err)
+	require.Equal(t, "Missing", scalar.Name)
+	require.Equal(t, int32(2), files.scans)
+}
+
+func TestResolverGlobalFiles(t *testing.T) {
+	// the test protos are not in conventional files
+	iface, err := FindInterface("cosmostest.Msg")
+	require.NoError(t, err)
+	require.Equal(t, "Msg", iface.Name)
+
+	scalar, err := FindScalar("cosmostest.Checksum")
+	require.NoError(t, err)
+	require.Equal(t, []cosmos_proto.ScalarType{cosmos_proto.ScalarType_SCALAR_TYPE_BYTES}, scalar.FieldType)
+}
+
+func TestDeclarations(t *testing.T) {
+	files := &protoregistry.Files{}
+	newFile(t, files, "a/b/interfaces.proto", "a.b", []string{"Msg"}, nil)
+	newFile(t, files, "root.proto", "", nil, []string{"Int"})
+
+	fd, err := files.FindFileByPath("a/b/interfaces.proto")
+	require.NoError(t, err)
+	require.Equal(t, fd.Path(), ConventionalPath(fd.Package(), InterfacesFile))
+	require.Len(t, Interfaces(fd), 1)
+	require.Empty(t, Scalars(fd))
+	require.Equal(t, "a.b.Msg", FullName(fd, Interfaces(fd)[0].Name))
+
+	fd, err = files.FindFileByPath("root.proto")
+	require.NoError(t, err)
+	require.Equal(t, "scalars.proto", ConventionalPath(fd.Package(), ScalarsFile))
+	require.Equal(t, "Int", FullName(fd, Scalars(fd)[0].Name))
+}
+
+func TestResolverConcurrency(t *testing.T) {
+	files := &protoregistry.Files{}
+	newFile(t, files, "a/b/interfaces.proto", "a.b", []string{"Msg"}, nil)
+	newFile(t, files, "a/b/other.proto", "a.b", nil, []string{"Int"})
+	r := NewResolver(files)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, err := r.FindInterface("a.b.Msg")
+				require.NoError(t, err)
+				_, err = r.FindScalar("a.b.Int")
+				require.NoError(t, err)
+				_, err = r.FindScalar("a.b.Missing")
+				require.Error(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
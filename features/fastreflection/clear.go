@@ -30,15 +30,16 @@ func (g *clearGen) genComments() {
 
 func (g *clearGen) generate() {
 	g.genComments()
-	g.P("func (x *", g.typeName, ") Clear(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "Clear(fd ", protoreflectPkg.Ident("FieldDescriptor"), ")")
 	g.P("switch fd.FullName() {")
 	for _, field := range g.message.Fields {
 		g.genField(field)
 	}
 	g.P("default:")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
+	genExtensionCase(g.GeneratedFile, g.message, "fd", func() {
+		g.P(extensionsOf(g.GeneratedFile), ".Clear(", messageDescriptorName(g.message), ", fd)")
+		g.P("return")
+	})
 	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
 	g.P("}")
 	g.P("}")
@@ -46,6 +47,12 @@ func (g *clearGen) generate() {
 
 func (g *clearGen) genField(field *protogen.Field) {
 	g.P("case \"", field.Desc.FullName(), "\":")
+	if ident, ok := g.CustomType(field); ok {
+		g.P("var zero ", g.QualifiedGoIdent(ident))
+		g.P("x.", field.GoName, " = zero")
+		g.P(runtimePackage.Ident("ClearCustomType"), "(&x.unknownFields, ", field.Desc.Number(), ")")
+		return
+	}
 	if field.Desc.HasPresence() || field.Desc.IsList() || field.Desc.IsMap() || field.Desc.Kind() == protoreflect.BytesKind {
 		g.genNullable(field)
 		return
@@ -56,9 +63,9 @@ func (g *clearGen) genField(field *protogen.Field) {
 
 func (g *clearGen) genNullable(field *protogen.Field) {
 	switch {
-	case field.Desc.ContainingOneof() != nil:
+	case realOneof(field) != nil:
 		g.P("x.", field.Oneof.GoName, " = nil")
-	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.BytesKind:
+	case field.Desc.IsMap(), field.Desc.IsList(), scalarPresence(field), field.Desc.Kind() == protoreflect.BytesKind:
 		g.P("x.", field.GoName, " = nil")
 	case field.Desc.Kind() == protoreflect.MessageKind:
 		g.P(" x.", field.GoName, " = nil")
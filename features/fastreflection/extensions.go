@@ -0,0 +1,34 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// extendable reports whether message declares extension ranges, whose
+// extension fields are stored in its extensionFields field.
+func extendable(message *protogen.Message) bool {
+	return message.Desc.ExtensionRanges().Len() > 0
+}
+
+// extensionsOf returns the runtime.Extensions of the extension fields of x.
+func extensionsOf(g *generator.GeneratedFile) string {
+	return g.QualifiedGoIdent(runtimePackage.Ident("NewExtensions")) + "(&x.extensionFields)"
+}
+
+// genExtensionCase generates the handling of the extension descriptor fd in the
+// default case of a reflection method: the statements generated by gen if the
+// message is extendable, a panic otherwise.
+func genExtensionCase(g *generator.GeneratedFile, message *protogen.Message, fd string, gen func()) {
+	g.P("if ", fd, ".IsExtension() {")
+	switch {
+	case extendable(message):
+		gen()
+	case message.Desc.Syntax() == protoreflect.Proto3:
+		g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", message.Desc.FullName(), "\"))")
+	default:
+		g.P("panic(", fmtPkg.Ident("Errorf"), "(\"message ", message.Desc.FullName(), " does not declare extension ranges\"))")
+	}
+	g.P("}")
+}
@@ -9,7 +9,7 @@ func init() {
 	generator.RegisterFeature("fast", func(gen *generator.GeneratedFile, _ *protogen.Plugin) generator.FeatureGenerator {
 		return fastReflectionFeature{
 			GeneratedFile: gen,
-			Stable:        false,
+			Stable:        gen.StableRange(),
 			once:          false,
 		}
 	})
@@ -35,7 +35,7 @@ func newGenerator(f *protogen.File, g *generator.GeneratedFile, message *protoge
 		GeneratedFile: g,
 		file:          f,
 		message:       message,
-		Stable:        true,
+		Stable:        g.StableRange(),
 		typeName:      fastReflectionTypeName(message),
 		err:           nil,
 	}
@@ -48,6 +48,36 @@ func (g fastReflectionFeature) GenerateFile(file *protogen.File, _ *protogen.Plu
 	return true // only do this once
 }
 
+// GenerateFallback generates the ProtoReflect method of the messages with fast
+// reflection, implemented by protoimpl, for the builds excluding the fast feature.
+// The messages with fields mapped to custom types have no fallback, as protoimpl
+// cannot reflect them.
+func (g fastReflectionFeature) GenerateFallback(file *protogen.File) {
+	var genFallback func(message *protogen.Message)
+	genFallback = func(message *protogen.Message) {
+		if g.FastReflection(message) && !g.hasCustomTypes(message) {
+			genSlowProtoReflect(g.GeneratedFile, file, message, "ProtoReflect")
+		}
+		for _, nested := range message.Messages {
+			if !nested.Desc.IsMapEntry() {
+				genFallback(nested)
+			}
+		}
+	}
+	for _, msg := range file.Messages {
+		genFallback(msg)
+	}
+}
+
+func (g fastReflectionFeature) hasCustomTypes(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if _, ok := g.CustomType(field); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (g fastReflectionFeature) GenerateHelpers() {
 	// no helpers needed here yet
 }
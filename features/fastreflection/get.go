@@ -14,7 +14,7 @@ type getGen struct {
 
 func (g *getGen) generate() {
 	g.genComment()
-	g.P("func (x *", g.typeName, ") Get(descriptor ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"), " {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "Get(descriptor ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"))
 	g.P("switch descriptor.FullName() {")
 	// implement the fastReflectionFeature Get function
 	for _, field := range g.message.Fields {
@@ -39,7 +39,7 @@ func (g *getGen) genComment() {
 }
 
 func (g *getGen) genFieldGetter(field *protogen.Field) {
-	if field.Oneof != nil {
+	if realOneof(field) != nil {
 		g.genOneofGetter(field)
 		return
 	}
@@ -53,7 +53,26 @@ func (g *getGen) genFieldGetter(field *protogen.Field) {
 		return
 	}
 
+	if _, ok := g.CustomType(field); ok {
+		g.genCustomType(field)
+		return
+	}
+
 	fieldRef := "x." + field.GoName
+	switch {
+	case scalarPresence(field) && field.Desc.Kind() == protoreflect.BytesKind:
+		// unset bytes fields are nil, unless they have a default value
+		if field.Desc.HasDefault() {
+			g.P("if ", fieldRef, " == nil {")
+			g.P("return ", defaultValue(g.GeneratedFile, field))
+			g.P("}")
+		}
+	case scalarPresence(field):
+		g.P("if ", fieldRef, " == nil {")
+		g.P("return ", defaultValue(g.GeneratedFile, field))
+		g.P("}")
+		fieldRef = "*" + fieldRef
+	}
 	g.P("value := ", fieldRef)
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -112,11 +131,22 @@ func (g *getGen) genOneofGetter(fd *protogen.Field) {
 	g.P("}")
 }
 
+// genCustomType generates the getter of a field mapped to a custom type,
+// returning the value encoded as the field's proto kind.
+func (g *getGen) genCustomType(field *protogen.Field) {
+	g.P("value := ", runtimePackage.Ident("MarshalCustomType"), "(&x.", field.GoName, ")")
+	if field.Desc.Kind() == protoreflect.StringKind {
+		g.P("return ", protoreflectPkg.Ident("ValueOfString"), "(string(value))")
+	} else {
+		g.P("return ", protoreflectPkg.Ident("ValueOfBytes"), "(value)")
+	}
+}
+
 // genDefaultCase generates the default case for field descriptor
 func (g *getGen) genDefaultCase() {
-	g.P("if descriptor.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
+	genExtensionCase(g.GeneratedFile, g.message, "descriptor", func() {
+		g.P("return ", extensionsOf(g.GeneratedFile), ".Get(", messageDescriptorName(g.message), ", descriptor)")
+	})
 	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", descriptor.FullName()))")
 }
 
@@ -124,20 +154,20 @@ func (g *getGen) genDefaultCase() {
 func (g *getGen) genMap(field *protogen.Field) {
 	// gen invalid case
 	g.P("if len(x.", field.GoName, ") == 0 {")
-	g.P("return ", protoreflectPkg.Ident("ValueOfMap"), "(&", mapTypeName(field), "{})")
+	g.P("return ", protoreflectPkg.Ident("ValueOfMap"), "(", newMap(g.GeneratedFile, field, "nil"), ")")
 	g.P("}")
 	// gen valid case
-	g.P("mapValue := &", mapTypeName(field), "{m: &x.", field.GoName, "}")
+	g.P("mapValue := ", newMap(g.GeneratedFile, field, "&x."+field.GoName))
 	g.P("return ", protoreflectPkg.Ident("ValueOfMap"), "(mapValue)")
 }
 
 func (g *getGen) genList(field *protogen.Field) {
 	// gen invalid case
 	g.P("if len(x.", field.GoName, ") == 0 {")
-	g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(&", listTypeName(field), "{})")
+	g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(", newList(g.GeneratedFile, field, "nil"), ")")
 	g.P("}")
 	// gen valid case
-	g.P("listValue := &", listTypeName(field), "{list: &x.", field.GoName, "}")
+	g.P("listValue := ", newList(g.GeneratedFile, field, "&x."+field.GoName))
 	g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(listValue)")
 }
 
@@ -39,15 +39,15 @@ func (g *hasGen) genComments() {
 
 func (g *hasGen) generate() {
 	g.genComments()
-	g.P("func (x *", g.typeName, ") Has(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") bool {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "Has(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") bool")
 	g.P("switch fd.FullName() {")
 	for _, field := range g.message.Fields {
 		g.genField(field)
 	}
 	g.P("default:")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
+	genExtensionCase(g.GeneratedFile, g.message, "fd", func() {
+		g.P("return ", extensionsOf(g.GeneratedFile), ".Has(", messageDescriptorName(g.message), ", fd)")
+	})
 	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
 	g.P("}")
 	g.P("}")
@@ -55,6 +55,10 @@ func (g *hasGen) generate() {
 
 func (g *hasGen) genField(field *protogen.Field) {
 	g.P("case \"", field.Desc.FullName(), "\":")
+	if _, ok := g.CustomType(field); ok {
+		g.P("return x.", field.GoName, ".Size() != 0")
+		return
+	}
 	if field.Desc.HasPresence() || field.Desc.IsList() || field.Desc.IsMap() || field.Desc.Kind() == protoreflect.BytesKind {
 		g.genNullable(field)
 		return
@@ -73,7 +77,7 @@ func (g *hasGen) genField(field *protogen.Field) {
 
 func (g *hasGen) genNullable(field *protogen.Field) {
 	switch {
-	case field.Desc.ContainingOneof() != nil:
+	case realOneof(field) != nil:
 		// case oneof is nil
 		g.P("if x.", field.Oneof.GoName, " == nil {")
 		g.P("return false")
@@ -84,6 +88,8 @@ func (g *hasGen) genNullable(field *protogen.Field) {
 		g.P("} else { ")
 		g.P("return false")
 		g.P("}")
+	case scalarPresence(field):
+		g.P("return x.", field.GoName, " != nil")
 	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.BytesKind:
 		g.P("return len(x.", field.GoName, ") != 0")
 	case field.Desc.Kind() == protoreflect.MessageKind:
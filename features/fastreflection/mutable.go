@@ -14,7 +14,7 @@ type mutableGen struct {
 
 func (g *mutableGen) generate() {
 	g.genComment()
-	g.P("func (x *", g.typeName, ") Mutable(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"), " {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, g.hasMutableFields() || extendable(g.message), "Mutable(fd ", protoreflectPkg.Ident("FieldDescriptor"), ") ", protoreflectPkg.Ident("Value"))
 	g.P("switch fd.FullName()  {")
 	// we first output all the fields that are mutable
 	for _, field := range g.message.Fields {
@@ -34,14 +34,23 @@ func (g *mutableGen) generate() {
 	}
 	// then the default case
 	g.P("default:")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
+	genExtensionCase(g.GeneratedFile, g.message, "fd", func() {
+		g.P("return ", extensionsOf(g.GeneratedFile), ".Mutable(", messageDescriptorName(g.message), ", fd)")
+	})
 	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
 	g.P("}")
 	g.P("}")
 }
 
+func (g *mutableGen) hasMutableFields() bool {
+	for _, field := range g.message.Fields {
+		if mutable(field) {
+			return true
+		}
+	}
+	return false
+}
+
 func mutable(field *protogen.Field) bool {
 	switch {
 	case field.Desc.IsMap():
@@ -69,7 +78,7 @@ func (g *mutableGen) genComment() {
 }
 
 func (g *mutableGen) genField(field *protogen.Field) {
-	if field.Oneof != nil {
+	if realOneof(field) != nil {
 		g.genOneof(field)
 		return
 	}
@@ -81,13 +90,13 @@ func (g *mutableGen) genField(field *protogen.Field) {
 		g.P("x.", field.GoName, " = make(map[", getGoType(g.GeneratedFile, field.Message.Fields[0]), "]", getGoType(g.GeneratedFile, field.Message.Fields[1]), ")")
 		g.P("}")
 		// return value of map
-		g.P("value := &", mapTypeName(field), "{m: &x.", field.GoName, "}")
+		g.P("value := ", newMap(g.GeneratedFile, field, "&x."+field.GoName))
 		g.P("return ", protoreflectPkg.Ident("ValueOfMap"), "(value)")
 	case field.Desc.IsList():
 		g.P("if x.", field.GoName, " == nil {")
 		g.P("x.", field.GoName, " = []", getGoType(g.GeneratedFile, field), "{}")
 		g.P("}")
-		g.P("value := &", listTypeName(field), "{list: &x.", field.GoName, "}")
+		g.P("value := ", newList(g.GeneratedFile, field, "&x."+field.GoName))
 		g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(value)")
 	case field.Desc.Kind() == protoreflect.MessageKind:
 		g.P("if x.", field.GoName, " == nil {")
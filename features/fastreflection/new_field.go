@@ -21,9 +21,9 @@ func (g *newFieldGen) generate() {
 		g.genField(field)
 	}
 	g.P("default: ")
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
+	genExtensionCase(g.GeneratedFile, g.message, "fd", func() {
+		g.P("return ", runtimePackage.Ident("NewExtensionField"), "(", messageDescriptorName(g.message), ", fd)")
+	})
 	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
 	g.P("}")
 	g.P("}")
@@ -39,6 +39,8 @@ func (g *newFieldGen) genField(field *protogen.Field) {
 	switch {
 	case field.Desc.IsMap(), field.Desc.IsList(), field.Desc.Kind() == protoreflect.MessageKind:
 		g.genMutable(field)
+	case field.Desc.HasDefault():
+		g.P("return ", defaultValue(g.GeneratedFile, field))
 	default:
 		g.P("return ", kindToValueConstructor(field.Desc.Kind()), "(", zeroValueForField(g.GeneratedFile, field), ")")
 	}
@@ -46,14 +48,14 @@ func (g *newFieldGen) genField(field *protogen.Field) {
 
 func (g *newFieldGen) genMutable(field *protogen.Field) {
 	switch {
-	case field.Oneof != nil:
+	case realOneof(field) != nil:
 		g.genOneof(field)
 	case field.Desc.IsMap():
 		g.P("m := make(map[", getGoType(g.GeneratedFile, field.Message.Fields[0]), "]", getGoType(g.GeneratedFile, field.Message.Fields[1]), ")")
-		g.P("return ", protoreflectPkg.Ident("ValueOfMap"), "(&", mapTypeName(field), "{m: &m})")
+		g.P("return ", protoreflectPkg.Ident("ValueOfMap"), "(", newMap(g.GeneratedFile, field, "&m"), ")")
 	case field.Desc.IsList():
 		g.P("list := []", getGoType(g.GeneratedFile, field), "{}")
-		g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(&", listTypeName(field), "{list: &list})")
+		g.P("return ", protoreflectPkg.Ident("ValueOfList"), "(", newList(g.GeneratedFile, field, "&list"), ")")
 	case field.Desc.Kind() == protoreflect.MessageKind:
 		g.P("m := new(", g.QualifiedGoIdent(field.Message.GoIdent), ")")
 		g.P("return ", protoreflectPkg.Ident("ValueOfMessage"), "(m.ProtoReflect())")
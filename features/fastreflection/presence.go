@@ -0,0 +1,33 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// realOneof returns the oneof of field, or nil if field is not a member of a
+// oneof or is a proto3 optional field, whose synthetic oneof has no Go field.
+func realOneof(field *protogen.Field) *protogen.Oneof {
+	if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+		return nil
+	}
+	return field.Oneof
+}
+
+// scalarPresence reports whether field is a singular scalar field with presence
+// outside of a oneof, such as a proto2 optional or a proto3 optional field,
+// which is populated when its Go field is not nil: a pointer, or a slice for
+// bytes fields.
+func scalarPresence(field *protogen.Field) bool {
+	return field.Desc.HasPresence() && field.Desc.Message() == nil && realOneof(field) == nil
+}
+
+// defaultValue returns the expression of the default value of the scalar field,
+// returned by its descriptor, the default bytes being copied.
+func defaultValue(g *generator.GeneratedFile, field *protogen.Field) string {
+	if field.Desc.Kind() == protoreflect.BytesKind {
+		return g.QualifiedGoIdent(protoreflectPkg.Ident("ValueOfBytes")) + "(append([]byte(nil), " + fieldDescriptorName(field) + ".Default().Bytes()...))"
+	}
+	return fieldDescriptorName(field) + ".Default()"
+}
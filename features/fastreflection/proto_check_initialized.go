@@ -0,0 +1,111 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// needsCheck reports whether the messages described by md may not be initialized:
+// when they have required fields, fields mapped to custom types, which may be set
+// to invalid values, or extension ranges, whose extensions may have required
+// fields, or when the messages of their fields need to be checked.
+// seen holds the messages being visited, which are skipped.
+func (g *fastGenerator) needsCheck(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen == nil {
+		seen = map[protoreflect.FullName]bool{}
+	}
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	if md.RequiredNumbers().Len() > 0 || md.ExtensionRanges().Len() > 0 {
+		return true
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if _, ok := g.CustomTypeOf(fd); ok {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && g.needsCheck(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// genCheckInitializedMethod generates the checkInitialized method, which
+// reports the first required field which is not set or custom type field set
+// to an invalid value, in the message or in the messages of its fields.
+func (g *fastGenerator) genCheckInitializedMethod() {
+	g.P(`checkInitialized := func(input `, protoifacePkg.Ident("CheckInitializedInput"), `) (`, protoifacePkg.Ident("CheckInitializedOutput"), `, error) {`)
+	if !g.needsCheck(g.message.Desc, nil) {
+		g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{}, nil`)
+		g.P(`}`)
+		return
+	}
+	g.P(`x := input.Message.Interface().(*`, g.message.GoIdent, `)`)
+	g.P(`output := `, protoifacePkg.Ident("CheckInitializedOutput"), `{NoUnkeyedLiterals: input.NoUnkeyedLiterals}`)
+	g.P(`if x == nil {`)
+	g.P(`return output, nil`)
+	g.P(`}`)
+	for _, field := range g.message.Fields {
+		if field.Desc.Cardinality() == protoreflect.Required {
+			if _, ok := g.CustomType(field); ok {
+				g.P(`if x.`, field.GoName, `.Size() == 0 {`)
+			} else {
+				g.P(`if x.`, field.GoName, ` == nil {`)
+			}
+			g.P(`return output, `, runtimePackage.Ident("RequiredNotSet"), `(`, fieldDescriptorName(field), `)`)
+			g.P(`}`)
+		}
+		if ident, ok := g.CustomType(field); ok {
+			g.P(`if err := `, runtimePackage.Ident("CheckCustomType"), `(x.unknownFields, `, fieldDescriptorName(field), `, new(`, ident, `)); err != nil {`)
+			g.P(`return output, err`)
+			g.P(`}`)
+		}
+		g.genCheckField(field)
+	}
+	if extendable(g.message) {
+		g.P(`if err := `, extensionsOf(g.GeneratedFile), `.CheckInitialized(); err != nil {`)
+		g.P(`return output, err`)
+		g.P(`}`)
+	}
+	g.P(`return output, nil`)
+	g.P(`}`)
+}
+
+// genCheckField generates the check of the messages of field, if they may not
+// be initialized.
+func (g *fastGenerator) genCheckField(field *protogen.Field) {
+	value := field
+	if field.Desc.IsMap() {
+		value = field.Message.Fields[1]
+	}
+	if value.Message == nil || !g.needsCheck(value.Message.Desc, nil) {
+		return
+	}
+	check := func(v string) {
+		g.P(`if err := `, g.Ident(generator.ProtoPkg, "CheckInitialized"), `(`, v, `); err != nil {`)
+		g.P(`return output, err`)
+		g.P(`}`)
+	}
+	switch {
+	case field.Desc.IsMap(), field.Desc.IsList():
+		g.P(`for _, v := range x.`, field.GoName, ` {`)
+		check("v")
+		g.P(`}`)
+	case realOneof(field) != nil:
+		g.P(`if v, ok := x.`, field.Oneof.GoName, `.(*`, field.GoIdent, `); ok && v.`, field.GoName, ` != nil {`)
+		check("v." + field.GoName)
+		g.P(`}`)
+	default:
+		g.P(`if x.`, field.GoName, ` != nil {`)
+		check("x." + field.GoName)
+		g.P(`}`)
+	}
+}
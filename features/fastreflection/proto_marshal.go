@@ -41,7 +41,14 @@ func (g *fastGenerator) genMarshalMethod() {
 	g.P("options := ", runtimePackage.Ident("MarshalInputToOptions"), "(input)")
 	g.P("_ = options")
 	g.P("size := options.Size(x)")
-	g.P(`dAtA := make([]byte, size)`)
+	// marshal in place when the caller provides a large enough buffer, e.g. a pooled one
+	g.P("var dAtA []byte")
+	g.P("inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size")
+	g.P("if inPlace {")
+	g.P("dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]")
+	g.P("} else {")
+	g.P(`dAtA = make([]byte, size)`)
+	g.P("}")
 
 	// from here we need to do what MarshalToSizedBuffer was doing
 	g.P("i := len(dAtA)")
@@ -57,6 +64,9 @@ func (g *fastGenerator) genMarshalMethod() {
 	oneofs := make(map[string]struct{})
 	for i := len(g.message.Oneofs) - 1; i >= 0; i-- {
 		field := g.message.Oneofs[i]
+		if field.Desc.IsSynthetic() {
+			continue
+		}
 		fieldname := field.GoName
 		if _, ok := oneofs[fieldname]; !ok {
 			oneofs[fieldname] = struct{}{}
@@ -82,13 +92,27 @@ func (g *fastGenerator) genMarshalMethod() {
 	// then we do everything else
 	for i := len(messageFields) - 1; i >= 0; i-- {
 		field := messageFields[i]
-		isOneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		if !isOneof {
+		if realOneof(field) == nil {
 			g.marshalField(true, &numGen, field, false)
 		}
 	}
 
-	g.P("if input.Buf != nil {")
+	// extension fields are encoded before the regular ones, like the protobuf runtime does
+	if extendable(g.message) {
+		g.P("n, err := ", extensionsOf(g.GeneratedFile), ".MarshalToSizedBuffer(dAtA[:i], options)")
+		g.P("if err != nil {")
+		g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+		g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+		g.P("Buf: input.Buf,")
+		g.P("}, err")
+		g.P("}")
+		g.P("i -= n")
+	}
+
+	// dAtA already is the tail of input.Buf when marshaling in place
+	g.P("if inPlace {")
+	g.P("input.Buf = input.Buf[:len(input.Buf)+size]")
+	g.P("} else if input.Buf != nil {")
 	g.P(`input.Buf = append(input.Buf, dAtA...)`)
 	g.P("} else {")
 	g.P("input.Buf = dAtA")
@@ -102,7 +126,22 @@ func (g *fastGenerator) genMarshalMethod() {
 
 func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protogen.Field, oneof bool) {
 	fieldname := field.GoName
-	nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic())
+	if _, ok := g.CustomType(field); ok {
+		g.P(`l = x.`, fieldname, `.Size()`)
+		g.P(`if l > 0 {`)
+		g.P(`i -= l`)
+		g.P(`if _, err := x.`, fieldname, `.MarshalTo(dAtA[i:i+l]); err != nil {`)
+		g.P(`return `, protoifacePkg.Ident("MarshalOutput"), " {")
+		g.P("NoUnkeyedLiterals: input.NoUnkeyedLiterals,")
+		g.P("Buf: input.Buf,")
+		g.P("}, err")
+		g.P(`}`)
+		g.encodeVarint(`l`)
+		g.encodeKey(field.Desc.Number(), protowire.BytesType)
+		g.P(`}`)
+		return
+	}
+	nullable := field.Message != nil || scalarPresence(field)
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated && !oneof {
 		g.P(`if len(x.`, fieldname, `) > 0 {`)
@@ -447,7 +486,7 @@ func (g *fastGenerator) marshalField(proto3 bool, numGen *counter, field *protog
 			g.encodeVarint(`len(`, val, `)`)
 			g.encodeKey(fieldNumber, wireType)
 			g.P(`}`)
-		} else if proto3 {
+		} else if proto3 && !nullable {
 			if !oneof {
 				g.P(`if len(x.`, fieldname, `) > 0 {`)
 			}
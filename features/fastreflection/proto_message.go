@@ -21,7 +21,10 @@ const (
 )
 
 func GenProtoMessage(f *protogen.File, g *generator.GeneratedFile, message *protogen.Message) {
-	genMessage(f, g, message)
+	// messages filtered out get the slow reflection generated by the protoc feature
+	if g.FastReflection(message) {
+		genMessage(f, g, message)
+	}
 	// check for message declarations within a message declaration
 	for _, nested := range message.Messages {
 		// map entries are defines as messages, but we don't want to generate those.
@@ -59,18 +62,10 @@ func fastReflectionTypeName(message *protogen.Message) string {
 	return fmt.Sprintf("fastReflection_%s", message.GoIdent.GoName)
 }
 
-// generateExtraTypes generates the protoreflect.List and protoreflect.Map types required.
+// generateExtraTypes generates the descriptors of the message. Its
+// protoreflect.List and protoreflect.Map are implemented by runtime.List
+// and runtime.Map.
 func (g *fastGenerator) generateExtraTypes() {
-	for _, field := range g.message.Fields {
-		switch {
-		case field.Desc.IsMap():
-			g.generateMapType(field)
-		case field.Desc.IsList():
-			g.generateListType(field)
-		}
-	}
-
-	// generate descriptors
 	(&descGen{
 		GeneratedFile: g.GeneratedFile,
 		file:          g.file,
@@ -78,24 +73,6 @@ func (g *fastGenerator) generateExtraTypes() {
 	}).generate()
 }
 
-// generateMapType generates the fastReflectionFeature reflection protoreflect.Map type
-// related to the provided protogen.Field.
-func (g *fastGenerator) generateMapType(field *protogen.Field) {
-	(&mapGen{
-		GeneratedFile: g.GeneratedFile,
-		field:         field,
-	}).generate()
-}
-
-// generateListType generates the fastReflectionFeature reflection protoreflect.List type
-// related to the provided protogen.Field.
-func (g *fastGenerator) generateListType(field *protogen.Field) {
-	(&listGen{
-		GeneratedFile: g.GeneratedFile,
-		field:         field,
-	}).generate()
-}
-
 func (g *fastGenerator) genMessageType() {
 	(&messageTypeGen{
 		typeName:        g.typeName,
@@ -110,21 +87,22 @@ func (g *fastGenerator) generateReflectionType() {
 	// gen interface assertion
 	g.P("var _ ", protoreflectPkg.Ident("Message"), " = (*", g.typeName, ")(nil)")
 	g.P()
-	// gen type
-	g.P("type ", g.typeName, " ", g.message.GoIdent.GoName)
-	// gen msg implementation
-	g.P("func (x *", g.message.GoIdent.GoName, ") ProtoReflect() ", protoreflectPkg.Ident("Message"), "{")
-	g.P("return (*", g.typeName, ")(x)")
-	g.P("}")
-	g.P()
+	// gen type and msg implementation
+	genReflectionType(g.GeneratedFile, g.message, g.typeName)
 
 	// gen slowreflection
-	f := copied.NewFileInfo(g.file)
+	genSlowProtoReflect(g.GeneratedFile, g.file, g.message, "slowProtoReflect")
+}
+
+// genSlowProtoReflect generates the method named methodName of message returning
+// its reflection implemented by protoimpl.
+func genSlowProtoReflect(g *generator.GeneratedFile, file *protogen.File, message *protogen.Message, methodName string) {
+	f := copied.NewFileInfo(file)
 	idx := func() int {
 		var id int
 		var found bool
 		for mInfo, index := range f.AllMessagesByPtr {
-			if mInfo.Message.Desc.FullName() == g.message.Desc.FullName() {
+			if mInfo.Message.Desc.FullName() == message.Desc.FullName() {
 				id = index
 				found = true
 			}
@@ -137,7 +115,7 @@ func (g *fastGenerator) generateReflectionType() {
 	typesVar := copied.MessageTypesVarName(f)
 
 	// ProtoReflect method.
-	g.P("func (x *", g.message.GoIdent, ") slowProtoReflect() ", protoreflectPkg.Ident("Message"), " {")
+	g.P("func (x *", message.GoIdent, ") ", methodName, "() ", protoreflectPkg.Ident("Message"), " {")
 	g.P("mi := &", typesVar, "[", idx, "]")
 	g.P("if ", protoimplPkg.Ident("UnsafeEnabled"), " && x != nil {")
 	g.P("ms := ", protoimplPkg.Ident("X"), ".MessageStateOf(", protoimplPkg.Ident("Pointer"), "(x))")
@@ -181,7 +159,7 @@ func (g *fastGenerator) genNew() {
 func (g *fastGenerator) genInterface() {
 	g.P("// Interface unwraps the message reflection interface and")
 	g.P("// returns the underlying ProtoMessage interface.")
-	g.P("func (x *", g.typeName, ") Interface() ", protoreflectPkg.Ident("ProtoMessage"), " {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "Interface() ", protoreflectPkg.Ident("ProtoMessage"))
 	g.P("return (*", g.message.GoIdent, ")(x)")
 	g.P("}")
 	g.P()
@@ -192,6 +170,7 @@ func (g *fastGenerator) genRange() {
 		GeneratedFile: g.GeneratedFile,
 		typeName:      g.typeName,
 		message:       g.message,
+		stable:        g.Stable,
 	}).generate()
 	g.P()
 }
@@ -251,7 +230,7 @@ func (g *fastGenerator) genGetUnknown() {
 	g.P("// GetUnknown retrieves the entire list of unknown fields.")
 	g.P("// The caller may only mutate the contents of the RawFields")
 	g.P("// if the mutated bytes are stored back into the message with SetUnknown.")
-	g.P("func (x *", g.typeName, ") GetUnknown() ", protoreflectPkg.Ident("RawFields"), " {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "GetUnknown() ", protoreflectPkg.Ident("RawFields"))
 	g.P("return x.unknownFields")
 	g.P("}")
 	g.P()
@@ -265,7 +244,7 @@ func (g *fastGenerator) genSetUnknown() {
 	g.P("// An empty RawFields may be passed to clear the fields.")
 	g.P("//")
 	g.P("// SetUnknown is a mutating operation and unsafe for concurrent use.")
-	g.P("func (x *", g.typeName, ") SetUnknown(fields ", protoreflectPkg.Ident("RawFields"), ") {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "SetUnknown(fields ", protoreflectPkg.Ident("RawFields"), ")")
 	g.P("x.unknownFields = fields")
 	g.P("}")
 	g.P()
@@ -303,6 +282,16 @@ func (g *fastGenerator) genProtoMethods() {
 	g.P("var ", varName, " *", protoifacePkg.Ident("Methods"))
 
 	g.P("func init() {")
+	// the tables do not describe extension fields, which keep the unrolled methods
+	if g.TableCodec() && !extendable(g.message) {
+		g.genTableMethods(varName)
+		if g.needsCheck(g.message.Desc, nil) {
+			g.genCheckInitializedMethod()
+			g.P(varName, ".CheckInitialized = checkInitialized")
+		}
+		g.P("}")
+		return
+	}
 	g.genSizeMethod()
 	g.genMarshalMethod()
 	g.genUnmarshalMethod()
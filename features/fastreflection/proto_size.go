@@ -46,8 +46,7 @@ func (g *fastGenerator) genSizeMethod() {
 	g.P(`_ = l`)
 	oneofs := make(map[string]struct{})
 	for _, field := range g.message.Fields {
-		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		if !oneof {
+		if realOneof(field) == nil {
 			g.field(true, field, false)
 		} else {
 			fieldName := field.Oneof.GoName
@@ -67,6 +66,10 @@ func (g *fastGenerator) genSizeMethod() {
 		}
 	}
 
+	if extendable(g.message) {
+		g.P("n += ", extensionsOf(g.GeneratedFile), ".Size(options)")
+	}
+
 	// last thing to do
 	g.P(`if x.unknownFields != nil {`)
 	g.P(`n+=len(x.unknownFields)`)
@@ -81,7 +84,15 @@ func (g *fastGenerator) genSizeMethod() {
 
 func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 	fieldname := field.GoName
-	nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic())
+	if _, ok := g.CustomType(field); ok {
+		key := generator.KeySize(field.Desc.Number(), protowire.BytesType)
+		g.P(`l = x.`, fieldname, `.Size()`)
+		g.P(`if l > 0 {`)
+		g.P(`n+=`, strconv.Itoa(key), `+l+`, runtimePackage.Ident("Sov"), `(uint64(l))`)
+		g.P(`}`)
+		return
+	}
+	nullable := field.Message != nil || scalarPresence(field)
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated && !oneof {
 		g.P(`if len(x.`, fieldname, `) > 0 {`)
@@ -335,7 +346,7 @@ func (g *fastGenerator) field(proto3 bool, field *protogen.Field, oneof bool) {
 			g.P(`l = len(b)`)
 			g.P(`n+=`, strconv.Itoa(key), `+l+`, runtimePackage.Ident("Sov"), `(uint64(l))`)
 			g.P(`}`)
-		} else if proto3 {
+		} else if proto3 && !nullable {
 			g.P(`l=len(x.`, fieldname, `)`)
 			if !oneof {
 				g.P(`if l > 0 {`)
@@ -0,0 +1,124 @@
+package fastreflection
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const tablePackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime/table")
+
+var tableCodecs = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "Bool",
+	protoreflect.Int32Kind:    "Int32",
+	protoreflect.Sint32Kind:   "Sint32",
+	protoreflect.Uint32Kind:   "Uint32",
+	protoreflect.Int64Kind:    "Int64",
+	protoreflect.Sint64Kind:   "Sint64",
+	protoreflect.Uint64Kind:   "Uint64",
+	protoreflect.Sfixed32Kind: "Sfixed32",
+	protoreflect.Fixed32Kind:  "Fixed32",
+	protoreflect.FloatKind:    "Float",
+	protoreflect.Sfixed64Kind: "Sfixed64",
+	protoreflect.Fixed64Kind:  "Fixed64",
+	protoreflect.DoubleKind:   "Double",
+	protoreflect.StringKind:   "String",
+	protoreflect.BytesKind:    "Bytes",
+}
+
+// genTableMethods assigns to varName the methods of the message implemented by a
+// runtime/table.Table, listing the fields in the order they are marshaled by
+// genMarshalMethod: the fields outside of oneofs by number, then the oneofs.
+func (g *fastGenerator) genTableMethods(varName string) {
+	typeName := g.message.GoIdent.GoName
+
+	g.P(varName, " = ", tablePackage.Ident("New"), "(func(x *", typeName, ") *[]byte { return &x.unknownFields },")
+
+	fields := make([]*protogen.Field, 0, len(g.message.Fields))
+	for _, field := range g.message.Fields {
+		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Desc.Number() < fields[j].Desc.Number()
+	})
+	for _, field := range fields {
+		g.genTableField(typeName, field)
+	}
+
+	for _, oneof := range g.message.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		oneofGetter := "func(x *" + typeName + ") *is" + oneof.GoIdent.GoName + " { return &x." + oneof.GoName + " }"
+		for _, field := range oneof.Fields {
+			elemType := g.tableElemType(field)
+			wrapperGetter := "func(w *" + field.GoIdent.GoName + ") *" + elemType + " { return &w." + field.GoName + " }"
+			if field.Message != nil {
+				g.P(tablePackage.Ident("OneofMessage"), "(", field.Desc.Number(), ", ", oneofGetter, ", ", wrapperGetter, "),")
+			} else {
+				g.P(tablePackage.Ident("OneofScalar"), "(", field.Desc.Number(), ", ", g.tableCodec(field), ", ", oneofGetter, ", ", wrapperGetter, "),")
+			}
+		}
+	}
+	g.P(").Methods()")
+}
+
+func (g *fastGenerator) genTableField(typeName string, field *protogen.Field) {
+	getter := func(fieldType string) string {
+		return "func(x *" + typeName + ") *" + fieldType + " { return &x." + field.GoName + " }"
+	}
+	num := field.Desc.Number()
+	goType, pointer := g.FieldGoType(field)
+
+	switch {
+	case g.isCustomType(field):
+		g.P(tablePackage.Ident("Custom"), "(", num, ", ", getter(goType), "),")
+	case field.Desc.IsMap():
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		if value.Message != nil {
+			g.P(tablePackage.Ident("MessageMap"), "(", num, ", ", g.tableCodec(key), ", ", getter(goType), "),")
+		} else {
+			g.P(tablePackage.Ident("Map"), "(", num, ", ", g.tableCodec(key), ", ", g.tableCodec(value), ", ", getter(goType), "),")
+		}
+	case field.Desc.IsList() && field.Message != nil:
+		g.P(tablePackage.Ident("RepeatedMessage"), "(", num, ", ", getter(goType), "),")
+	case field.Desc.IsList() && field.Desc.IsPacked():
+		g.P(tablePackage.Ident("Packed"), "(", num, ", ", g.tableCodec(field), ", ", getter(goType), "),")
+	case field.Desc.IsList():
+		g.P(tablePackage.Ident("Repeated"), "(", num, ", ", g.tableCodec(field), ", ", getter(goType), "),")
+	case field.Message != nil:
+		g.P(tablePackage.Ident("Message"), "(", num, ", ", getter(goType), "),")
+	case scalarPresence(field) && field.Desc.Kind() == protoreflect.BytesKind:
+		g.P(tablePackage.Ident("OptionalBytes"), "(", num, ", ", getter(goType), "),")
+	case pointer:
+		g.P(tablePackage.Ident("Optional"), "(", num, ", ", g.tableCodec(field), ", ", getter("*"+goType), "),")
+	default:
+		g.P(tablePackage.Ident("Scalar"), "(", num, ", ", g.tableCodec(field), ", ", getter(goType), "),")
+	}
+}
+
+func (g *fastGenerator) isCustomType(field *protogen.Field) bool {
+	_, ok := g.CustomType(field)
+	return ok
+}
+
+// tableElemType returns the Go type of the values of field.
+func (g *fastGenerator) tableElemType(field *protogen.Field) string {
+	goType, _ := g.FieldGoType(field)
+	if field.Desc.IsList() {
+		goType = strings.TrimPrefix(goType, "[]")
+	}
+	return goType
+}
+
+// tableCodec returns the runtime/table.Codec of the scalar values of field.
+func (g *fastGenerator) tableCodec(field *protogen.Field) string {
+	if field.Desc.Kind() == protoreflect.EnumKind {
+		return g.QualifiedGoIdent(tablePackage.Ident("Enum")) + "[" + g.QualifiedGoIdent(field.Enum.GoIdent) + "]()"
+	}
+	return g.QualifiedGoIdent(tablePackage.Ident(tableCodecs[field.Desc.Kind()]))
+}
@@ -11,9 +11,6 @@ import (
 )
 
 func (g *fastGenerator) genUnmarshalMethod() {
-
-	required := g.message.Desc.RequiredNumbers()
-
 	// UNMARSHAL METHOD
 	g.P(`unmarshal := func(input `, protoifacePkg.Ident("UnmarshalInput"), `) (`, protoifacePkg.Ident("UnmarshalOutput"), `, error) {`)
 	g.P(`x := input.Message.Interface().(*`, g.message.GoIdent, `)`)
@@ -27,9 +24,6 @@ func (g *fastGenerator) genUnmarshalMethod() {
 	g.P("_ = options")
 	g.P("dAtA := input.Buf")
 	// body
-	if required.Len() > 0 {
-		g.P(`var hasFields [`, strconv.Itoa(1+(required.Len()-1)/64), `]uint64`)
-	}
 	g.P(`l := len(dAtA)`)
 	g.P(`iNdEx := 0`)
 	g.P(`for iNdEx < l {`)
@@ -46,26 +40,18 @@ func (g *fastGenerator) genUnmarshalMethod() {
 	g.P(`}`)
 	g.P(`switch fieldNum {`)
 	for _, field := range g.message.Fields {
-		g.unmarshalField(field, g.message, true, required)
+		g.unmarshalField(field, g.message, true)
 	}
 	g.P(`default:`)
-	if len(g.message.Extensions) > 0 {
+	if extendable(g.message) {
 		c := []string{}
 		eranges := g.message.Desc.ExtensionRanges()
 		for e := 0; e < eranges.Len(); e++ {
 			erange := eranges.Get(e)
-			c = append(c, `((fieldNum >= `, strconv.Itoa(int(erange[0])), ") && (fieldNum < ", strconv.Itoa(int(erange[1])), `))`)
+			c = append(c, `((fieldNum >= `+strconv.Itoa(int(erange[0]))+") && (fieldNum < "+strconv.Itoa(int(erange[1]))+`))`)
 		}
 		g.P(`if `, strings.Join(c, "||"), `{`)
-		g.P(`var sizeOfWire int`)
-		g.P(`for {`)
-		g.P(`sizeOfWire++`)
-		g.P(`wire >>= 7`)
-		g.P(`if wire == 0 {`)
-		g.P(`break`)
-		g.P(`}`)
-		g.P(`}`)
-		g.P(`iNdEx-=sizeOfWire`)
+		g.P(`iNdEx=preIndex`)
 		g.P(`skippy, err := `, runtimePackage.Ident("Skip"), `(dAtA[iNdEx:])`)
 		g.P(`if err != nil {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
@@ -76,7 +62,14 @@ func (g *fastGenerator) genUnmarshalMethod() {
 		g.P(`if (iNdEx + skippy) > l {`)
 		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
 		g.P(`}`)
-		g.P(g.Ident(generator.ProtoPkg, "AppendExtension"), `(m, int32(fieldNum), dAtA[iNdEx:iNdEx+skippy])`)
+		// extensions unknown to the resolver are kept as unknown fields
+		g.P(`ok, err := `, extensionsOf(g.GeneratedFile), `.UnmarshalField(`, messageDescriptorName(g.message), `, dAtA[iNdEx:iNdEx+skippy], options)`)
+		g.P(`if err != nil {`)
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
+		g.P(`}`)
+		g.P("if !ok && !options.DiscardUnknown {")
+		g.P(`x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)`)
+		g.P("}")
 		g.P(`iNdEx += skippy`)
 		g.P(`} else {`)
 	}
@@ -95,41 +88,22 @@ func (g *fastGenerator) genUnmarshalMethod() {
 	g.P(`x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)`)
 	g.P("}")
 	g.P(`iNdEx += skippy`)
-	if len(g.message.Extensions) > 0 {
+	if extendable(g.message) {
 		g.P(`}`)
 	}
 	g.P(`}`)
 	g.P(`}`)
 
-	for _, field := range g.message.Fields {
-		if field.Desc.Cardinality() != protoreflect.Required {
-			continue
-		}
-		var fieldBit int
-		for fieldBit = 0; fieldBit < required.Len(); fieldBit++ {
-			if required.Get(fieldBit) == field.Desc.Number() {
-				break
-			}
-		}
-		if fieldBit == required.Len() {
-			panic("missing required field")
-		}
-		g.P(`if hasFields[`, strconv.Itoa(fieldBit/64), `] & uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `) == 0 {`)
-		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `new(`, g.Ident(generator.ProtoPkg, "RequiredNotSetError"), `)`)
-		g.P(`}`)
-	}
 	g.P()
 	g.P(`if iNdEx > l {`)
 	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", g.Ident("io", `ErrUnexpectedEOF`))
 	g.P(`}`)
-	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", `nil`)
-	g.P(`}`)
-}
-
-func (g *fastGenerator) genCheckInitializedMethod() {
-	// checkInitialized method.
-	g.P(`checkInitialized := func(input `, protoifacePkg.Ident("CheckInitializedInput"), `) (`, protoifacePkg.Ident("CheckInitializedOutput"), `, error) {`)
-	g.P(`return `, protoifacePkg.Ident("CheckInitializedOutput"), `{}, nil`)
+	if g.needsCheck(g.message.Desc, nil) {
+		// the message is not reported as initialized, for its required fields to be checked
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, ", `nil`)
+	} else {
+		g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, ", `nil`)
+	}
 	g.P(`}`)
 }
 
@@ -150,11 +124,11 @@ func (g *fastGenerator) decodeVarint(varName string, typName string) {
 	g.P(`}`)
 }
 
-func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.Message, proto3 bool, required protoreflect.FieldNumbers) {
+func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.Message, proto3 bool) {
 	fieldname := field.GoName
 	errFieldname := fieldname
-	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
-		fieldname = field.Oneof.GoName
+	if oneof := realOneof(field); oneof != nil {
+		fieldname = oneof.GoName
 	}
 
 	g.P(`case `, strconv.Itoa(int(field.Desc.Number())), `:`)
@@ -212,26 +186,18 @@ func (g *fastGenerator) unmarshalField(field *protogen.Field, message *protogen.
 		g.P(`}`)
 		g.fieldItem(field, fieldname, message, proto3)
 	}
-
-	if field.Desc.Cardinality() == protoreflect.Required {
-		var fieldBit int
-		for fieldBit = 0; fieldBit < required.Len(); fieldBit++ {
-			if required.Get(fieldBit) == field.Desc.Number() {
-				break
-			}
-		}
-		if fieldBit == required.Len() {
-			panic("missing required field")
-		}
-		g.P(`hasFields[`, strconv.Itoa(fieldBit/64), `] |= uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `)`)
-	}
 }
 
 func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, message *protogen.Message, proto3 bool) {
+	if _, ok := g.CustomType(field); ok {
+		g.customTypeItem(fieldname)
+		return
+	}
+
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	typ := g.noStarOrSliceType(field)
-	oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-	nullable := field.Oneof != nil && field.Oneof.Desc.IsSynthetic()
+	oneof := realOneof(field) != nil
+	nullable := scalarPresence(field)
 
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
@@ -587,6 +553,27 @@ func (g *fastGenerator) fieldItem(field *protogen.Field, fieldname string, messa
 	}
 }
 
+// customTypeItem decodes a length-delimited field mapped to a custom type
+// by handing the raw field value to its Unmarshal method.
+func (g *fastGenerator) customTypeItem(fieldname string) {
+	g.P(`var byteLen int`)
+	g.decodeVarint("byteLen", "int")
+	g.P(`if byteLen < 0 {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("ErrInvalidLength"))
+	g.P(`}`)
+	g.P(`postIndex := iNdEx + byteLen`)
+	g.P(`if postIndex < 0 {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", runtimePackage.Ident("ErrInvalidLength"))
+	g.P(`}`)
+	g.P(`if postIndex > l {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", g.Ident("io", `ErrUnexpectedEOF`))
+	g.P(`}`)
+	g.P(`if err := x.`, fieldname, `.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {`)
+	g.P(`return `, protoifacePkg.Ident("UnmarshalOutput"), "{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags},", `err`)
+	g.P(`}`)
+	g.P(`iNdEx = postIndex`)
+}
+
 func (g *fastGenerator) noStarOrSliceType(field *protogen.Field) string {
 	typ, _ := g.FieldGoType(field)
 	if typ[0] == '[' && typ[1] == ']' {
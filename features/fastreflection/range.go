@@ -1,6 +1,8 @@
 package fastreflection
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-proto/generator"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -10,6 +12,8 @@ type rangeGen struct {
 	*generator.GeneratedFile
 	typeName string
 	message  *protogen.Message
+	// stable ranges over the fields by number rather than in declaration order
+	stable bool
 
 	processedOneofs map[string]struct{}
 }
@@ -18,14 +22,43 @@ func (g *rangeGen) generate() {
 	g.processedOneofs = map[string]struct{}{}
 
 	g.genComment()
-	g.P("func (x *", g.typeName, ") Range(f func(", protoreflectPkg.Ident("FieldDescriptor"), ", ", protoreflectPkg.Ident("Value"), ") bool) {")
-	for _, field := range g.message.Fields {
-		g.genField(field)
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "Range(f func(", protoreflectPkg.Ident("FieldDescriptor"), ", ", protoreflectPkg.Ident("Value"), ") bool)")
+	if g.stable {
+		fields := append([]*protogen.Field(nil), g.message.Fields...)
+		sort.SliceStable(fields, func(i, j int) bool {
+			return fields[i].Desc.Number() < fields[j].Desc.Number()
+		})
+		for _, field := range fields {
+			if realOneof(field) != nil {
+				g.genOneofField(field)
+				continue
+			}
+			g.genField(field)
+		}
+	} else {
+		for _, field := range g.message.Fields {
+			g.genField(field)
+		}
+	}
+	if extendable(g.message) {
+		g.P(extensionsOf(g.GeneratedFile), ".Range(", g.stable, ", f)")
 	}
 	g.P("}")
 }
 
 func (g *rangeGen) genComment() {
+	if g.stable {
+		g.P("// Range iterates over every populated field in field number order,")
+		g.P("// calling f for each field descriptor and value encountered.")
+		if extendable(g.message) {
+			g.P("// The extension fields are visited after the other fields.")
+		}
+		g.P("// The entries of map fields are ranged over in key order.")
+		g.P("// Range returns immediately if f returns false.")
+		g.P("// While iterating, mutating operations may only be performed")
+		g.P("// on the current field descriptor.")
+		return
+	}
 	g.P("// Range iterates over every populated field in an undefined order,")
 	g.P("// calling f for each field descriptor and value encountered.")
 	g.P("// Range returns immediately if f returns false.")
@@ -34,22 +67,51 @@ func (g *rangeGen) genComment() {
 }
 
 func (g *rangeGen) genField(field *protogen.Field) {
-	if field.Oneof != nil {
+	if realOneof(field) != nil {
 		g.genOneof(field)
 		return
 	}
 
+	if _, ok := g.CustomType(field); ok {
+		g.P("if x.", field.GoName, ".Size() != 0 {")
+		g.P("bz := ", runtimePackage.Ident("MarshalCustomType"), "(&x.", field.GoName, ")")
+		if field.Desc.Kind() == protoreflect.StringKind {
+			g.P("value := ", protoreflectPkg.Ident("ValueOfString"), "(string(bz))")
+		} else {
+			g.P("value := ", protoreflectPkg.Ident("ValueOfBytes"), "(bz)")
+		}
+		g.P("if !f(", fieldDescriptorName(field), ", value) {")
+		g.P("return")
+		g.P("}")
+		g.P("}")
+		return
+	}
+
 	switch {
+	case scalarPresence(field):
+		g.P("if x.", field.GoName, " != nil {")
+		switch field.Desc.Kind() {
+		case protoreflect.BytesKind:
+			g.P("value := ", kindToValueConstructor(field.Desc.Kind()), "(x.", field.GoName, ")")
+		case protoreflect.EnumKind:
+			g.P("value := ", kindToValueConstructor(field.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(*x.", field.GoName, "))")
+		default:
+			g.P("value := ", kindToValueConstructor(field.Desc.Kind()), "(*x.", field.GoName, ")")
+		}
+		g.P("if !f(", fieldDescriptorName(field), ", value) {")
+		g.P("return")
+		g.P("}")
+		g.P("}")
 	case field.Desc.IsMap():
 		g.P("if len(x.", field.GoName, ") != 0 {")
-		g.P("value := ", protoreflectPkg.Ident("ValueOfMap"), "(&", mapTypeName(field), "{m: &x.", field.GoName, "})")
+		g.P("value := ", protoreflectPkg.Ident("ValueOfMap"), "(", newMap(g.GeneratedFile, field, "&x."+field.GoName), ")")
 		g.P("if !f(", fieldDescriptorName(field), ", value) {")
 		g.P("return")
 		g.P("}")
 		g.P("}")
 	case field.Desc.IsList():
 		g.P("if len(x.", field.GoName, ") != 0 {")
-		g.P("value := ", protoreflectPkg.Ident("ValueOfList"), "(&", listTypeName(field), "{list: &x.", field.GoName, "})")
+		g.P("value := ", protoreflectPkg.Ident("ValueOfList"), "(", newList(g.GeneratedFile, field, "&x."+field.GoName), ")")
 		g.P("if !f(", fieldDescriptorName(field), ", value) {")
 		g.P("return")
 		g.P("}")
@@ -107,23 +169,35 @@ func (g *rangeGen) genOneof(field *protogen.Field) {
 	g.P("switch o := x.", field.Oneof.GoName, ".(type) {")
 	for _, oneofField := range field.Oneof.Fields {
 		g.P("case *", g.QualifiedGoIdent(oneofField.GoIdent), ":")
-		g.P("v := ", "o.", oneofField.GoName)
-		switch oneofField.Desc.Kind() {
-		case protoreflect.MessageKind:
-			g.P("value := ", kindToValueConstructor(oneofField.Desc.Kind()), "(v.ProtoReflect())")
-		case protoreflect.EnumKind:
-			g.P("value :=", kindToValueConstructor(oneofField.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(v))")
-		default:
-			g.P("value := ", kindToValueConstructor(oneofField.Desc.Kind()), "(v)")
-
-		}
-		g.P("if !f(", fieldDescriptorName(oneofField), ", value) {")
-		g.P("return")
-		g.P("}")
-
+		g.genOneofValue(oneofField)
 	}
 	g.P("}")
 	g.P("}")
 	// add this as processed oneof
 	g.processedOneofs[field.Oneof.GoIdent.String()] = struct{}{}
 }
+
+// genOneofField generates the visit of a single field of a oneof, when the
+// fields are ranged over by number.
+func (g *rangeGen) genOneofField(field *protogen.Field) {
+	g.P("if o, ok := x.", field.Oneof.GoName, ".(*", g.QualifiedGoIdent(field.GoIdent), "); ok {")
+	g.genOneofValue(field)
+	g.P("}")
+}
+
+// genOneofValue generates the visit of the field of the oneof wrapper o.
+func (g *rangeGen) genOneofValue(oneofField *protogen.Field) {
+	g.P("v := ", "o.", oneofField.GoName)
+	switch oneofField.Desc.Kind() {
+	case protoreflect.MessageKind:
+		g.P("value := ", kindToValueConstructor(oneofField.Desc.Kind()), "(v.ProtoReflect())")
+	case protoreflect.EnumKind:
+		g.P("value :=", kindToValueConstructor(oneofField.Desc.Kind()), "((", protoreflectPkg.Ident("EnumNumber"), ")(v))")
+	default:
+		g.P("value := ", kindToValueConstructor(oneofField.Desc.Kind()), "(v)")
+
+	}
+	g.P("if !f(", fieldDescriptorName(oneofField), ", value) {")
+	g.P("return")
+	g.P("}")
+}
@@ -0,0 +1,45 @@
+package fastreflection
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const unsafePkg = protogen.GoImportPath("unsafe")
+
+// genMethod generates the start of a method of the fast reflection type of message,
+// up to the opening brace, signature being the method name and signature. The fields
+// of the message are accessed through x which, when the fast reflection type is a
+// separate struct type, is converted from the receiver if usesFields is true.
+func genMethod(g *generator.GeneratedFile, message *protogen.Message, typeName string, usesFields bool, signature ...interface{}) {
+	if !usesFields || !g.SeparateFastReflectionType(message) {
+		g.P(append(append([]interface{}{"func (x *", typeName, ") "}, signature...), " {")...)
+		return
+	}
+	g.P(append(append([]interface{}{"func (r *", typeName, ") "}, signature...), " {")...)
+	g.P("x := (*", message.GoIdent, ")(", unsafePkg.Ident("Pointer"), "(r))")
+}
+
+// genReflectionType generates the fast reflection type of message and the
+// ProtoReflect method of the message returning it.
+func genReflectionType(g *generator.GeneratedFile, message *protogen.Message, typeName string) {
+	if !g.SeparateFastReflectionType(message) {
+		g.P("type ", typeName, " ", message.GoIdent.GoName)
+		g.P("func (x *", message.GoIdent.GoName, ") ProtoReflect() ", protoreflectPkg.Ident("Message"), "{")
+		g.P("return (*", typeName, ")(x)")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	// a type defined from the message cannot have methods named as its fields
+	g.P("// ", typeName, " has the same memory layout as ", message.GoIdent.GoName, ", but none of its fields,")
+	g.P("// as they conflict with the methods of ", protoreflectPkg.Ident("Message"), ".")
+	g.P("type ", typeName, " struct {")
+	g.P("msg ", message.GoIdent.GoName)
+	g.P("}")
+	g.P("func (x *", message.GoIdent.GoName, ") ProtoReflect() ", protoreflectPkg.Ident("Message"), "{")
+	g.P("return (*", typeName, ")(", unsafePkg.Ident("Pointer"), "(x))")
+	g.P("}")
+	g.P()
+}
@@ -14,7 +14,7 @@ type setGen struct {
 
 func (g *setGen) generate() {
 	g.genComment()
-	g.P("func (x *", g.typeName, ") Set(fd ", protoreflectPkg.Ident("FieldDescriptor"), ", value ", protoreflectPkg.Ident("Value"), ") {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, true, "Set(fd ", protoreflectPkg.Ident("FieldDescriptor"), ", value ", protoreflectPkg.Ident("Value"), ")")
 	g.P("switch fd.FullName() {")
 	for _, field := range g.message.Fields {
 		g.P("case \"", field.Desc.FullName(), "\":")
@@ -41,7 +41,7 @@ func (g *setGen) genComment() {
 }
 
 func (g *setGen) genField(field *protogen.Field) {
-	if field.Oneof != nil {
+	if realOneof(field) != nil {
 		g.genOneof(field)
 		return
 	}
@@ -57,6 +57,23 @@ func (g *setGen) genField(field *protogen.Field) {
 
 	fieldRef := "x." + field.GoName
 
+	if ident, ok := g.CustomType(field); ok {
+		bz := "value.Bytes()"
+		if field.Desc.Kind() == protoreflect.StringKind {
+			bz = "[]byte(value.Interface().(string))"
+		}
+		g.P("if !", runtimePackage.Ident("SetCustomType"), "(&", fieldRef, ", &x.unknownFields, ", field.Desc.Number(), ", ", bz, ") {")
+		g.P("var zero ", g.QualifiedGoIdent(ident))
+		g.P(fieldRef, " = zero")
+		g.P("}")
+		return
+	}
+
+	if scalarPresence(field) {
+		g.genPresence(field)
+		return
+	}
+
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
 		g.P(fieldRef, " = value.Bool()")
@@ -84,11 +101,26 @@ func (g *setGen) genField(field *protogen.Field) {
 
 }
 
+// genPresence generates the setter of a scalar field with presence, which
+// points to the value, set bytes fields being never nil.
+func (g *setGen) genPresence(field *protogen.Field) {
+	g.genOneofValueUnwrapper(field)
+	if field.Desc.Kind() == protoreflect.BytesKind {
+		g.P("if cv == nil {")
+		g.P("cv = []byte{}")
+		g.P("}")
+		g.P("x.", field.GoName, " = cv")
+		return
+	}
+	g.P("x.", field.GoName, " = &cv")
+}
+
 // genDefaultCase generates the default case for field descriptor
 func (g *setGen) genDefaultCase() {
-	g.P("if fd.IsExtension() {")
-	g.P("panic(", fmtPkg.Ident("Errorf"), "(\"proto3 declared messages do not support extensions: ", g.message.Desc.FullName(), "\"))")
-	g.P("}")
+	genExtensionCase(g.GeneratedFile, g.message, "fd", func() {
+		g.P(extensionsOf(g.GeneratedFile), ".Set(", messageDescriptorName(g.message), ", fd, value)")
+		g.P("return")
+	})
 	g.P("panic(fmt.Errorf(\"message ", g.message.Desc.FullName(), " does not contain field %s\", fd.FullName()))")
 }
 
@@ -101,8 +133,8 @@ func (g *setGen) genOneof(field *protogen.Field) {
 // for implementation details look at genList
 func (g *setGen) genMap(field *protogen.Field) {
 	g.P("mv := value.Map()")
-	g.P("cmv := mv.(*", mapTypeName(field), ")")
-	g.P("x.", field.GoName, " = *cmv.m")
+	g.P("cmv := mv.(*", mapType(g.GeneratedFile, field), ")")
+	g.P("x.", field.GoName, " = cmv.GoMap()")
 }
 
 // genList generates the implementation of set for list types.
@@ -113,8 +145,8 @@ func (g *setGen) genMap(field *protogen.Field) {
 // After we set a list the value can still be mutated.
 func (g *setGen) genList(field *protogen.Field) {
 	g.P("lv := value.List()")
-	g.P("clv := lv.(*", listTypeName(field), ")")
-	g.P("x.", field.GoName, " = *clv.list")
+	g.P("clv := lv.(*", listType(g.GeneratedFile, field), ")")
+	g.P("x.", field.GoName, " = clv.Slice()")
 }
 
 func (g *setGen) genOneofValueUnwrapper(field *protogen.Field) {
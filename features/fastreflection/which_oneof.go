@@ -23,7 +23,7 @@ func (g *whichOneofGen) genComment() {
 }
 
 func (g *whichOneofGen) genFunc() {
-	g.P("func (x *", g.typeName, ") WhichOneof(d ", protoreflectPkg.Ident("OneofDescriptor"), ") ", protoreflectPkg.Ident("FieldDescriptor"), " {")
+	genMethod(g.GeneratedFile, g.message, g.typeName, len(g.message.Oneofs) > 0, "WhichOneof(d ", protoreflectPkg.Ident("OneofDescriptor"), ") ", protoreflectPkg.Ident("FieldDescriptor"))
 	g.P("switch d.FullName() {")
 	for _, oneof := range g.message.Oneofs {
 		g.P("case \"", oneof.Desc.FullName(), "\": ")
@@ -40,6 +40,10 @@ func (g *whichOneofGen) genFunc() {
 }
 
 func (g *whichOneofGen) genOneof(oneof *protogen.Oneof) {
+	if oneof.Desc.IsSynthetic() {
+		g.genSynthetic(oneof)
+		return
+	}
 	// if none is populated then return nil
 	g.P("if x.", oneof.GoName, " == nil {")
 	g.P("return nil")
@@ -48,7 +52,21 @@ func (g *whichOneofGen) genOneof(oneof *protogen.Oneof) {
 	g.P("switch x.", oneof.GoName, ".(type) {")
 	for _, field := range oneof.Fields {
 		g.P("case *", g.QualifiedGoIdent(field.GoIdent), ":")
-		g.P("return x.Descriptor().Fields().ByName(\"", field.Desc.Name(), "\")")
+		g.P("return ", messageDescriptorName(g.message), ".Fields().ByName(\"", field.Desc.Name(), "\")")
+	}
+	g.P("}")
+}
+
+// genSynthetic generates the case of the synthetic oneof of a proto3 optional
+// field, which is populated when the field is.
+func (g *whichOneofGen) genSynthetic(oneof *protogen.Oneof) {
+	field := oneof.Fields[0]
+	if _, ok := g.CustomType(field); ok {
+		g.P("if x.", field.GoName, ".Size() == 0 {")
+	} else {
+		g.P("if x.", field.GoName, " == nil {")
 	}
+	g.P("return nil")
 	g.P("}")
+	g.P("return ", messageDescriptorName(g.message), ".Fields().ByName(\"", field.Desc.Name(), "\")")
 }
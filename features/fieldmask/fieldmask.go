@@ -0,0 +1,65 @@
+// Package fieldmask implements the "fieldmask" feature, which generates typed
+// constants for the field mask paths of the fields of every message, e.g.
+// A_MAP_path, so that the paths of google.protobuf.FieldMask are checked at
+// compile time. The paths through singular message fields are built with
+// MessagePath.Sub, e.g. A_MESSAGE_path.Sub(B_X_path).
+package fieldmask
+
+import (
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const fieldmaskPackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime/fieldmask")
+
+func init() {
+	generator.RegisterOptInFeature("fieldmask", func(gen *generator.GeneratedFile, _ *protogen.Plugin) generator.FeatureGenerator {
+		return &fieldmaskFeature{GeneratedFile: gen}
+	})
+}
+
+type fieldmaskFeature struct {
+	*generator.GeneratedFile
+}
+
+func (g *fieldmaskFeature) GenerateFile(file *protogen.File, _ *protogen.Plugin) bool {
+	var generated bool
+	for _, message := range file.Messages {
+		if g.generateMessage(message) {
+			generated = true
+		}
+	}
+	return generated
+}
+
+func (g *fieldmaskFeature) GenerateHelpers() {}
+
+// generateMessage generates the path constants of message and its nested
+// messages, and reports whether any constant was generated.
+func (g *fieldmaskFeature) generateMessage(message *protogen.Message) bool {
+	// map entries are not declared in the schema
+	if message.Desc.IsMapEntry() {
+		return false
+	}
+	generated := len(message.Fields) > 0
+	if generated {
+		name := message.GoIdent.GoName
+		g.P("// Field mask paths of ", message.Desc.FullName(), ".")
+		g.P("const (")
+		for _, field := range message.Fields {
+			if field.Message != nil && !field.Desc.IsList() && !field.Desc.IsMap() {
+				g.P(name, "_", field.GoName, "_path ", fieldmaskPackage.Ident("MessagePath"), "[*", message.GoIdent, ", *", field.Message.GoIdent, "] = \"", field.Desc.Name(), "\"")
+				continue
+			}
+			g.P(name, "_", field.GoName, "_path ", fieldmaskPackage.Ident("Path"), "[*", message.GoIdent, "] = \"", field.Desc.Name(), "\"")
+		}
+		g.P(")")
+		g.P()
+	}
+	for _, nested := range message.Messages {
+		if g.generateMessage(nested) {
+			generated = true
+		}
+	}
+	return generated
+}
@@ -0,0 +1,187 @@
+// Package genid implements the "genid" feature, which generates constants for the
+// names and numbers of the messages, fields, oneofs and enums of a file, in the
+// style of the genid package of the protobuf runtime, e.g. A_Map_field_number,
+// so that reflection-based code fails to compile when the schema changes.
+package genid
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const protoreflectPackage = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
+
+func init() {
+	generator.RegisterOptInFeature("genid", func(gen *generator.GeneratedFile, _ *protogen.Plugin) generator.FeatureGenerator {
+		return &genidFeature{GeneratedFile: gen}
+	})
+}
+
+type genidFeature struct {
+	*generator.GeneratedFile
+}
+
+func (g *genidFeature) GenerateFile(file *protogen.File, plugin *protogen.Plugin) bool {
+	if len(file.Enums) == 0 && len(file.Messages) == 0 {
+		return false
+	}
+	if err := checkCollisions(file, plugin); err != nil {
+		plugin.Error(err)
+		return false
+	}
+	for _, enum := range file.Enums {
+		g.generateEnum(enum)
+	}
+	for _, message := range file.Messages {
+		g.generateMessage(message)
+	}
+	return true
+}
+
+func (g *genidFeature) GenerateHelpers() {}
+
+// The constants of a declaration are named after a common stem, e.g. A_Map_field
+// for the constants A_Map_field_name, A_Map_field_fullname and A_Map_field_number
+// of the field map of the message A.
+
+func enumStem(enum *protogen.Enum) string {
+	return enum.GoIdent.GoName + "_enum"
+}
+
+func messageStem(message *protogen.Message) string {
+	return message.GoIdent.GoName + "_message"
+}
+
+func fieldStem(message *protogen.Message, field *protogen.Field) string {
+	return message.GoIdent.GoName + "_" + field.GoName + "_field"
+}
+
+func oneofStem(message *protogen.Message, oneof *protogen.Oneof) string {
+	return message.GoIdent.GoName + "_" + oneof.GoName + "_oneof"
+}
+
+// declaredOneofs returns the oneofs of message, without the synthetic oneofs of
+// proto3 optional fields, which are not declared in the schema.
+func declaredOneofs(message *protogen.Message) []*protogen.Oneof {
+	var oneofs []*protogen.Oneof
+	for _, oneof := range message.Oneofs {
+		if !oneof.Desc.IsSynthetic() {
+			oneofs = append(oneofs, oneof)
+		}
+	}
+	return oneofs
+}
+
+// rangeStems calls f with the stem of the constants of every declaration of
+// messages and enums, and their nested declarations.
+func rangeStems(messages []*protogen.Message, enums []*protogen.Enum, f func(stem string, desc protoreflect.Descriptor)) {
+	for _, enum := range enums {
+		f(enumStem(enum), enum.Desc)
+	}
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() {
+			continue
+		}
+		f(messageStem(message), message.Desc)
+		for _, field := range message.Fields {
+			f(fieldStem(message, field), field.Desc)
+		}
+		for _, oneof := range declaredOneofs(message) {
+			f(oneofStem(message, oneof), oneof.Desc)
+		}
+		rangeStems(message.Messages, message.Enums, f)
+	}
+}
+
+// checkCollisions reports the constants of file having the same names as other
+// constants of its Go package, such as the ones of the field c of the message
+// A.B and of the field B_C of the message A, both named A_B_C_field_*.
+func checkCollisions(file *protogen.File, plugin *protogen.Plugin) error {
+	seen := make(map[string]protoreflect.Descriptor)
+	var err error
+	for _, f := range plugin.Files {
+		if !f.Generate || f.GoImportPath != file.GoImportPath {
+			continue
+		}
+		rangeStems(f.Messages, f.Enums, func(stem string, desc protoreflect.Descriptor) {
+			prev, ok := seen[stem]
+			if ok && err == nil && (f == file || prev.ParentFile().Path() == file.Desc.Path()) {
+				err = fmt.Errorf("%s: genid constants %s_* of %s collide with the ones of %s, one of them must be renamed",
+					file.Desc.Path(), stem, desc.FullName(), prev.FullName())
+			}
+			seen[stem] = desc
+		})
+	}
+	return err
+}
+
+func (g *genidFeature) generateEnum(enum *protogen.Enum) {
+	name := enumStem(enum)
+	g.P("// Full and short names for ", enum.Desc.FullName(), ".")
+	g.P("const (")
+	g.P(name, "_fullname = \"", enum.Desc.FullName(), "\"")
+	g.P(name, "_name = \"", enum.Desc.Name(), "\"")
+	g.P(")")
+	g.P()
+}
+
+func (g *genidFeature) generateMessage(message *protogen.Message) {
+	// map entries are not declared in the schema
+	if message.Desc.IsMapEntry() {
+		return
+	}
+	nameType, fullNameType := protoreflectPackage.Ident("Name"), protoreflectPackage.Ident("FullName")
+
+	g.P("// Names for ", message.Desc.FullName(), ".")
+	g.P("const (")
+	g.P(messageStem(message), "_name ", nameType, " = \"", message.Desc.Name(), "\"")
+	g.P(messageStem(message), "_fullname ", fullNameType, " = \"", message.Desc.FullName(), "\"")
+	g.P(")")
+	g.P()
+
+	if len(message.Fields) > 0 {
+		g.P("// Field names for ", message.Desc.FullName(), ".")
+		g.P("const (")
+		for _, field := range message.Fields {
+			g.P(fieldStem(message, field), "_name ", nameType, " = \"", field.Desc.Name(), "\"")
+		}
+		g.P()
+		for _, field := range message.Fields {
+			g.P(fieldStem(message, field), "_fullname ", fullNameType, " = \"", field.Desc.FullName(), "\"")
+		}
+		g.P(")")
+		g.P()
+
+		g.P("// Field numbers for ", message.Desc.FullName(), ".")
+		g.P("const (")
+		for _, field := range message.Fields {
+			g.P(fieldStem(message, field), "_number ", protoreflectPackage.Ident("FieldNumber"), " = ", field.Desc.Number())
+		}
+		g.P(")")
+		g.P()
+	}
+
+	if oneofs := declaredOneofs(message); len(oneofs) > 0 {
+		g.P("// Oneof names for ", message.Desc.FullName(), ".")
+		g.P("const (")
+		for _, oneof := range oneofs {
+			g.P(oneofStem(message, oneof), "_name ", nameType, " = \"", oneof.Desc.Name(), "\"")
+		}
+		g.P()
+		for _, oneof := range oneofs {
+			g.P(oneofStem(message, oneof), "_fullname ", fullNameType, " = \"", oneof.Desc.FullName(), "\"")
+		}
+		g.P(")")
+		g.P()
+	}
+
+	for _, enum := range message.Enums {
+		g.generateEnum(enum)
+	}
+	for _, nested := range message.Messages {
+		g.generateMessage(nested)
+	}
+}
@@ -0,0 +1,309 @@
+// Package grpc implements the "grpc" feature, which generates the gRPC client and
+// server stubs and the service descriptors of the services of a file, in the same
+// way as protoc-gen-go-grpc. The stubs use the generic stream types of grpc-go and
+// thus require grpc-go v1.64.0 or later.
+package grpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const (
+	contextPackage = protogen.GoImportPath("context")
+	grpcPackage    = protogen.GoImportPath("google.golang.org/grpc")
+	codesPackage   = protogen.GoImportPath("google.golang.org/grpc/codes")
+	statusPackage  = protogen.GoImportPath("google.golang.org/grpc/status")
+)
+
+func init() {
+	generator.RegisterOptInFeature("grpc", func(gen *generator.GeneratedFile, plugin *protogen.Plugin) generator.FeatureGenerator {
+		return &grpcFeature{
+			GeneratedFile: gen,
+			plugin:        plugin,
+		}
+	})
+}
+
+type grpcFeature struct {
+	*generator.GeneratedFile
+	plugin *protogen.Plugin
+}
+
+func (g *grpcFeature) GenerateFile(file *protogen.File, _ *protogen.Plugin) bool {
+	if len(file.Services) == 0 {
+		return false
+	}
+
+	g.P("// This is a compile-time assertion to ensure that this generated file")
+	g.P("// is compatible with the grpc package it is being compiled against.")
+	g.P("// Requires gRPC-Go v1.64.0 or later.")
+	g.P("const _ = ", grpcPackage.Ident("SupportPackageIsVersion9"))
+	g.P()
+
+	for _, service := range file.Services {
+		g.generateService(file, service)
+	}
+	return true
+}
+
+func (g *grpcFeature) GenerateHelpers() {}
+
+func (g *grpcFeature) generateService(file *protogen.File, service *protogen.Service) {
+	g.P("const (")
+	for _, method := range service.Methods {
+		g.P(fullMethodNameConst(service, method), " = ", strconv.Quote(fullMethodName(service, method)))
+	}
+	g.P(")")
+	g.P()
+
+	g.generateClient(service)
+	g.generateServer(service)
+	g.generateServiceDesc(file, service)
+}
+
+func (g *grpcFeature) generateClient(service *protogen.Service) {
+	clientName := service.GoName + "Client"
+
+	g.P("// ", clientName, " is the client API for ", service.GoName, " service.")
+	g.P("//")
+	g.P("// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.")
+	g.P("type ", clientName, " interface {")
+	for _, method := range service.Methods {
+		g.P(method.Comments.Leading, g.clientSignature(method))
+	}
+	g.P("}")
+	g.P()
+
+	g.P("type ", unexport(clientName), " struct {")
+	g.P("cc ", grpcPackage.Ident("ClientConnInterface"))
+	g.P("}")
+	g.P()
+
+	g.P("func New", clientName, "(cc ", grpcPackage.Ident("ClientConnInterface"), ") ", clientName, " {")
+	g.P("return &", unexport(clientName), "{cc}")
+	g.P("}")
+	g.P()
+
+	var streamIndex int
+	for _, method := range service.Methods {
+		g.generateClientMethod(service, method, streamIndex)
+		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+			streamIndex++
+		}
+	}
+}
+
+func (g *grpcFeature) generateClientMethod(service *protogen.Service, method *protogen.Method, streamIndex int) {
+	g.P("func (c *", unexport(service.GoName), "Client) ", g.clientSignature(method), " {")
+	g.P("cOpts := append([]", grpcPackage.Ident("CallOption"), "{", grpcPackage.Ident("StaticMethod"), "()}, opts...)")
+
+	if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+		g.P("out := new(", method.Output.GoIdent, ")")
+		g.P("err := c.cc.Invoke(ctx, ", fullMethodNameConst(service, method), ", in, out, cOpts...)")
+		g.P("if err != nil { return nil, err }")
+		g.P("return out, nil")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	g.P("stream, err := c.cc.NewStream(ctx, &", serviceDescName(service), ".Streams[", streamIndex, "], ", fullMethodNameConst(service, method), ", cOpts...)")
+	g.P("if err != nil { return nil, err }")
+	g.P("x := &", grpcPackage.Ident("GenericClientStream"), "[", method.Input.GoIdent, ", ", method.Output.GoIdent, "]{ClientStream: stream}")
+	if !method.Desc.IsStreamingClient() {
+		g.P("if err := x.ClientStream.SendMsg(in); err != nil { return nil, err }")
+		g.P("if err := x.ClientStream.CloseSend(); err != nil { return nil, err }")
+	}
+	g.P("return x, nil")
+	g.P("}")
+	g.P()
+}
+
+func (g *grpcFeature) generateServer(service *protogen.Service) {
+	serverName := service.GoName + "Server"
+	mustEmbed := "mustEmbedUnimplemented" + serverName
+
+	g.P("// ", serverName, " is the server API for ", service.GoName, " service.")
+	g.P("// All implementations must embed Unimplemented", serverName)
+	g.P("// for forward compatibility.")
+	g.P("type ", serverName, " interface {")
+	for _, method := range service.Methods {
+		g.P(method.Comments.Leading, g.serverSignature(method))
+	}
+	g.P(mustEmbed, "()")
+	g.P("}")
+	g.P()
+
+	g.P("// Unimplemented", serverName, " must be embedded to have forward compatible implementations.")
+	g.P("type Unimplemented", serverName, " struct{}")
+	g.P()
+	for _, method := range service.Methods {
+		g.P("func (Unimplemented", serverName, ") ", g.serverSignature(method), " {")
+		msg := fmt.Sprintf("%q", "method "+method.GoName+" not implemented")
+		if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+			g.P("return nil, ", statusPackage.Ident("Errorf"), "(", codesPackage.Ident("Unimplemented"), ", ", msg, ")")
+		} else {
+			g.P("return ", statusPackage.Ident("Errorf"), "(", codesPackage.Ident("Unimplemented"), ", ", msg, ")")
+		}
+		g.P("}")
+	}
+	g.P("func (Unimplemented", serverName, ") ", mustEmbed, "() {}")
+	g.P()
+
+	g.P("// Unsafe", serverName, " may be embedded to opt out of forward compatibility for this service.")
+	g.P("// Use of this interface is not recommended, as added methods to ", serverName, " will")
+	g.P("// result in compilation errors.")
+	g.P("type Unsafe", serverName, " interface {")
+	g.P(mustEmbed, "()")
+	g.P("}")
+	g.P()
+
+	g.P("func Register", serverName, "(s ", grpcPackage.Ident("ServiceRegistrar"), ", srv ", serverName, ") {")
+	g.P("s.RegisterService(&", serviceDescName(service), ", srv)")
+	g.P("}")
+	g.P()
+
+	for _, method := range service.Methods {
+		g.generateServerHandler(service, method)
+	}
+}
+
+func (g *grpcFeature) generateServerHandler(service *protogen.Service, method *protogen.Method) {
+	serverName := service.GoName + "Server"
+	handler := handlerName(service, method)
+
+	if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+		g.P("func ", handler, "(srv interface{}, ctx ", contextPackage.Ident("Context"), ", dec func(interface{}) error, interceptor ", grpcPackage.Ident("UnaryServerInterceptor"), ") (interface{}, error) {")
+		g.P("in := new(", method.Input.GoIdent, ")")
+		g.P("if err := dec(in); err != nil { return nil, err }")
+		g.P("if interceptor == nil { return srv.(", serverName, ").", method.GoName, "(ctx, in) }")
+		g.P("info := &", grpcPackage.Ident("UnaryServerInfo"), "{")
+		g.P("Server: srv,")
+		g.P("FullMethod: ", fullMethodNameConst(service, method), ",")
+		g.P("}")
+		g.P("handler := func(ctx ", contextPackage.Ident("Context"), ", req interface{}) (interface{}, error) {")
+		g.P("return srv.(", serverName, ").", method.GoName, "(ctx, req.(*", method.Input.GoIdent, "))")
+		g.P("}")
+		g.P("return interceptor(ctx, in, info, handler)")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	g.P("func ", handler, "(srv interface{}, stream ", grpcPackage.Ident("ServerStream"), ") error {")
+	stream := []interface{}{"&", grpcPackage.Ident("GenericServerStream"), "[", method.Input.GoIdent, ", ", method.Output.GoIdent, "]{ServerStream: stream}"}
+	if !method.Desc.IsStreamingClient() {
+		g.P("m := new(", method.Input.GoIdent, ")")
+		g.P("if err := stream.RecvMsg(m); err != nil { return err }")
+		g.P(append([]interface{}{"return srv.(", serverName, ").", method.GoName, "(m, "}, append(stream, ")")...)...)
+	} else {
+		g.P(append([]interface{}{"return srv.(", serverName, ").", method.GoName, "("}, append(stream, ")")...)...)
+	}
+	g.P("}")
+	g.P()
+}
+
+func (g *grpcFeature) generateServiceDesc(file *protogen.File, service *protogen.Service) {
+	g.P("// ", serviceDescName(service), " is the ", grpcPackage.Ident("ServiceDesc"), " for ", service.GoName, " service.")
+	g.P("// It's only intended for direct use with ", grpcPackage.Ident("RegisterService"), ",")
+	g.P("// and not to be introspected or modified (even as a copy)")
+	g.P("var ", serviceDescName(service), " = ", grpcPackage.Ident("ServiceDesc"), "{")
+	g.P("ServiceName: ", strconv.Quote(string(service.Desc.FullName())), ",")
+	g.P("HandlerType: (*", service.GoName, "Server)(nil),")
+	g.P("Methods: []", grpcPackage.Ident("MethodDesc"), "{")
+	for _, method := range service.Methods {
+		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+			continue
+		}
+		g.P("{")
+		g.P("MethodName: ", strconv.Quote(string(method.Desc.Name())), ",")
+		g.P("Handler: ", handlerName(service, method), ",")
+		g.P("},")
+	}
+	g.P("},")
+	g.P("Streams: []", grpcPackage.Ident("StreamDesc"), "{")
+	for _, method := range service.Methods {
+		if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+			continue
+		}
+		g.P("{")
+		g.P("StreamName: ", strconv.Quote(string(method.Desc.Name())), ",")
+		g.P("Handler: ", handlerName(service, method), ",")
+		if method.Desc.IsStreamingServer() {
+			g.P("ServerStreams: true,")
+		}
+		if method.Desc.IsStreamingClient() {
+			g.P("ClientStreams: true,")
+		}
+		g.P("},")
+	}
+	g.P("},")
+	g.P("Metadata: ", strconv.Quote(file.Desc.Path()), ",")
+	g.P("}")
+	g.P()
+}
+
+func (g *grpcFeature) clientSignature(method *protogen.Method) string {
+	s := method.GoName + "(ctx " + g.QualifiedGoIdent(contextPackage.Ident("Context"))
+	if !method.Desc.IsStreamingClient() {
+		s += ", in *" + g.QualifiedGoIdent(method.Input.GoIdent)
+	}
+	s += ", opts ..." + g.QualifiedGoIdent(grpcPackage.Ident("CallOption")) + ") ("
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		s += g.streamType("BidiStreamingClient", method.Input.GoIdent, method.Output.GoIdent)
+	case method.Desc.IsStreamingClient():
+		s += g.streamType("ClientStreamingClient", method.Input.GoIdent, method.Output.GoIdent)
+	case method.Desc.IsStreamingServer():
+		s += g.streamType("ServerStreamingClient", method.Output.GoIdent)
+	default:
+		s += "*" + g.QualifiedGoIdent(method.Output.GoIdent)
+	}
+	return s + ", error)"
+}
+
+func (g *grpcFeature) serverSignature(method *protogen.Method) string {
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		return method.GoName + "(" + g.streamType("BidiStreamingServer", method.Input.GoIdent, method.Output.GoIdent) + ") error"
+	case method.Desc.IsStreamingClient():
+		return method.GoName + "(" + g.streamType("ClientStreamingServer", method.Input.GoIdent, method.Output.GoIdent) + ") error"
+	case method.Desc.IsStreamingServer():
+		return method.GoName + "(*" + g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.streamType("ServerStreamingServer", method.Output.GoIdent) + ") error"
+	default:
+		return method.GoName + "(" + g.QualifiedGoIdent(contextPackage.Ident("Context")) + ", *" + g.QualifiedGoIdent(method.Input.GoIdent) + ") (*" + g.QualifiedGoIdent(method.Output.GoIdent) + ", error)"
+	}
+}
+
+func (g *grpcFeature) streamType(name string, params ...protogen.GoIdent) string {
+	args := make([]string, len(params))
+	for i, p := range params {
+		args[i] = g.QualifiedGoIdent(p)
+	}
+	return g.QualifiedGoIdent(grpcPackage.Ident(name)) + "[" + strings.Join(args, ", ") + "]"
+}
+
+func fullMethodName(service *protogen.Service, method *protogen.Method) string {
+	return "/" + string(service.Desc.FullName()) + "/" + string(method.Desc.Name())
+}
+
+func fullMethodNameConst(service *protogen.Service, method *protogen.Method) string {
+	return service.GoName + "_" + method.GoName + "_FullMethodName"
+}
+
+func serviceDescName(service *protogen.Service) string {
+	return service.GoName + "_ServiceDesc"
+}
+
+func handlerName(service *protogen.Service, method *protogen.Method) string {
+	return "_" + service.GoName + "_" + method.GoName + "_Handler"
+}
+
+func unexport(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
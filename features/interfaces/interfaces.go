@@ -0,0 +1,207 @@
+// Package interfaces implements the "interfaces" feature, which generates a Go
+// interface for every interface declared with cosmos_proto declare_interface,
+// makes the messages listing it in implements_interface satisfy it, and generates
+// a RegisterInterfaceImplementations function per Go package.
+package interfaces
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/declaration"
+	"github.com/cosmos/cosmos-proto/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	protoPackage   = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	runtimePackage = protogen.GoImportPath("github.com/cosmos/cosmos-proto/runtime")
+)
+
+func init() {
+	generator.RegisterOptInFeature("interfaces", func(gen *generator.GeneratedFile, plugin *protogen.Plugin) generator.FeatureGenerator {
+		return &interfacesFeature{
+			GeneratedFile: gen,
+			plugin:        plugin,
+		}
+	})
+}
+
+type interfacesFeature struct {
+	*generator.GeneratedFile
+	plugin *protogen.Plugin
+	file   *protogen.File
+}
+
+// interfaceDecl is an interface declared with declare_interface.
+type interfaceDecl struct {
+	desc    *cosmos_proto.InterfaceDescriptor
+	goIdent protogen.GoIdent
+}
+
+// MarkerMethod returns the name of the method identifying the implementations of
+// the interface with the given fully-qualified name, e.g. IsCosmosBaseV1beta1Msg
+// for cosmos.base.v1beta1.Msg. It is derived from the full name alone so that
+// implementations do not depend on the Go package of the declaration.
+func MarkerMethod(iface string) string {
+	var sb strings.Builder
+	sb.WriteString("Is")
+	for _, part := range strings.Split(iface, ".") {
+		sb.WriteString(exported(part))
+	}
+	return sb.String()
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// declarations returns the interfaces declared in the files of the request which
+// are generated. The other files may have been generated without the interfaces
+// feature, so their interfaces may have no Go type.
+func (g *interfacesFeature) declarations() map[string]interfaceDecl {
+	decls := make(map[string]interfaceDecl)
+	for _, file := range g.plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		for _, desc := range declaration.Interfaces(file.Desc) {
+			decls[declaration.FullName(file.Desc, desc.Name)] = interfaceDecl{
+				desc:    desc,
+				goIdent: file.GoImportPath.Ident(exported(desc.Name)),
+			}
+		}
+	}
+	return decls
+}
+
+func (g *interfacesFeature) GenerateFile(file *protogen.File, _ *protogen.Plugin) bool {
+	g.file = file
+	decls := g.declarations()
+
+	for _, desc := range declaration.Interfaces(file.Desc) {
+		name := declaration.FullName(file.Desc, desc.Name)
+		if err := g.checkConflict(file, decls[name].goIdent.GoName); err != nil {
+			g.plugin.Error(err)
+			return false
+		}
+		g.generateInterface(name, decls[name])
+	}
+
+	for _, msg := range allMessages(file.Messages) {
+		g.generateImplementation(msg, decls)
+	}
+	return true
+}
+
+// checkConflict reports Go identifiers of the package of file clashing with an interface.
+func (g *interfacesFeature) checkConflict(file *protogen.File, goName string) error {
+	for _, f := range g.plugin.Files {
+		if f.GoImportPath != file.GoImportPath {
+			continue
+		}
+		for _, msg := range allMessages(f.Messages) {
+			if msg.GoIdent.GoName == goName {
+				return fmt.Errorf("%s: interface %s conflicts with message %s", file.Desc.Path(), goName, msg.Desc.FullName())
+			}
+		}
+		for _, enum := range f.Enums {
+			if enum.GoIdent.GoName == goName {
+				return fmt.Errorf("%s: interface %s conflicts with enum %s", file.Desc.Path(), goName, enum.Desc.FullName())
+			}
+		}
+	}
+	return nil
+}
+
+func (g *interfacesFeature) generateInterface(name string, decl interfaceDecl) {
+	if decl.desc.Description != "" {
+		for _, line := range strings.Split(strings.TrimSpace(decl.desc.Description), "\n") {
+			g.P("// ", strings.TrimSpace(line))
+		}
+	} else {
+		g.P("// ", decl.goIdent.GoName, " is the ", name, " interface.")
+	}
+	g.P("//")
+	g.P("// Messages implement it by listing ", name, " in their cosmos_proto.implements_interface option.")
+	g.P("type ", decl.goIdent.GoName, " interface {")
+	g.P(protoPackage.Ident("Message"))
+	g.P(MarkerMethod(name), "()")
+	g.P("}")
+	g.P()
+}
+
+func (g *interfacesFeature) generateImplementation(msg *protogen.Message, decls map[string]interfaceDecl) {
+	for _, iface := range implementedInterfaces(msg) {
+		g.P("// ", MarkerMethod(iface), " marks ", msg.GoIdent.GoName, " as an implementation of ", iface, ".")
+		g.P("func (*", msg.GoIdent.GoName, ") ", MarkerMethod(iface), "() {}")
+		g.P()
+		// the declaration can only be checked when it is generated along with the implementation
+		if decl, ok := decls[iface]; ok {
+			g.P("var _ ", decl.goIdent, " = (*", msg.GoIdent.GoName, ")(nil)")
+			g.P()
+		}
+	}
+}
+
+// GenerateHelpers generates the RegisterInterfaceImplementations function of the Go
+// package of the file, listing the implementations of all the files of the package
+// which are generated. All the files of a package must thus be generated together.
+func (g *interfacesFeature) GenerateHelpers() {
+	impls := make(map[string][]protogen.GoIdent)
+	for _, file := range g.plugin.Files {
+		if !file.Generate || file.GoImportPath != g.file.GoImportPath {
+			continue
+		}
+		for _, msg := range allMessages(file.Messages) {
+			for _, iface := range implementedInterfaces(msg) {
+				impls[iface] = append(impls[iface], msg.GoIdent)
+			}
+		}
+	}
+	if len(impls) == 0 {
+		return
+	}
+
+	ifaces := make([]string, 0, len(impls))
+	for iface := range impls {
+		ifaces = append(ifaces, iface)
+	}
+	sort.Strings(ifaces)
+
+	g.P("// RegisterInterfaceImplementations registers the messages of this package")
+	g.P("// implementing interfaces declared with cosmos_proto.declare_interface.")
+	g.P("func RegisterInterfaceImplementations(registry ", runtimePackage.Ident("InterfaceRegistry"), ") {")
+	for _, iface := range ifaces {
+		g.P("registry.RegisterImplementations(", fmt.Sprintf("%q", iface), ",")
+		for _, ident := range impls[iface] {
+			g.P("(*", ident.GoName, ")(nil),")
+		}
+		g.P(")")
+	}
+	g.P("}")
+	g.P()
+}
+
+func implementedInterfaces(msg *protogen.Message) []string {
+	return proto.GetExtension(msg.Desc.Options(), cosmos_proto.E_ImplementsInterface).([]string)
+}
+
+// allMessages returns msgs and their nested messages, excluding map entries.
+func allMessages(msgs []*protogen.Message) []*protogen.Message {
+	var all []*protogen.Message
+	for _, msg := range msgs {
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		all = append(all, msg)
+		all = append(all, allMessages(msg.Messages)...)
+	}
+	return all
+}
@@ -1627,6 +1627,14 @@ func genMessageGetterMethods(g *generator.GeneratedFile, f *fileInfo, m *message
 			g.P("}")
 			g.P("return ", protoimplPackage.Ident("X"), ".GetWeak(w, ", field.Desc.Number(), ", ", strconv.Quote(string(field.Message.Desc.FullName())), ")")
 			g.P("}")
+		case isCustomType(g, field):
+			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
+			g.P("var v ", goType)
+			g.P("if x != nil {")
+			g.P("v = x.", field.GoName)
+			g.P("}")
+			g.P("return v")
+			g.P("}")
 		case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
 			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
 			g.P("if x, ok := x.Get", field.Oneof.GoName, "().(*", field.GoIdent, "); ok {")
@@ -1686,6 +1694,9 @@ func fieldGoType(g *generator.GeneratedFile, f *fileInfo, field *protogen.Field)
 	if field.Desc.IsWeak() {
 		return "struct{}", false
 	}
+	if ident, ok := g.CustomType(field); ok {
+		return g.QualifiedGoIdent(ident), false
+	}
 
 	pointer = field.Desc.HasPresence()
 	switch field.Desc.Kind() {
@@ -1725,6 +1736,11 @@ func fieldGoType(g *generator.GeneratedFile, f *fileInfo, field *protogen.Field)
 	return goType, pointer
 }
 
+func isCustomType(g *generator.GeneratedFile, field *protogen.Field) bool {
+	_, ok := g.CustomType(field)
+	return ok
+}
+
 func fieldProtobufTagValue(field *protogen.Field) string {
 	var enumName string
 	if field.Desc.Kind() == protoreflect.EnumKind {
@@ -2011,24 +2027,27 @@ func TagMarshal(fd pref.FieldDescriptor, enumName string) string {
 }
 
 func genMessageReflectMethods(g *generator.GeneratedFile, f *fileInfo, m *messageInfo) {
-	// This is being handled by the fast reflection feature.
+	// This is being handled by the fast reflection feature, unless the message is filtered out.
+	if g.FastReflection(m.Message) {
+		return
+	}
 
-	//idx := f.allMessagesByPtr[m]
-	//typesVar := messageTypesVarName(f)
+	idx := f.allMessagesByPtr[m]
+	typesVar := messageTypesVarName(f)
 
 	// ProtoReflect method.
-	//g.P("func (x *", m.GoIdent, ") ProtoReflect() ", protoreflectPackage.Ident("Message"), " {")
-	//g.P("mi := &", typesVar, "[", idx, "]")
-	//g.P("if ", protoimplPackage.Ident("UnsafeEnabled"), " && x != nil {")
-	//g.P("ms := ", protoimplPackage.Ident("X"), ".MessageStateOf(", protoimplPackage.Ident("Pointer"), "(x))")
-	//g.P("if ms.LoadMessageInfo() == nil {")
-	//g.P("ms.StoreMessageInfo(mi)")
-	//g.P("}")
-	//g.P("return ms")
-	//g.P("}")
-	//g.P("return mi.MessageOf(x)")
-	//g.P("}")
-	//g.P()
+	g.P("func (x *", m.GoIdent, ") ProtoReflect() ", protoreflectPackage.Ident("Message"), " {")
+	g.P("mi := &", typesVar, "[", idx, "]")
+	g.P("if ", protoimplPackage.Ident("UnsafeEnabled"), " && x != nil {")
+	g.P("ms := ", protoimplPackage.Ident("X"), ".MessageStateOf(", protoimplPackage.Ident("Pointer"), "(x))")
+	g.P("if ms.LoadMessageInfo() == nil {")
+	g.P("ms.StoreMessageInfo(mi)")
+	g.P("}")
+	g.P("return ms")
+	g.P("}")
+	g.P("return mi.MessageOf(x)")
+	g.P("}")
+	g.P()
 }
 
 // DefValMarshal serializes v as the default string according to the given kind k.
@@ -0,0 +1,30 @@
+package generator
+
+import "fmt"
+
+// CodecMode is the generation mode of the size, marshal and unmarshal methods
+// of the messages with fast reflection.
+type CodecMode string
+
+const (
+	// CodecUnrolled generates the methods as code unrolled for every field.
+	CodecUnrolled CodecMode = "unrolled"
+	// CodecTable generates a table describing the fields of every message,
+	// encoded and decoded by the runtime/table package.
+	CodecTable CodecMode = "table"
+)
+
+// ParseCodecMode parses the name of a CodecMode.
+func ParseCodecMode(s string) (CodecMode, error) {
+	switch mode := CodecMode(s); mode {
+	case CodecUnrolled, CodecTable:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown codec %q, expected %s or %s", s, CodecUnrolled, CodecTable)
+	}
+}
+
+// TableCodec reports whether the methods of the messages are table-driven.
+func (p *GeneratedFile) TableCodec() bool {
+	return p.Ext != nil && p.Ext.Codec == CodecTable
+}
@@ -11,49 +11,70 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
-var defaultFeatures = make(map[string]Feature)
+var (
+	defaultFeatures = make(map[string]Feature)
+	optInFeatures   = make(map[string]Feature)
+)
+
+// namedFeature is a feature with the name it is registered with.
+type namedFeature struct {
+	name string
+	feat Feature
+}
 
-func findFeatures(featureNames []string) ([]Feature, error) {
+func findFeatures(featureNames []string) ([]namedFeature, map[string]bool, error) {
 	required := make(map[string]Feature)
 	for _, name := range featureNames {
 		if name == "all" {
-			required = defaultFeatures
-			break
+			for name, feat := range defaultFeatures {
+				required[name] = feat
+			}
+			continue
 		}
 
 		feat, ok := defaultFeatures[name]
 		if !ok {
-			return nil, fmt.Errorf("unknown feature: %q", name)
+			feat, ok = optInFeatures[name]
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown feature: %q", name)
 		}
 		required[name] = feat
 	}
 
-	type namefeat struct {
-		name string
-		feat Feature
-	}
-	var sorted []namefeat
+	var sorted []namedFeature
+	enabled := make(map[string]bool, len(required))
 	for name, feat := range required {
-		sorted = append(sorted, namefeat{name, feat})
+		sorted = append(sorted, namedFeature{name, feat})
+		enabled[name] = true
 	}
 	sort.Slice(sorted, func(i, j int) bool {
 		return sorted[i].name < sorted[j].name
 	})
-
-	var features []Feature
-	for _, sp := range sorted {
-		features = append(features, sp.feat)
-	}
-	return features, nil
+	return sorted, enabled, nil
 }
 
 func RegisterFeature(name string, feat Feature) {
 	defaultFeatures[name] = feat
 }
 
+// RegisterOptInFeature registers a feature which is only generated when
+// requested by name, e.g. features=all+name, and not by "all" alone.
+func RegisterOptInFeature(name string, feat Feature) {
+	optInFeatures[name] = feat
+}
+
 type Feature func(gen *GeneratedFile, plugin *protogen.Plugin) FeatureGenerator
 
 type FeatureGenerator interface {
 	GenerateFile(file *protogen.File, plugin *protogen.Plugin) bool
 	GenerateHelpers()
 }
+
+// FallbackGenerator is implemented by the feature generators which, when the
+// features are generated in their own files, generate the declarations which the
+// other features require in a file compiled only when the feature is excluded
+// from the build. See Generator.GenerateFeatureFiles.
+type FallbackGenerator interface {
+	GenerateFallback(file *protogen.File)
+}
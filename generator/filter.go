@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageFilter selects the messages whose fast reflection is generated by their
+// fully-qualified names, using path.Match globs such as cosmos.bank.v1beta1.Msg*
+// or cosmos.bank.* for the messages of a package and its sub packages. The other
+// messages use the slow reflection of the protobuf runtime.
+type MessageFilter struct {
+	// Include lists the globs of the selected messages, all the messages
+	// being selected when it is empty.
+	Include []string
+	// Exclude lists the globs of the messages which are not selected,
+	// even if they match Include.
+	Exclude []string
+}
+
+// Match reports whether the message with the given name is selected by the filter.
+// A nil filter selects all the messages.
+func (f *MessageFilter) Match(name protoreflect.FullName) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Include) > 0 && !matchAny(f.Include, string(name)) {
+		return false
+	}
+	return !matchAny(f.Exclude, string(name))
+}
+
+func matchAny(globs []string, name string) bool {
+	for _, glob := range globs {
+		if ok, _ := path.Match(glob, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *MessageFilter) validate() error {
+	if f == nil {
+		return nil
+	}
+	for _, glob := range append(f.Include[:len(f.Include):len(f.Include)], f.Exclude...) {
+		if _, err := path.Match(glob, ""); err != nil {
+			return fmt.Errorf("invalid message filter %q: %w", glob, err)
+		}
+	}
+	return nil
+}
+
+// FastReflection reports whether the fast reflection of message is generated,
+// which is when the "fast" feature is enabled, the filter selects message and
+// fast reflection supports its fields. The protoc feature generates the slow
+// reflection of the other messages.
+func (p *GeneratedFile) FastReflection(message *protogen.Message) bool {
+	if !p.Features["fast"] || unsupportedField(message) != nil {
+		return false
+	}
+	if p.Ext == nil {
+		return true
+	}
+	return p.Ext.Filter.Match(message.Desc.FullName())
+}
+
+// unsupportedField returns the first field of message which is not supported by
+// fast reflection, or nil: the groups.
+func unsupportedField(message *protogen.Message) *protogen.Field {
+	for _, field := range message.Fields {
+		if field.Desc.Kind() == protoreflect.GroupKind {
+			return field
+		}
+	}
+	return nil
+}
+
+// checkFilter makes sure that the messages with fields mapped to custom types,
+// which are only supported by fast reflection, are selected by the filter and
+// supported by fast reflection.
+func checkFilter(files []*protogen.File, ext *Extensions) error {
+	if ext == nil || len(ext.ScalarTypes) == 0 {
+		return nil
+	}
+
+	var checkMessage func(message *protogen.Message) error
+	checkMessage = func(message *protogen.Message) error {
+		unsupported := unsupportedField(message)
+		if unsupported != nil || !ext.Filter.Match(message.Desc.FullName()) {
+			for _, field := range message.Fields {
+				ident, ok := ext.ScalarTypes[ScalarName(field)]
+				switch {
+				case !ok:
+				case unsupported != nil:
+					return fmt.Errorf("field %s of message %s is not supported by fast reflection, but its field %s is mapped to the custom type %s, which requires it",
+						unsupported.Desc.Name(), message.Desc.FullName(), field.Desc.Name(), ident)
+				default:
+					return fmt.Errorf("message %s is filtered out of fast reflection, but its field %s is mapped to the custom type %s, which requires it",
+						message.Desc.FullName(), field.Desc.Name(), ident)
+				}
+			}
+		}
+		for _, nested := range message.Messages {
+			if err := checkMessage(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, f := range files {
+		if !f.Generate {
+			continue
+		}
+		for _, message := range f.Messages {
+			if err := checkMessage(message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
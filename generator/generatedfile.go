@@ -14,6 +14,8 @@ type GeneratedFile struct {
 	*protogen.GeneratedFile
 	Ext           *Extensions
 	LocalPackages map[string]bool
+	// Features is the set of the names of the generated features.
+	Features map[string]bool
 }
 
 func (p *GeneratedFile) Ident(path, ident string) string {
@@ -24,6 +26,9 @@ func (p *GeneratedFile) FieldGoType(field *protogen.Field) (goType string, point
 	if field.Desc.IsWeak() {
 		return "struct{}", false
 	}
+	if ident, ok := p.CustomType(field); ok {
+		return p.QualifiedGoIdent(ident), false
+	}
 
 	pointer = field.Desc.HasPresence()
 	switch field.Desc.Kind() {
@@ -63,6 +68,12 @@ func (p *GeneratedFile) FieldGoType(field *protogen.Field) (goType string, point
 	return goType, pointer
 }
 
+// StableRange reports whether the fast reflection Range visits the fields and
+// map entries in a deterministic order.
+func (p *GeneratedFile) StableRange() bool {
+	return p.Ext != nil && p.Ext.StableRange
+}
+
 func (p *GeneratedFile) IsLocalMessage(message *protogen.Message) bool {
 	pkg := string(message.Desc.ParentFile().Package())
 	return p.LocalPackages[pkg]
@@ -16,17 +16,32 @@ type featureHelpers struct {
 
 type Extensions struct {
 	Poolable map[protogen.GoIdent]bool
+	// ScalarTypes maps fully-qualified cosmos_proto scalar names to the
+	// custom Go types used for the fields annotated with them.
+	ScalarTypes map[string]protogen.GoIdent
+	// Filter selects the messages whose fast reflection is generated.
+	Filter *MessageFilter
+	// ReservedNames is the handling of the fields conflicting with the methods of
+	// protoreflect.Message, which defaults to ReservedNamesSuffix.
+	ReservedNames ReservedNamesMode
+	// Codec is the generation mode of the size, marshal and unmarshal methods of
+	// the messages, which defaults to CodecUnrolled.
+	Codec CodecMode
+	// StableRange makes the fast reflection Range visit the populated fields
+	// by number, and the entries of maps by key.
+	StableRange bool
 }
 
 type Generator struct {
 	seen     map[featureHelpers]bool
 	ext      *Extensions
-	features []Feature
+	features []namedFeature
+	enabled  map[string]bool
 	local    map[string]bool
 }
 
 func NewGenerator(allFiles []*protogen.File, featureNames []string, ext *Extensions) (*Generator, error) {
-	features, err := findFeatures(featureNames)
+	features, enabled, err := findFeatures(featureNames)
 
 	if err != nil {
 		return nil, err
@@ -39,44 +54,70 @@ func NewGenerator(allFiles []*protogen.File, featureNames []string, ext *Extensi
 		}
 	}
 
+	if err := checkScalarTypes(allFiles, ext); err != nil {
+		return nil, err
+	}
+
+	if ext != nil {
+		if err := ext.Filter.validate(); err != nil {
+			return nil, err
+		}
+		if enabled["fast"] {
+			if err := checkFilter(allFiles, ext); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return &Generator{
 		seen:     make(map[featureHelpers]bool),
 		ext:      ext,
 		features: features,
+		enabled:  enabled,
 		local:    local,
 	}, nil
 }
 
 func (gen *Generator) GenerateFile(plugin *protogen.Plugin, gf *protogen.GeneratedFile, file *protogen.File) bool {
-	if file.Desc.Syntax() != protoreflect.Proto3 {
+	if file.Desc.Syntax() != protoreflect.Proto3 && file.Desc.Syntax() != protoreflect.Proto2 {
 		return false
 	}
 
-	p := &GeneratedFile{
-		GeneratedFile: gf,
-		Ext:           gen.ext,
-		LocalPackages: gen.local,
-	}
+	p := gen.wrap(gf)
 
 	// DEPRECATED: this was used for our fork/copy of protoc-gen-go
 	// GenerateProtocGenGo(plugin, p, file)
 
 	var generated bool
 	for fidx, feat := range gen.features {
-		featGenerator := feat(p, plugin)
+		featGenerator := feat.feat(p, plugin)
 		if featGenerator.GenerateFile(file, plugin) {
 			generated = true
 
-			helpersForPlugin := featureHelpers{
-				path:    file.GoImportPath,
-				feature: fidx,
-			}
-			if !gen.seen[helpersForPlugin] {
-				featGenerator.GenerateHelpers()
-				gen.seen[helpersForPlugin] = true
-			}
+			gen.generateHelpers(featGenerator, file, fidx)
 		}
 	}
 
 	return generated
 }
+
+func (gen *Generator) wrap(gf *protogen.GeneratedFile) *GeneratedFile {
+	return &GeneratedFile{
+		GeneratedFile: gf,
+		Ext:           gen.ext,
+		LocalPackages: gen.local,
+		Features:      gen.enabled,
+	}
+}
+
+// generateHelpers generates the helpers of the feature at index fidx once per Go package.
+func (gen *Generator) generateHelpers(featGenerator FeatureGenerator, file *protogen.File, fidx int) {
+	helpersForPlugin := featureHelpers{
+		path:    file.GoImportPath,
+		feature: fidx,
+	}
+	if !gen.seen[helpersForPlugin] {
+		featGenerator.GenerateHelpers()
+		gen.seen[helpersForPlugin] = true
+	}
+}
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// ReservedNamesMode is the handling of the fields whose Go name conflicts with a
+// method of protoreflect.Message, which fast reflection types implement.
+type ReservedNamesMode string
+
+const (
+	// ReservedNamesSuffix appends an underscore to the Go name of the conflicting fields.
+	ReservedNamesSuffix ReservedNamesMode = "suffix"
+	// ReservedNamesFail fails the generation.
+	ReservedNamesFail ReservedNamesMode = "fail"
+	// ReservedNamesSeparate keeps the Go names of the fields and generates the fast
+	// reflection of the messages with conflicting fields on a separate struct type,
+	// rather than on a type defined from the message.
+	ReservedNamesSeparate ReservedNamesMode = "separate"
+)
+
+// ParseReservedNamesMode parses the name of a ReservedNamesMode.
+func ParseReservedNamesMode(s string) (ReservedNamesMode, error) {
+	switch mode := ReservedNamesMode(s); mode {
+	case ReservedNamesSuffix, ReservedNamesFail, ReservedNamesSeparate:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown reserved names mode %q, expected %s, %s or %s", s, ReservedNamesSuffix, ReservedNamesFail, ReservedNamesSeparate)
+	}
+}
+
+var reservedFieldNames = map[string]struct{}{
+	"Descriptor":   {},
+	"Type":         {},
+	"New":          {},
+	"Interface":    {},
+	"Range":        {},
+	"Has":          {},
+	"Clear":        {},
+	"Get":          {},
+	"Set":          {},
+	"Mutable":      {},
+	"NewField":     {},
+	"WhichOneof":   {},
+	"GetUnknown":   {},
+	"SetUnknown":   {},
+	"IsValid":      {},
+	"ProtoMethods": {},
+}
+
+// IsReservedFieldName reports whether a field with the given Go name conflicts
+// with a method of protoreflect.Message.
+func IsReservedFieldName(goName string) bool {
+	_, reserved := reservedFieldNames[goName]
+	return reserved
+}
+
+// SeparateFastReflectionType reports whether the fast reflection of message is
+// generated on a separate struct type, which fields are accessed through a
+// pointer to the message.
+func (p *GeneratedFile) SeparateFastReflectionType(message *protogen.Message) bool {
+	if p.Ext == nil || p.Ext.ReservedNames != ReservedNamesSeparate {
+		return false
+	}
+	for _, field := range message.Fields {
+		if IsReservedFieldName(field.GoName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"fmt"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ScalarName returns the name of the cosmos_proto scalar the field is annotated with,
+// or an empty string if it is not annotated.
+func ScalarName(field *protogen.Field) string {
+	return proto.GetExtension(field.Desc.Options(), cosmos_proto.E_Scalar).(string)
+}
+
+// CustomType returns the Go type that the field is mapped to through
+// its scalar annotation, if any.
+func (p *GeneratedFile) CustomType(field *protogen.Field) (protogen.GoIdent, bool) {
+	return p.CustomTypeOf(field.Desc)
+}
+
+// CustomTypeOf is like CustomType, for a field known only by its descriptor,
+// such as a field of a message of another package.
+func (p *GeneratedFile) CustomTypeOf(fd protoreflect.FieldDescriptor) (protogen.GoIdent, bool) {
+	if p.Ext == nil || len(p.Ext.ScalarTypes) == 0 || fd.IsExtension() {
+		return protogen.GoIdent{}, false
+	}
+	ident, ok := p.Ext.ScalarTypes[proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string)]
+	return ident, ok
+}
+
+// checkScalarTypes makes sure that custom types are only mapped to fields
+// they can be generated for, which are singular string and bytes fields
+// not belonging to a oneof, other than the synthetic oneof of a proto3
+// optional field.
+func checkScalarTypes(files []*protogen.File, ext *Extensions) error {
+	if ext == nil || len(ext.ScalarTypes) == 0 {
+		return nil
+	}
+
+	var checkMessage func(message *protogen.Message) error
+	checkMessage = func(message *protogen.Message) error {
+		for _, field := range message.Fields {
+			scalar := ScalarName(field)
+			ident, ok := ext.ScalarTypes[scalar]
+			if !ok {
+				continue
+			}
+			kind := field.Desc.Kind()
+			if (kind != protoreflect.StringKind && kind != protoreflect.BytesKind) ||
+				field.Desc.Cardinality() == protoreflect.Repeated ||
+				(field.Desc.ContainingOneof() != nil && !field.Desc.ContainingOneof().IsSynthetic()) {
+				return fmt.Errorf("field %s: scalar %s is mapped to %s, but custom types are only supported on singular string and bytes fields outside of oneofs",
+					field.Desc.FullName(), scalar, ident)
+			}
+		}
+		for _, nested := range message.Messages {
+			if err := checkMessage(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, f := range files {
+		if !f.Generate {
+			continue
+		}
+		for _, message := range f.Messages {
+			if err := checkMessage(message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
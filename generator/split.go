@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BaseFeature is the feature generated in the .pulsar.go file when the features are
+// generated in their own files. It cannot be excluded from the build, as the other
+// features depend on the message types it generates.
+const BaseFeature = "protoc"
+
+// BuildTag returns the build tag excluding the feature from the build when the
+// features are generated in their own files, e.g. pulsar_no_fast.
+func BuildTag(feature string) string {
+	return "pulsar_no_" + feature
+}
+
+// GenerateFeatureFiles generates each feature of file into its own file, named
+// after the feature, e.g. x.pulsar_fast.go, and compiled unless the build tag of the
+// feature is set, e.g. go build -tags pulsar_no_fast. The base feature is generated
+// into x.pulsar.go, which is always compiled. When a feature generator implements
+// FallbackGenerator, its fallback is generated into x.pulsar_no_<feature>.go, which is
+// only compiled when the feature is excluded. Empty files are not generated. It
+// reports whether any file was generated.
+func (gen *Generator) GenerateFeatureFiles(plugin *protogen.Plugin, file *protogen.File) bool {
+	if file.Desc.Syntax() != protoreflect.Proto3 && file.Desc.Syntax() != protoreflect.Proto2 {
+		return false
+	}
+
+	var generated bool
+	for fidx, feat := range gen.features {
+		name, constraint := file.GeneratedFilenamePrefix+".pulsar_"+feat.name+".go", "!"+BuildTag(feat.name)
+		if feat.name == BaseFeature {
+			name, constraint = file.GeneratedFilenamePrefix+".pulsar.go", ""
+		}
+
+		p := gen.wrap(newFeatureFile(plugin, file, name, constraint))
+		featGenerator := feat.feat(p, plugin)
+		if featGenerator.GenerateFile(file, plugin) {
+			gen.generateHelpers(featGenerator, file, fidx)
+		}
+		if skipEmpty(p.GeneratedFile) {
+			generated = true
+		}
+
+		if feat.name == BaseFeature {
+			continue
+		}
+		p = gen.wrap(newFeatureFile(plugin, file, file.GeneratedFilenamePrefix+".pulsar_no_"+feat.name+".go", BuildTag(feat.name)))
+		fallback, ok := feat.feat(p, plugin).(FallbackGenerator)
+		if !ok {
+			p.Skip()
+			continue
+		}
+		fallback.GenerateFallback(file)
+		if skipEmpty(p.GeneratedFile) {
+			generated = true
+		}
+	}
+
+	return generated
+}
+
+func newFeatureFile(plugin *protogen.Plugin, file *protogen.File, name, constraint string) *protogen.GeneratedFile {
+	gf := plugin.NewGeneratedFile(name, file.GoImportPath)
+	gf.P("// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.")
+	if constraint != "" {
+		gf.P()
+		gf.P("//go:build ", constraint)
+		gf.P()
+	}
+	gf.P("package ", file.GoPackageName)
+	return gf
+}
+
+// skipEmpty skips gf if it declares nothing, and reports whether it is generated.
+func skipEmpty(gf *protogen.GeneratedFile) bool {
+	content, err := gf.Content()
+	if err != nil {
+		// reported by the plugin
+		return true
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", content, parser.SkipObjectResolution)
+	if err != nil || len(f.Decls) > 0 {
+		return true
+	}
+	gf.Skip()
+	return false
+}
@@ -0,0 +1,159 @@
+package cosmostest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestCustomTypeRoundTrip(t *testing.T) {
+	supply := &Supply{
+		Total:    &Coin{Denom: "atom", Amount: NewInt(1000)},
+		Checksum: Checksum{1, 2, 3},
+		History:  []*Coin{{Denom: "atom", Amount: NewInt(-5)}, {Denom: "atom"}},
+	}
+
+	bz, err := proto.Marshal(supply)
+	require.NoError(t, err)
+
+	// the wire encoding is the same as the one of plain string and bytes fields
+	dyn := dynamicpb.NewMessage(supply.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(bz, dyn))
+	fields := dyn.Descriptor().Fields()
+	total := dyn.Get(fields.ByName("total")).Message()
+	require.Equal(t, "1000", total.Get(total.Descriptor().Fields().ByName("amount")).String())
+	require.Equal(t, supply.Checksum[:], dyn.Get(fields.ByName("checksum")).Bytes())
+	dynBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(dyn)
+	require.NoError(t, err)
+	require.Equal(t, bz, dynBz)
+
+	decoded := &Supply{}
+	require.NoError(t, proto.Unmarshal(bz, decoded))
+	require.Equal(t, "1000", decoded.Total.Amount.String())
+	require.Equal(t, "-5", decoded.History[0].Amount.String())
+	require.Equal(t, "", decoded.History[1].Amount.String())
+	require.Equal(t, supply.Checksum, decoded.GetChecksum())
+	require.True(t, proto.Equal(supply, decoded))
+
+	jsonBz, err := protojson.Marshal(decoded)
+	require.NoError(t, err)
+	fromJSON := &Supply{}
+	require.NoError(t, protojson.Unmarshal(jsonBz, fromJSON))
+	require.True(t, proto.Equal(supply, fromJSON))
+}
+
+func TestCustomTypeInvalidEncoding(t *testing.T) {
+	dyn := dynamicpb.NewMessage((&Coin{}).ProtoReflect().Descriptor())
+	dyn.Set(dyn.Descriptor().Fields().ByName("amount"), protoreflect.ValueOfString("1.5"))
+	bz, err := proto.Marshal(dyn)
+	require.NoError(t, err)
+
+	require.Error(t, proto.Unmarshal(bz, &Coin{}))
+}
+
+func TestCustomTypeFastReflection(t *testing.T) {
+	coin := &Coin{Denom: "atom"}
+	m := coin.ProtoReflect()
+	amountField := m.Descriptor().Fields().ByName("amount")
+
+	require.False(t, m.Has(amountField))
+	require.Equal(t, "", m.Get(amountField).String())
+
+	m.Set(amountField, protoreflect.ValueOfString("42"))
+	require.True(t, m.Has(amountField))
+	require.Equal(t, "42", coin.Amount.String())
+	require.Equal(t, "42", m.Get(amountField).String())
+
+	var ranged []protoreflect.Name
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		ranged = append(ranged, fd.Name())
+		if fd == amountField {
+			require.Equal(t, "42", v.String())
+		}
+		return true
+	})
+	require.ElementsMatch(t, []protoreflect.Name{"denom", "amount"}, ranged)
+
+	// an invalid value leaves the field unset and is reported when the message is checked
+	m.Set(amountField, protoreflect.ValueOfString("x"))
+	require.False(t, m.Has(amountField))
+	require.Equal(t, "", m.Get(amountField).String())
+	err := proto.CheckInitialized(coin)
+	require.EqualError(t, err, "proto: invalid value for field cosmostest.Coin.amount: invalid integer: \"x\"")
+	require.True(t, errors.Is(err, proto.Error))
+	_, err = proto.Marshal(coin)
+	require.Error(t, err)
+
+	// the invalid value is kept when partial messages are allowed
+	bz, err := proto.MarshalOptions{AllowPartial: true}.Marshal(coin)
+	require.NoError(t, err)
+	require.Error(t, proto.Unmarshal(bz, &Coin{}))
+
+	// setting a valid value discards the invalid one
+	m.Set(amountField, protoreflect.ValueOfString("7"))
+	require.NoError(t, proto.CheckInitialized(coin))
+	require.Empty(t, m.GetUnknown())
+	m.Set(amountField, protoreflect.ValueOfString("x"))
+
+	m.Clear(amountField)
+	require.NoError(t, proto.CheckInitialized(coin))
+	require.False(t, m.Has(amountField))
+	require.Nil(t, coin.Amount.BigInt())
+
+	supply := (&Supply{}).ProtoReflect()
+	checksumField := supply.Descriptor().Fields().ByName("checksum")
+	require.False(t, supply.Has(checksumField))
+	supply.Set(checksumField, protoreflect.ValueOfBytes(make([]byte, 32)))
+	require.False(t, supply.Has(checksumField), "an all-zero checksum is the zero value")
+	supply.Set(checksumField, protoreflect.ValueOfBytes([]byte{1}))
+	require.False(t, supply.Has(checksumField))
+	require.Error(t, proto.CheckInitialized(supply.Interface()))
+}
+
+func TestCustomTypeInvalidJSON(t *testing.T) {
+	err := protojson.Unmarshal([]byte(`{"amount":"abc"}`), &Coin{})
+	require.ErrorContains(t, err, "cosmostest.Coin.amount")
+
+	// nested messages are checked
+	err = protojson.Unmarshal([]byte(`{"history":[{"amount":"1"},{"amount":"abc"}]}`), &Supply{})
+	require.ErrorContains(t, err, "cosmostest.Coin.amount")
+
+	coin := &Coin{}
+	require.NoError(t, protojson.UnmarshalOptions{AllowPartial: true}.Unmarshal([]byte(`{"amount":"abc"}`), coin))
+	require.Equal(t, "", coin.Amount.String())
+}
+
+func TestCustomTypeOptional(t *testing.T) {
+	supply := &Supply{}
+	m := supply.ProtoReflect()
+	mintedField := m.Descriptor().Fields().ByName("minted")
+	require.True(t, mintedField.HasPresence())
+	require.Nil(t, m.WhichOneof(mintedField.ContainingOneof()))
+
+	m.Set(mintedField, protoreflect.ValueOfString("5"))
+	require.True(t, m.Has(mintedField))
+	require.Equal(t, mintedField, m.WhichOneof(mintedField.ContainingOneof()))
+	require.Equal(t, "5", supply.GetMinted().String())
+
+	bz, err := proto.Marshal(supply)
+	require.NoError(t, err)
+	dyn := dynamicpb.NewMessage(m.Descriptor())
+	require.NoError(t, proto.Unmarshal(bz, dyn))
+	require.Equal(t, "5", dyn.Get(mintedField).String())
+
+	decoded := &Supply{}
+	require.NoError(t, proto.Unmarshal(bz, decoded))
+	require.Equal(t, "5", decoded.Minted.String())
+
+	jsonBz, err := protojson.Marshal(supply)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"minted":"5"}`, string(jsonBz))
+
+	m.Clear(mintedField)
+	require.False(t, m.Has(mintedField))
+}
@@ -0,0 +1,96 @@
+package cosmostest
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Int is the custom type generated for fields annotated with the cosmostest.Int scalar.
+type Int struct {
+	i *big.Int
+}
+
+// NewInt returns a new Int from x.
+func NewInt(x int64) Int {
+	return Int{i: big.NewInt(x)}
+}
+
+// BigInt returns a copy of the underlying big.Int, or nil if i is zero.
+func (i Int) BigInt() *big.Int {
+	if i.i == nil {
+		return nil
+	}
+	return new(big.Int).Set(i.i)
+}
+
+func (i Int) String() string {
+	if i.i == nil {
+		return ""
+	}
+	return i.i.String()
+}
+
+func (i Int) Marshal() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i Int) MarshalTo(data []byte) (int, error) {
+	return copy(data, i.String()), nil
+}
+
+func (i *Int) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		i.i = nil
+		return nil
+	}
+	v, ok := new(big.Int).SetString(string(data), 10)
+	if !ok {
+		return fmt.Errorf("invalid integer: %q", data)
+	}
+	i.i = v
+	return nil
+}
+
+func (i Int) Size() int {
+	return len(i.String())
+}
+
+// Checksum is the custom type generated for fields annotated with the cosmostest.Checksum scalar.
+type Checksum [32]byte
+
+func (c Checksum) isZero() bool {
+	return c == Checksum{}
+}
+
+func (c Checksum) Marshal() ([]byte, error) {
+	if c.isZero() {
+		return nil, nil
+	}
+	return c[:], nil
+}
+
+func (c Checksum) MarshalTo(data []byte) (int, error) {
+	if c.isZero() {
+		return 0, nil
+	}
+	return copy(data, c[:]), nil
+}
+
+func (c *Checksum) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*c = Checksum{}
+		return nil
+	}
+	if len(data) != len(c) {
+		return fmt.Errorf("invalid checksum length %d", len(data))
+	}
+	copy(c[:], data)
+	return nil
+}
+
+func (c Checksum) Size() int {
+	if c.isZero() {
+		return 0
+	}
+	return len(c)
+}
@@ -0,0 +1,84 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package cosmostest
+
+import (
+	_ "github.com/cosmos/cosmos-proto"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+// Msg is implemented by every message that can be included in a Tx.
+//
+// Messages implement it by listing cosmostest.Msg in their cosmos_proto.implements_interface option.
+type Msg interface {
+	proto.Message
+	IsCosmostestMsg()
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: internal/testprotos/cosmostest/interfaces.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_internal_testprotos_cosmostest_interfaces_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_cosmostest_interfaces_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x8c, 0x01, 0xea, 0x9b, 0x83, 0x03, 0x48,
+	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x41, 0x4d, 0x73, 0x67, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65, 0x76, 0x65,
+	0x72, 0x79, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+	0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x20,
+	0x69, 0x6e, 0x20, 0x61, 0x20, 0x54, 0x78, 0x2e, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_internal_testprotos_cosmostest_interfaces_proto_goTypes = []interface{}{}
+var file_internal_testprotos_cosmostest_interfaces_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_cosmostest_interfaces_proto_init() }
+func file_internal_testprotos_cosmostest_interfaces_proto_init() {
+	if File_internal_testprotos_cosmostest_interfaces_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_cosmostest_interfaces_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_cosmostest_interfaces_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_cosmostest_interfaces_proto_depIdxs,
+	}.Build()
+	File_internal_testprotos_cosmostest_interfaces_proto = out.File
+	file_internal_testprotos_cosmostest_interfaces_proto_rawDesc = nil
+	file_internal_testprotos_cosmostest_interfaces_proto_goTypes = nil
+	file_internal_testprotos_cosmostest_interfaces_proto_depIdxs = nil
+}
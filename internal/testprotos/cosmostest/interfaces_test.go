@@ -0,0 +1,32 @@
+package cosmostest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type interfaceRegistry map[string][]string
+
+func (r interfaceRegistry) RegisterImplementations(iface string, impls ...proto.Message) {
+	for _, impl := range impls {
+		r[iface] = append(r[iface], string(impl.ProtoReflect().Descriptor().FullName()))
+	}
+}
+
+func TestGeneratedInterfaces(t *testing.T) {
+	var msgs []Msg
+	msgs = append(msgs, &MsgSend{}, &MsgExec{})
+	require.Len(t, msgs, 2)
+
+	var memo interface{} = &Memo{}
+	_, ok := memo.(Msg)
+	require.False(t, ok, "Memo does not implement cosmostest.Msg")
+
+	registry := make(interfaceRegistry)
+	RegisterInterfaceImplementations(registry)
+	require.Equal(t, interfaceRegistry{
+		"cosmostest.Msg": {"cosmostest.MsgSend", "cosmostest.MsgExec"},
+	}, registry)
+}
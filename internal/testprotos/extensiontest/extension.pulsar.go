@@ -0,0 +1,1467 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package extensiontest
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Extendable       protoreflect.MessageDescriptor
+	fd_Extendable_names protoreflect.FieldDescriptor
+	fd_Extendable_child protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_extensiontest_extension_proto_init()
+	md_Extendable = File_internal_testprotos_extensiontest_extension_proto.Messages().ByName("Extendable")
+	fd_Extendable_names = md_Extendable.Fields().ByName("names")
+	fd_Extendable_child = md_Extendable.Fields().ByName("child")
+}
+
+var _ protoreflect.Message = (*fastReflection_Extendable)(nil)
+
+type fastReflection_Extendable Extendable
+
+func (x *Extendable) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Extendable)(x)
+}
+
+func (x *Extendable) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_extensiontest_extension_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Extendable_messageType fastReflection_Extendable_messageType
+var _ protoreflect.MessageType = fastReflection_Extendable_messageType{}
+
+type fastReflection_Extendable_messageType struct{}
+
+func (x fastReflection_Extendable_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Extendable)(nil)
+}
+func (x fastReflection_Extendable_messageType) New() protoreflect.Message {
+	return new(fastReflection_Extendable)
+}
+func (x fastReflection_Extendable_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Extendable
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Extendable) Descriptor() protoreflect.MessageDescriptor {
+	return md_Extendable
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Extendable) Type() protoreflect.MessageType {
+	return _fastReflection_Extendable_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Extendable) New() protoreflect.Message {
+	return new(fastReflection_Extendable)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Extendable) Interface() protoreflect.ProtoMessage {
+	return (*Extendable)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Extendable) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Names) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](&x.Names))
+		if !f(fd_Extendable_names, value) {
+			return
+		}
+	}
+	if x.Child != nil {
+		value := protoreflect.ValueOfMessage(x.Child.ProtoReflect())
+		if !f(fd_Extendable_child, value) {
+			return
+		}
+	}
+	runtime.NewExtensions(&x.extensionFields).Range(false, f)
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Extendable) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "extensiontest.Extendable.names":
+		return len(x.Names) != 0
+	case "extensiontest.Extendable.child":
+		return x.Child != nil
+	default:
+		if fd.IsExtension() {
+			return runtime.NewExtensions(&x.extensionFields).Has(md_Extendable, fd)
+		}
+		panic(fmt.Errorf("message extensiontest.Extendable does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extendable) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "extensiontest.Extendable.names":
+		x.Names = nil
+	case "extensiontest.Extendable.child":
+		x.Child = nil
+	default:
+		if fd.IsExtension() {
+			runtime.NewExtensions(&x.extensionFields).Clear(md_Extendable, fd)
+			return
+		}
+		panic(fmt.Errorf("message extensiontest.Extendable does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Extendable) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "extensiontest.Extendable.names":
+		if len(x.Names) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](nil))
+		}
+		listValue := runtime.NewList[string, runtime.StringValue](&x.Names)
+		return protoreflect.ValueOfList(listValue)
+	case "extensiontest.Extendable.child":
+		value := x.Child
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			return runtime.NewExtensions(&x.extensionFields).Get(md_Extendable, descriptor)
+		}
+		panic(fmt.Errorf("message extensiontest.Extendable does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extendable) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "extensiontest.Extendable.names":
+		lv := value.List()
+		clv := lv.(*runtime.List[string, runtime.StringValue])
+		x.Names = clv.Slice()
+	case "extensiontest.Extendable.child":
+		x.Child = value.Message().Interface().(*Extendable)
+	default:
+		if fd.IsExtension() {
+			runtime.NewExtensions(&x.extensionFields).Set(md_Extendable, fd, value)
+			return
+		}
+		panic(fmt.Errorf("message extensiontest.Extendable does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extendable) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "extensiontest.Extendable.names":
+		if x.Names == nil {
+			x.Names = []string{}
+		}
+		value := runtime.NewList[string, runtime.StringValue](&x.Names)
+		return protoreflect.ValueOfList(value)
+	case "extensiontest.Extendable.child":
+		if x.Child == nil {
+			x.Child = new(Extendable)
+		}
+		return protoreflect.ValueOfMessage(x.Child.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			return runtime.NewExtensions(&x.extensionFields).Mutable(md_Extendable, fd)
+		}
+		panic(fmt.Errorf("message extensiontest.Extendable does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Extendable) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "extensiontest.Extendable.names":
+		list := []string{}
+		return protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](&list))
+	case "extensiontest.Extendable.child":
+		m := new(Extendable)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			return runtime.NewExtensionField(md_Extendable, fd)
+		}
+		panic(fmt.Errorf("message extensiontest.Extendable does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Extendable) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in extensiontest.Extendable", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Extendable) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extendable) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Extendable) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Extendable) ProtoMethods() *protoiface.Methods {
+	return fastReflection_ExtendableProtoMethods
+}
+
+var fastReflection_ExtendableProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Extendable)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Names) > 0 {
+			for _, s := range x.Names {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Child != nil {
+			l = options.Size(x.Child)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		n += runtime.NewExtensions(&x.extensionFields).Size(options)
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Extendable)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Child != nil {
+			encoded, err := options.Marshal(x.Child)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Names) > 0 {
+			for iNdEx := len(x.Names) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Names[iNdEx])
+				copy(dAtA[i:], x.Names[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Names[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		n, err := runtime.NewExtensions(&x.extensionFields).MarshalToSizedBuffer(dAtA[:i], options)
+		if err != nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, err
+		}
+		i -= n
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Extendable)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Extendable: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Extendable: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Names = append(x.Names, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Child == nil {
+					x.Child = &Extendable{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Child); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				if ((fieldNum >= 100) && (fieldNum < 200)) || ((fieldNum >= 1000) && (fieldNum < 536870912)) {
+					iNdEx = preIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					ok, err := runtime.NewExtensions(&x.extensionFields).UnmarshalField(md_Extendable, dAtA[iNdEx:iNdEx+skippy], options)
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if !ok && !options.DiscardUnknown {
+						x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+					}
+					iNdEx += skippy
+				} else {
+					iNdEx = preIndex
+					skippy, err := runtime.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					if !options.DiscardUnknown {
+						x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+					}
+					iNdEx += skippy
+				}
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Extendable)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		if x.Child != nil {
+			if err := proto.CheckInitialized(x.Child); err != nil {
+				return output, err
+			}
+		}
+		if err := runtime.NewExtensions(&x.extensionFields).CheckInitialized(); err != nil {
+			return output, err
+		}
+		return output, nil
+	}
+	fastReflection_ExtendableProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+var (
+	md_Extension       protoreflect.MessageDescriptor
+	fd_Extension_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_extensiontest_extension_proto_init()
+	md_Extension = File_internal_testprotos_extensiontest_extension_proto.Messages().ByName("Extension")
+	fd_Extension_value = md_Extension.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_Extension)(nil)
+
+type fastReflection_Extension Extension
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Extension)(x)
+}
+
+func (x *Extension) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_extensiontest_extension_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Extension_messageType fastReflection_Extension_messageType
+var _ protoreflect.MessageType = fastReflection_Extension_messageType{}
+
+type fastReflection_Extension_messageType struct{}
+
+func (x fastReflection_Extension_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Extension)(nil)
+}
+func (x fastReflection_Extension_messageType) New() protoreflect.Message {
+	return new(fastReflection_Extension)
+}
+func (x fastReflection_Extension_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Extension
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Extension) Descriptor() protoreflect.MessageDescriptor {
+	return md_Extension
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Extension) Type() protoreflect.MessageType {
+	return _fastReflection_Extension_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Extension) New() protoreflect.Message {
+	return new(fastReflection_Extension)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Extension) Interface() protoreflect.ProtoMessage {
+	return (*Extension)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Extension) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != nil {
+		value := protoreflect.ValueOfString(*x.Value)
+		if !f(fd_Extension_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Extension) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "extensiontest.Extension.value":
+		return x.Value != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message extensiontest.Extension does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message extensiontest.Extension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extension) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "extensiontest.Extension.value":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message extensiontest.Extension does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message extensiontest.Extension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Extension) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "extensiontest.Extension.value":
+		if x.Value == nil {
+			return fd_Extension_value.Default()
+		}
+		value := *x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("message extensiontest.Extension does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message extensiontest.Extension does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extension) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "extensiontest.Extension.value":
+		cv := value.Interface().(string)
+		x.Value = &cv
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message extensiontest.Extension does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message extensiontest.Extension does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "extensiontest.Extension.value":
+		panic(fmt.Errorf("field value of message extensiontest.Extension is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message extensiontest.Extension does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message extensiontest.Extension does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Extension) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "extensiontest.Extension.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message extensiontest.Extension does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message extensiontest.Extension does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Extension) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in extensiontest.Extension", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Extension) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Extension) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Extension) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Extension) ProtoMethods() *protoiface.Methods {
+	return fastReflection_ExtensionProtoMethods
+}
+
+var fastReflection_ExtensionProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Extension)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != nil {
+			l = len(*x.Value)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Extension)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Value != nil {
+			i -= len(*x.Value)
+			copy(dAtA[i:], *x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.Value)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Extension)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Extension: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Extension: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				s := string(dAtA[iNdEx:postIndex])
+				x.Value = &s
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		return protoiface.CheckInitializedOutput{}, nil
+	}
+	fastReflection_ExtensionProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: internal/testprotos/extensiontest/extension.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Kind int32
+
+const (
+	Kind_KIND_A Kind = 1
+	Kind_KIND_B Kind = 2
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		1: "KIND_A",
+		2: "KIND_B",
+	}
+	Kind_value = map[string]int32{
+		"KIND_A": 1,
+		"KIND_B": 2,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_extensiontest_extension_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_extensiontest_extension_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Kind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Kind(num)
+	return nil
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_extensiontest_extension_proto_rawDescGZIP(), []int{0}
+}
+
+// Extendable declares extension ranges, extended by the extensions below.
+type Extendable struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	Names []string    `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	Child *Extendable `protobuf:"bytes,2,opt,name=child" json:"child,omitempty"`
+}
+
+func (x *Extendable) Reset() {
+	*x = Extendable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_extensiontest_extension_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extendable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extendable) ProtoMessage() {}
+
+// Deprecated: Use Extendable.ProtoReflect.Descriptor instead.
+func (*Extendable) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_extensiontest_extension_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Extendable) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Extendable) GetChild() *Extendable {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// Extension is the message of the message extensions.
+type Extension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *string `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_extensiontest_extension_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_extensiontest_extension_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Extension) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+var file_internal_testprotos_extensiontest_extension_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "extensiontest.int32_ext",
+		Tag:           "varint,100,opt,name=int32_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         101,
+		Name:          "extensiontest.string_ext",
+		Tag:           "bytes,101,opt,name=string_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]byte)(nil),
+		Field:         102,
+		Name:          "extensiontest.bytes_ext",
+		Tag:           "bytes,102,opt,name=bytes_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*Extension)(nil),
+		Field:         103,
+		Name:          "extensiontest.message_ext",
+		Tag:           "bytes,103,opt,name=message_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]uint64)(nil),
+		Field:         104,
+		Name:          "extensiontest.packed_ext",
+		Tag:           "varint,104,rep,packed,name=packed_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         105,
+		Name:          "extensiontest.repeated_ext",
+		Tag:           "bytes,105,rep,name=repeated_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]*Extension)(nil),
+		Field:         106,
+		Name:          "extensiontest.repeated_message_ext",
+		Tag:           "bytes,106,rep,name=repeated_message_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int64)(nil),
+		Field:         107,
+		Name:          "extensiontest.sint64_ext",
+		Tag:           "zigzag64,107,opt,name=sint64_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*float64)(nil),
+		Field:         108,
+		Name:          "extensiontest.double_ext",
+		Tag:           "fixed64,108,opt,name=double_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*Kind)(nil),
+		Field:         109,
+		Name:          "extensiontest.enum_ext",
+		Tag:           "varint,109,opt,name=enum_ext,enum=extensiontest.Kind",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*uint32)(nil),
+		Field:         1000,
+		Name:          "extensiontest.fixed32_ext",
+		Tag:           "fixed32,1000,opt,name=fixed32_ext",
+		Filename:      "internal/testprotos/extensiontest/extension.proto",
+	},
+}
+
+// Extension fields to Extendable.
+var (
+	// optional int32 int32_ext = 100;
+	E_Int32Ext = &file_internal_testprotos_extensiontest_extension_proto_extTypes[0]
+	// optional string string_ext = 101;
+	E_StringExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[1]
+	// optional bytes bytes_ext = 102;
+	E_BytesExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[2]
+	// optional extensiontest.Extension message_ext = 103;
+	E_MessageExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[3]
+	// repeated uint64 packed_ext = 104;
+	E_PackedExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[4]
+	// repeated string repeated_ext = 105;
+	E_RepeatedExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[5]
+	// repeated extensiontest.Extension repeated_message_ext = 106;
+	E_RepeatedMessageExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[6]
+	// optional sint64 sint64_ext = 107;
+	E_Sint64Ext = &file_internal_testprotos_extensiontest_extension_proto_extTypes[7]
+	// optional double double_ext = 108;
+	E_DoubleExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[8]
+	// optional extensiontest.Kind enum_ext = 109;
+	E_EnumExt = &file_internal_testprotos_extensiontest_extension_proto_extTypes[9]
+	// optional fixed32 fixed32_ext = 1000;
+	E_Fixed32Ext = &file_internal_testprotos_extensiontest_extension_proto_extTypes[10]
+)
+
+var File_internal_testprotos_extensiontest_extension_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_extensiontest_extension_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x22, 0x65, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2a, 0x05, 0x08, 0x64, 0x10, 0xc8, 0x01, 0x2a, 0x09,
+	0x08, 0xe8, 0x07, 0x10, 0x80, 0x80, 0x80, 0x80, 0x02, 0x22, 0x21, 0x0a, 0x09, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x1e, 0x0a, 0x04,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x10, 0x02, 0x3a, 0x36, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x45, 0x78, 0x74, 0x3a, 0x38, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65,
+	0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x3a, 0x36,
+	0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x45, 0x78, 0x74, 0x3a, 0x54, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x78, 0x74, 0x3a, 0x3c, 0x0a, 0x0a,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x68, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x10, 0x01, 0x52,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x78, 0x74, 0x3a, 0x3c, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x69, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x3a, 0x65, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x12, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x6a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x78, 0x74, 0x3a,
+	0x38, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x12, 0x52, 0x09,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x78, 0x74, 0x3a, 0x38, 0x0a, 0x0a, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x45, 0x78, 0x74, 0x3a, 0x49, 0x0a, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x78, 0x74, 0x12,
+	0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x07, 0x65, 0x6e, 0x75, 0x6d, 0x45, 0x78, 0x74, 0x3a, 0x3b,
+	0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x19, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x78, 0x74, 0x42, 0x42, 0x5a, 0x40, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+}
+
+var (
+	file_internal_testprotos_extensiontest_extension_proto_rawDescOnce sync.Once
+	file_internal_testprotos_extensiontest_extension_proto_rawDescData = file_internal_testprotos_extensiontest_extension_proto_rawDesc
+)
+
+func file_internal_testprotos_extensiontest_extension_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_extensiontest_extension_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_extensiontest_extension_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_extensiontest_extension_proto_rawDescData)
+	})
+	return file_internal_testprotos_extensiontest_extension_proto_rawDescData
+}
+
+var file_internal_testprotos_extensiontest_extension_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_testprotos_extensiontest_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_testprotos_extensiontest_extension_proto_goTypes = []interface{}{
+	(Kind)(0),          // 0: extensiontest.Kind
+	(*Extendable)(nil), // 1: extensiontest.Extendable
+	(*Extension)(nil),  // 2: extensiontest.Extension
+}
+var file_internal_testprotos_extensiontest_extension_proto_depIdxs = []int32{
+	1,  // 0: extensiontest.Extendable.child:type_name -> extensiontest.Extendable
+	1,  // 1: extensiontest.int32_ext:extendee -> extensiontest.Extendable
+	1,  // 2: extensiontest.string_ext:extendee -> extensiontest.Extendable
+	1,  // 3: extensiontest.bytes_ext:extendee -> extensiontest.Extendable
+	1,  // 4: extensiontest.message_ext:extendee -> extensiontest.Extendable
+	1,  // 5: extensiontest.packed_ext:extendee -> extensiontest.Extendable
+	1,  // 6: extensiontest.repeated_ext:extendee -> extensiontest.Extendable
+	1,  // 7: extensiontest.repeated_message_ext:extendee -> extensiontest.Extendable
+	1,  // 8: extensiontest.sint64_ext:extendee -> extensiontest.Extendable
+	1,  // 9: extensiontest.double_ext:extendee -> extensiontest.Extendable
+	1,  // 10: extensiontest.enum_ext:extendee -> extensiontest.Extendable
+	1,  // 11: extensiontest.fixed32_ext:extendee -> extensiontest.Extendable
+	2,  // 12: extensiontest.message_ext:type_name -> extensiontest.Extension
+	2,  // 13: extensiontest.repeated_message_ext:type_name -> extensiontest.Extension
+	0,  // 14: extensiontest.enum_ext:type_name -> extensiontest.Kind
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	12, // [12:15] is the sub-list for extension type_name
+	1,  // [1:12] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_extensiontest_extension_proto_init() }
+func file_internal_testprotos_extensiontest_extension_proto_init() {
+	if File_internal_testprotos_extensiontest_extension_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_extensiontest_extension_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extendable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_extensiontest_extension_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_extensiontest_extension_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 11,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_extensiontest_extension_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_extensiontest_extension_proto_depIdxs,
+		EnumInfos:         file_internal_testprotos_extensiontest_extension_proto_enumTypes,
+		MessageInfos:      file_internal_testprotos_extensiontest_extension_proto_msgTypes,
+		ExtensionInfos:    file_internal_testprotos_extensiontest_extension_proto_extTypes,
+	}.Build()
+	File_internal_testprotos_extensiontest_extension_proto = out.File
+	file_internal_testprotos_extensiontest_extension_proto_rawDesc = nil
+	file_internal_testprotos_extensiontest_extension_proto_goTypes = nil
+	file_internal_testprotos_extensiontest_extension_proto_depIdxs = nil
+}
@@ -0,0 +1,185 @@
+package extensiontest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func newExtendable() *Extendable {
+	msg := &Extendable{
+		Names: []string{"a", "b"},
+		Child: &Extendable{Names: []string{"child"}},
+	}
+	proto.SetExtension(msg.Child, E_Int32Ext, int32(-2))
+	proto.SetExtension(msg, E_Int32Ext, int32(-1))
+	proto.SetExtension(msg, E_StringExt, "string")
+	proto.SetExtension(msg, E_BytesExt, []byte("bytes"))
+	proto.SetExtension(msg, E_MessageExt, &Extension{Value: proto.String("message")})
+	proto.SetExtension(msg, E_PackedExt, []uint64{1, 1 << 40, 3})
+	proto.SetExtension(msg, E_RepeatedExt, []string{"x", "", "y"})
+	proto.SetExtension(msg, E_RepeatedMessageExt, []*Extension{{}, {Value: proto.String("z")}})
+	proto.SetExtension(msg, E_Sint64Ext, int64(-1<<40))
+	proto.SetExtension(msg, E_DoubleExt, 1.5)
+	proto.SetExtension(msg, E_EnumExt, Kind_KIND_B)
+	proto.SetExtension(msg, E_Fixed32Ext, uint32(7))
+	return msg
+}
+
+func TestFastReflection(t *testing.T) {
+	// the proto2 messages, with their optional scalars, are supported by fast reflection
+	require.Equal(t, "*extensiontest.fastReflection_Extendable", fmt.Sprintf("%T", (&Extendable{}).ProtoReflect()))
+	require.Equal(t, "*extensiontest.fastReflection_Extension", fmt.Sprintf("%T", (&Extension{}).ProtoReflect()))
+}
+
+func TestExtensionReflection(t *testing.T) {
+	msg := (&Extendable{}).ProtoReflect()
+	int32Ext := E_Int32Ext.TypeDescriptor()
+	messageExt := E_MessageExt.TypeDescriptor()
+	repeatedExt := E_RepeatedExt.TypeDescriptor()
+
+	// unpopulated extensions have their default value
+	require.False(t, msg.Has(int32Ext))
+	require.Equal(t, int64(0), msg.Get(int32Ext).Int())
+	require.False(t, msg.Get(messageExt).Message().IsValid())
+	require.Equal(t, 0, msg.Get(repeatedExt).List().Len())
+
+	msg.Set(int32Ext, protoreflect.ValueOfInt32(3))
+	require.True(t, msg.Has(int32Ext))
+	require.Equal(t, int32(3), proto.GetExtension(msg.Interface(), E_Int32Ext))
+
+	// Mutable populates composite extensions
+	msg.Mutable(messageExt).Message().Set(messageExt.Message().Fields().ByName("value"), protoreflect.ValueOfString("v"))
+	require.Equal(t, "v", proto.GetExtension(msg.Interface(), E_MessageExt).(*Extension).GetValue())
+	list := msg.Mutable(repeatedExt).List()
+	require.False(t, msg.Has(repeatedExt))
+	list.Append(protoreflect.ValueOfString("e"))
+	require.True(t, msg.Has(repeatedExt))
+	require.Equal(t, []string{"e"}, proto.GetExtension(msg.Interface(), E_RepeatedExt))
+	require.Panics(t, func() { msg.Mutable(int32Ext) })
+
+	field := msg.NewField(repeatedExt)
+	field.List().Append(protoreflect.ValueOfString("new"))
+	msg.Set(repeatedExt, field)
+	require.Equal(t, []string{"new"}, proto.GetExtension(msg.Interface(), E_RepeatedExt))
+	require.Panics(t, func() { msg.Set(int32Ext, protoreflect.ValueOfString("invalid")) })
+
+	ranged := map[protoreflect.FullName]bool{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		ranged[fd.FullName()] = true
+		return true
+	})
+	require.Equal(t, map[protoreflect.FullName]bool{
+		int32Ext.FullName():    true,
+		messageExt.FullName():  true,
+		repeatedExt.FullName(): true,
+	}, ranged)
+
+	msg.Clear(int32Ext)
+	require.False(t, msg.Has(int32Ext))
+	require.False(t, proto.HasExtension(msg.Interface(), E_Int32Ext))
+}
+
+func TestExtensionRangeStopsEarly(t *testing.T) {
+	var visited int
+	newExtendable().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		visited++
+		return !fd.IsExtension()
+	})
+	require.Equal(t, 3, visited)
+}
+
+func TestExtensionCodec(t *testing.T) {
+	msg := newExtendable()
+	opts := proto.MarshalOptions{Deterministic: true}
+	b, err := opts.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(msg), len(b))
+
+	// the encoding matches the one of the protobuf runtime
+	dyn := dynamicpb.NewMessage(msg.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(b, dyn))
+	dynBytes, err := opts.Marshal(dyn)
+	require.NoError(t, err)
+	require.Equal(t, dynBytes, b)
+
+	got := new(Extendable)
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.True(t, proto.Equal(msg, got))
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+	require.Equal(t, []uint64{1, 1 << 40, 3}, proto.GetExtension(got, E_PackedExt))
+	require.Equal(t, int32(-2), proto.GetExtension(got.Child, E_Int32Ext))
+}
+
+func TestUnmarshalMergesExtensions(t *testing.T) {
+	var b []byte
+	for _, msg := range []*Extendable{{}, {}} {
+		proto.SetExtension(msg, E_RepeatedExt, []string{"r"})
+		proto.SetExtension(msg, E_Int32Ext, int32(len(b)))
+		bz, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		b = append(b, bz...)
+	}
+	// unpacked encoding of a packed extension
+	b = protowire.AppendTag(b, 104, protowire.VarintType)
+	b = protowire.AppendVarint(b, 5)
+
+	got := new(Extendable)
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.Equal(t, []string{"r", "r"}, proto.GetExtension(got, E_RepeatedExt))
+	require.NotEqual(t, int32(0), proto.GetExtension(got, E_Int32Ext))
+	require.Equal(t, []uint64{5}, proto.GetExtension(got, E_PackedExt))
+}
+
+func TestUnknownExtensions(t *testing.T) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(newExtendable())
+	require.NoError(t, err)
+
+	// extensions which are not resolved are kept as unknown fields
+	got := new(Extendable)
+	require.NoError(t, proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}.Unmarshal(b, got))
+	require.False(t, proto.HasExtension(got, E_Int32Ext))
+	require.NotEmpty(t, got.ProtoReflect().GetUnknown())
+	// the extension fields, encoded first, are the unknown fields
+	unknown := got.ProtoReflect().GetUnknown()
+	require.Equal(t, b[:len(unknown)], []byte(unknown))
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(got)
+	require.NoError(t, err)
+	again := new(Extendable)
+	require.NoError(t, proto.Unmarshal(bz, again))
+	require.True(t, proto.Equal(newExtendable(), again))
+
+	got = new(Extendable)
+	require.NoError(t, proto.UnmarshalOptions{Resolver: new(protoregistry.Types), DiscardUnknown: true}.Unmarshal(b, got))
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+
+	// as well as extensions with a mismatching wire type
+	b = protowire.AppendTag(nil, 100, protowire.BytesType)
+	b = protowire.AppendString(b, "mismatch")
+	got = new(Extendable)
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.False(t, proto.HasExtension(got, E_Int32Ext))
+	require.Equal(t, protoreflect.RawFields(b), got.ProtoReflect().GetUnknown())
+
+	// and values of closed enums which are not declared, as specified for proto2
+	b = protowire.AppendTag(nil, 109, protowire.VarintType)
+	b = protowire.AppendVarint(b, 5)
+	got = new(Extendable)
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.False(t, proto.HasExtension(got, E_EnumExt))
+	require.Equal(t, protoreflect.RawFields(b), got.ProtoReflect().GetUnknown())
+
+	// a declared value is still decoded
+	b = protowire.AppendTag(nil, 109, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(Kind_KIND_A))
+	got = new(Extendable)
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.Equal(t, Kind_KIND_A, proto.GetExtension(got, E_EnumExt))
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+}
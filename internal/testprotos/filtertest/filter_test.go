@@ -0,0 +1,77 @@
+package filtertest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestFilteredReflection(t *testing.T) {
+	_, fast := (&State{}).ProtoReflect().(*fastReflection_State)
+	require.True(t, fast, "State has fast reflection")
+
+	for _, msg := range []proto.Message{&State_Nested{}, &QueryRequest{}, &QueryResponse{}} {
+		// the slow reflection of the protobuf runtime
+		require.NotContains(t, fmt.Sprintf("%T", msg.ProtoReflect()), "fastReflection")
+		require.Equal(t, msg.ProtoReflect().Descriptor(), msg.ProtoReflect().Type().Descriptor())
+	}
+}
+
+func newState() *State {
+	nested := &State_Nested{Value: 42}
+	return &State{
+		Name:      "state",
+		Responses: []*QueryResponse{{States: []*State{{Name: "inner", Nested: nested}}}},
+		Requests: map[string]*QueryRequest{
+			"denom":  {Kind: &QueryRequest_Denom{Denom: "atom"}},
+			"nested": {State: &State{Name: "inner"}, Kind: &QueryRequest_Nested{Nested: nested}},
+		},
+		Nested: nested,
+	}
+}
+
+func TestFilteredRoundTrip(t *testing.T) {
+	state := newState()
+
+	bz, err := proto.Marshal(state)
+	require.NoError(t, err)
+
+	// the fast and slow messages must agree with a dynamic message on the wire format
+	dyn := dynamicpb.NewMessage(state.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(bz, dyn))
+	dynBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(dyn)
+	require.NoError(t, err)
+	detBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	require.NoError(t, err)
+	require.Equal(t, dynBz, detBz)
+
+	got := &State{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.Empty(t, cmp.Diff(state, got, protocmp.Transform()))
+
+	js, err := protojson.Marshal(state)
+	require.NoError(t, err)
+	got = &State{}
+	require.NoError(t, protojson.Unmarshal(js, got))
+	require.Empty(t, cmp.Diff(state, got, protocmp.Transform()))
+}
+
+func TestFilteredRange(t *testing.T) {
+	var names []protoreflect.Name
+	newState().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		names = append(names, fd.Name())
+		if fd.Name() == "nested" {
+			// a slow message as the value of a fast one
+			require.Equal(t, int64(42), v.Message().Get(v.Message().Descriptor().Fields().ByName("value")).Int())
+		}
+		return true
+	})
+	require.ElementsMatch(t, []protoreflect.Name{"name", "responses", "requests", "nested"}, names)
+}
@@ -0,0 +1,1376 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package presencetest
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Optional3         protoreflect.MessageDescriptor
+	fd_Optional3_int32   protoreflect.FieldDescriptor
+	fd_Optional3_sint64  protoreflect.FieldDescriptor
+	fd_Optional3_double  protoreflect.FieldDescriptor
+	fd_Optional3_bool    protoreflect.FieldDescriptor
+	fd_Optional3_string  protoreflect.FieldDescriptor
+	fd_Optional3_bytes   protoreflect.FieldDescriptor
+	fd_Optional3_kind    protoreflect.FieldDescriptor
+	fd_Optional3_message protoreflect.FieldDescriptor
+	fd_Optional3_text    protoreflect.FieldDescriptor
+	fd_Optional3_number  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_presencetest_optional_proto_init()
+	md_Optional3 = File_internal_testprotos_presencetest_optional_proto.Messages().ByName("Optional3")
+	fd_Optional3_int32 = md_Optional3.Fields().ByName("int32")
+	fd_Optional3_sint64 = md_Optional3.Fields().ByName("sint64")
+	fd_Optional3_double = md_Optional3.Fields().ByName("double")
+	fd_Optional3_bool = md_Optional3.Fields().ByName("bool")
+	fd_Optional3_string = md_Optional3.Fields().ByName("string")
+	fd_Optional3_bytes = md_Optional3.Fields().ByName("bytes")
+	fd_Optional3_kind = md_Optional3.Fields().ByName("kind")
+	fd_Optional3_message = md_Optional3.Fields().ByName("message")
+	fd_Optional3_text = md_Optional3.Fields().ByName("text")
+	fd_Optional3_number = md_Optional3.Fields().ByName("number")
+}
+
+var _ protoreflect.Message = (*fastReflection_Optional3)(nil)
+
+type fastReflection_Optional3 Optional3
+
+func (x *Optional3) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Optional3)(x)
+}
+
+func (x *Optional3) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_presencetest_optional_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Optional3_messageType fastReflection_Optional3_messageType
+var _ protoreflect.MessageType = fastReflection_Optional3_messageType{}
+
+type fastReflection_Optional3_messageType struct{}
+
+func (x fastReflection_Optional3_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Optional3)(nil)
+}
+func (x fastReflection_Optional3_messageType) New() protoreflect.Message {
+	return new(fastReflection_Optional3)
+}
+func (x fastReflection_Optional3_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Optional3
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Optional3) Descriptor() protoreflect.MessageDescriptor {
+	return md_Optional3
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Optional3) Type() protoreflect.MessageType {
+	return _fastReflection_Optional3_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Optional3) New() protoreflect.Message {
+	return new(fastReflection_Optional3)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Optional3) Interface() protoreflect.ProtoMessage {
+	return (*Optional3)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Optional3) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Int32 != nil {
+		value := protoreflect.ValueOfInt32(*x.Int32)
+		if !f(fd_Optional3_int32, value) {
+			return
+		}
+	}
+	if x.Sint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.Sint64)
+		if !f(fd_Optional3_sint64, value) {
+			return
+		}
+	}
+	if x.Double != nil {
+		value := protoreflect.ValueOfFloat64(*x.Double)
+		if !f(fd_Optional3_double, value) {
+			return
+		}
+	}
+	if x.Bool != nil {
+		value := protoreflect.ValueOfBool(*x.Bool)
+		if !f(fd_Optional3_bool, value) {
+			return
+		}
+	}
+	if x.String_ != nil {
+		value := protoreflect.ValueOfString(*x.String_)
+		if !f(fd_Optional3_string, value) {
+			return
+		}
+	}
+	if x.Bytes != nil {
+		value := protoreflect.ValueOfBytes(x.Bytes)
+		if !f(fd_Optional3_bytes, value) {
+			return
+		}
+	}
+	if x.Kind != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.Kind))
+		if !f(fd_Optional3_kind, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_Optional3_message, value) {
+			return
+		}
+	}
+	if x.Value != nil {
+		switch o := x.Value.(type) {
+		case *Optional3_Text:
+			v := o.Text
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_Optional3_text, value) {
+				return
+			}
+		case *Optional3_Number:
+			v := o.Number
+			value := protoreflect.ValueOfInt64(v)
+			if !f(fd_Optional3_number, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Optional3) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "presencetest.Optional3.int32":
+		return x.Int32 != nil
+	case "presencetest.Optional3.sint64":
+		return x.Sint64 != nil
+	case "presencetest.Optional3.double":
+		return x.Double != nil
+	case "presencetest.Optional3.bool":
+		return x.Bool != nil
+	case "presencetest.Optional3.string":
+		return x.String_ != nil
+	case "presencetest.Optional3.bytes":
+		return x.Bytes != nil
+	case "presencetest.Optional3.kind":
+		return x.Kind != nil
+	case "presencetest.Optional3.message":
+		return x.Message != nil
+	case "presencetest.Optional3.text":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Optional3_Text); ok {
+			return true
+		} else {
+			return false
+		}
+	case "presencetest.Optional3.number":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Optional3_Number); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: presencetest.Optional3"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional3 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional3) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "presencetest.Optional3.int32":
+		x.Int32 = nil
+	case "presencetest.Optional3.sint64":
+		x.Sint64 = nil
+	case "presencetest.Optional3.double":
+		x.Double = nil
+	case "presencetest.Optional3.bool":
+		x.Bool = nil
+	case "presencetest.Optional3.string":
+		x.String_ = nil
+	case "presencetest.Optional3.bytes":
+		x.Bytes = nil
+	case "presencetest.Optional3.kind":
+		x.Kind = nil
+	case "presencetest.Optional3.message":
+		x.Message = nil
+	case "presencetest.Optional3.text":
+		x.Value = nil
+	case "presencetest.Optional3.number":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: presencetest.Optional3"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional3 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Optional3) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "presencetest.Optional3.int32":
+		if x.Int32 == nil {
+			return fd_Optional3_int32.Default()
+		}
+		value := *x.Int32
+		return protoreflect.ValueOfInt32(value)
+	case "presencetest.Optional3.sint64":
+		if x.Sint64 == nil {
+			return fd_Optional3_sint64.Default()
+		}
+		value := *x.Sint64
+		return protoreflect.ValueOfInt64(value)
+	case "presencetest.Optional3.double":
+		if x.Double == nil {
+			return fd_Optional3_double.Default()
+		}
+		value := *x.Double
+		return protoreflect.ValueOfFloat64(value)
+	case "presencetest.Optional3.bool":
+		if x.Bool == nil {
+			return fd_Optional3_bool.Default()
+		}
+		value := *x.Bool
+		return protoreflect.ValueOfBool(value)
+	case "presencetest.Optional3.string":
+		if x.String_ == nil {
+			return fd_Optional3_string.Default()
+		}
+		value := *x.String_
+		return protoreflect.ValueOfString(value)
+	case "presencetest.Optional3.bytes":
+		value := x.Bytes
+		return protoreflect.ValueOfBytes(value)
+	case "presencetest.Optional3.kind":
+		if x.Kind == nil {
+			return fd_Optional3_kind.Default()
+		}
+		value := *x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "presencetest.Optional3.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "presencetest.Optional3.text":
+		if x.Value == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Value.(*Optional3_Text); ok {
+			return protoreflect.ValueOfString(v.Text)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case "presencetest.Optional3.number":
+		if x.Value == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		} else if v, ok := x.Value.(*Optional3_Number); ok {
+			return protoreflect.ValueOfInt64(v.Number)
+		} else {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: presencetest.Optional3"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional3 does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional3) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "presencetest.Optional3.int32":
+		cv := int32(value.Int())
+		x.Int32 = &cv
+	case "presencetest.Optional3.sint64":
+		cv := value.Int()
+		x.Sint64 = &cv
+	case "presencetest.Optional3.double":
+		cv := value.Float()
+		x.Double = &cv
+	case "presencetest.Optional3.bool":
+		cv := value.Bool()
+		x.Bool = &cv
+	case "presencetest.Optional3.string":
+		cv := value.Interface().(string)
+		x.String_ = &cv
+	case "presencetest.Optional3.bytes":
+		cv := value.Bytes()
+		if cv == nil {
+			cv = []byte{}
+		}
+		x.Bytes = cv
+	case "presencetest.Optional3.kind":
+		cv := (Kind3)(value.Enum())
+		x.Kind = &cv
+	case "presencetest.Optional3.message":
+		x.Message = value.Message().Interface().(*Optional3)
+	case "presencetest.Optional3.text":
+		cv := value.Interface().(string)
+		x.Value = &Optional3_Text{Text: cv}
+	case "presencetest.Optional3.number":
+		cv := value.Int()
+		x.Value = &Optional3_Number{Number: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: presencetest.Optional3"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional3 does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional3) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Optional3.message":
+		if x.Message == nil {
+			x.Message = new(Optional3)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "presencetest.Optional3.int32":
+		panic(fmt.Errorf("field int32 of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.sint64":
+		panic(fmt.Errorf("field sint64 of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.double":
+		panic(fmt.Errorf("field double of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.bool":
+		panic(fmt.Errorf("field bool of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.string":
+		panic(fmt.Errorf("field string of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.bytes":
+		panic(fmt.Errorf("field bytes of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.kind":
+		panic(fmt.Errorf("field kind of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.text":
+		panic(fmt.Errorf("field text of message presencetest.Optional3 is not mutable"))
+	case "presencetest.Optional3.number":
+		panic(fmt.Errorf("field number of message presencetest.Optional3 is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: presencetest.Optional3"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional3 does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Optional3) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Optional3.int32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "presencetest.Optional3.sint64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "presencetest.Optional3.double":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "presencetest.Optional3.bool":
+		return protoreflect.ValueOfBool(false)
+	case "presencetest.Optional3.string":
+		return protoreflect.ValueOfString("")
+	case "presencetest.Optional3.bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "presencetest.Optional3.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "presencetest.Optional3.message":
+		m := new(Optional3)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "presencetest.Optional3.text":
+		return protoreflect.ValueOfString("")
+	case "presencetest.Optional3.number":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: presencetest.Optional3"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional3 does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Optional3) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "presencetest.Optional3.value":
+		if x.Value == nil {
+			return nil
+		}
+		switch x.Value.(type) {
+		case *Optional3_Text:
+			return md_Optional3.Fields().ByName("text")
+		case *Optional3_Number:
+			return md_Optional3.Fields().ByName("number")
+		}
+	case "presencetest.Optional3._int32":
+		if x.Int32 == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("int32")
+	case "presencetest.Optional3._sint64":
+		if x.Sint64 == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("sint64")
+	case "presencetest.Optional3._double":
+		if x.Double == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("double")
+	case "presencetest.Optional3._bool":
+		if x.Bool == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("bool")
+	case "presencetest.Optional3._string":
+		if x.String_ == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("string")
+	case "presencetest.Optional3._bytes":
+		if x.Bytes == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("bytes")
+	case "presencetest.Optional3._kind":
+		if x.Kind == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("kind")
+	case "presencetest.Optional3._message":
+		if x.Message == nil {
+			return nil
+		}
+		return md_Optional3.Fields().ByName("message")
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in presencetest.Optional3", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Optional3) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional3) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Optional3) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Optional3) ProtoMethods() *protoiface.Methods {
+	return fastReflection_Optional3ProtoMethods
+}
+
+var fastReflection_Optional3ProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Optional3)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Int32 != nil {
+			n += 1 + runtime.Sov(uint64(*x.Int32))
+		}
+		if x.Sint64 != nil {
+			n += 1 + runtime.Soz(uint64(*x.Sint64))
+		}
+		if x.Double != nil {
+			n += 9
+		}
+		if x.Bool != nil {
+			n += 2
+		}
+		if x.String_ != nil {
+			l = len(*x.String_)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bytes != nil {
+			l = len(x.Bytes)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Kind != nil {
+			n += 1 + runtime.Sov(uint64(*x.Kind))
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		switch x := x.Value.(type) {
+		case *Optional3_Text:
+			if x == nil {
+				break
+			}
+			l = len(x.Text)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *Optional3_Number:
+			if x == nil {
+				break
+			}
+			n += 1 + runtime.Sov(uint64(x.Number))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Optional3)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Value.(type) {
+		case *Optional3_Text:
+			i -= len(x.Text)
+			copy(dAtA[i:], x.Text)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Text)))
+			i--
+			dAtA[i] = 0x4a
+		case *Optional3_Number:
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Number))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Kind != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Kind))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Bytes != nil {
+			i -= len(x.Bytes)
+			copy(dAtA[i:], x.Bytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bytes)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.String_ != nil {
+			i -= len(*x.String_)
+			copy(dAtA[i:], *x.String_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.String_)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Bool != nil {
+			i--
+			if *x.Bool {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Double != nil {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.Double))))
+			i--
+			dAtA[i] = 0x19
+		}
+		if x.Sint64 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(*x.Sint64)<<1)^uint64((*x.Sint64>>63))))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Int32 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Int32))
+			i--
+			dAtA[i] = 0x8
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Optional3)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Optional3: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Optional3: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Int32", wireType)
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Int32 = &v
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sint64", wireType)
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				v2 := int64(v)
+				x.Sint64 = &v2
+			case 3:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Double", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.Double = &v2
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bool", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				b := bool(v != 0)
+				x.Bool = &b
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				s := string(dAtA[iNdEx:postIndex])
+				x.String_ = &s
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bytes = append(x.Bytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bytes == nil {
+					x.Bytes = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				var v Kind3
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Kind3(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Kind = &v
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &Optional3{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = &Optional3_Text{string(dAtA[iNdEx:postIndex])}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				}
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Value = &Optional3_Number{v}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		return protoiface.CheckInitializedOutput{}, nil
+	}
+	fastReflection_Optional3ProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: internal/testprotos/presencetest/optional.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Kind3 int32
+
+const (
+	Kind3_KIND3_UNSPECIFIED Kind3 = 0
+	Kind3_KIND3_A           Kind3 = 1
+)
+
+// Enum value maps for Kind3.
+var (
+	Kind3_name = map[int32]string{
+		0: "KIND3_UNSPECIFIED",
+		1: "KIND3_A",
+	}
+	Kind3_value = map[string]int32{
+		"KIND3_UNSPECIFIED": 0,
+		"KIND3_A":           1,
+	}
+)
+
+func (x Kind3) Enum() *Kind3 {
+	p := new(Kind3)
+	*p = x
+	return p
+}
+
+func (x Kind3) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind3) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_presencetest_optional_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind3) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_presencetest_optional_proto_enumTypes[0]
+}
+
+func (x Kind3) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind3.Descriptor instead.
+func (Kind3) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_presencetest_optional_proto_rawDescGZIP(), []int{0}
+}
+
+// Optional3 has proto3 optional fields, whose synthetic oneofs have no Go field.
+type Optional3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32   *int32     `protobuf:"varint,1,opt,name=int32,proto3,oneof" json:"int32,omitempty"`
+	Sint64  *int64     `protobuf:"zigzag64,2,opt,name=sint64,proto3,oneof" json:"sint64,omitempty"`
+	Double  *float64   `protobuf:"fixed64,3,opt,name=double,proto3,oneof" json:"double,omitempty"`
+	Bool    *bool      `protobuf:"varint,4,opt,name=bool,proto3,oneof" json:"bool,omitempty"`
+	String_ *string    `protobuf:"bytes,5,opt,name=string,proto3,oneof" json:"string,omitempty"`
+	Bytes   []byte     `protobuf:"bytes,6,opt,name=bytes,proto3,oneof" json:"bytes,omitempty"`
+	Kind    *Kind3     `protobuf:"varint,7,opt,name=kind,proto3,enum=presencetest.Kind3,oneof" json:"kind,omitempty"`
+	Message *Optional3 `protobuf:"bytes,8,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	// Types that are assignable to Value:
+	//	*Optional3_Text
+	//	*Optional3_Number
+	Value isOptional3_Value `protobuf_oneof:"value"`
+}
+
+func (x *Optional3) Reset() {
+	*x = Optional3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_presencetest_optional_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Optional3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Optional3) ProtoMessage() {}
+
+// Deprecated: Use Optional3.ProtoReflect.Descriptor instead.
+func (*Optional3) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_presencetest_optional_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Optional3) GetInt32() int32 {
+	if x != nil && x.Int32 != nil {
+		return *x.Int32
+	}
+	return 0
+}
+
+func (x *Optional3) GetSint64() int64 {
+	if x != nil && x.Sint64 != nil {
+		return *x.Sint64
+	}
+	return 0
+}
+
+func (x *Optional3) GetDouble() float64 {
+	if x != nil && x.Double != nil {
+		return *x.Double
+	}
+	return 0
+}
+
+func (x *Optional3) GetBool() bool {
+	if x != nil && x.Bool != nil {
+		return *x.Bool
+	}
+	return false
+}
+
+func (x *Optional3) GetString_() string {
+	if x != nil && x.String_ != nil {
+		return *x.String_
+	}
+	return ""
+}
+
+func (x *Optional3) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Optional3) GetKind() Kind3 {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return Kind3_KIND3_UNSPECIFIED
+}
+
+func (x *Optional3) GetMessage() *Optional3 {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Optional3) GetValue() isOptional3_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Optional3) GetText() string {
+	if x, ok := x.GetValue().(*Optional3_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Optional3) GetNumber() int64 {
+	if x, ok := x.GetValue().(*Optional3_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+type isOptional3_Value interface {
+	isOptional3_Value()
+}
+
+type Optional3_Text struct {
+	Text string `protobuf:"bytes,9,opt,name=text,proto3,oneof"`
+}
+
+type Optional3_Number struct {
+	Number int64 `protobuf:"varint,10,opt,name=number,proto3,oneof"`
+}
+
+func (*Optional3_Text) isOptional3_Value() {}
+
+func (*Optional3_Number) isOptional3_Value() {}
+
+var File_internal_testprotos_presencetest_optional_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_presencetest_optional_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65,
+	0x73, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x22,
+	0xa3, 0x03, 0x0a, 0x09, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x33, 0x12, 0x19, 0x0a,
+	0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x48, 0x02, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x04, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x06, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x33, 0x48, 0x07, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x33, 0x48, 0x08, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x2b, 0x0a, 0x05, 0x4b, 0x69, 0x6e, 0x64, 0x33, 0x12, 0x15,
+	0x0a, 0x11, 0x4b, 0x49, 0x4e, 0x44, 0x33, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x49, 0x4e, 0x44, 0x33, 0x5f, 0x41,
+	0x10, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_testprotos_presencetest_optional_proto_rawDescOnce sync.Once
+	file_internal_testprotos_presencetest_optional_proto_rawDescData = file_internal_testprotos_presencetest_optional_proto_rawDesc
+)
+
+func file_internal_testprotos_presencetest_optional_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_presencetest_optional_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_presencetest_optional_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_presencetest_optional_proto_rawDescData)
+	})
+	return file_internal_testprotos_presencetest_optional_proto_rawDescData
+}
+
+var file_internal_testprotos_presencetest_optional_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_testprotos_presencetest_optional_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_testprotos_presencetest_optional_proto_goTypes = []interface{}{
+	(Kind3)(0),        // 0: presencetest.Kind3
+	(*Optional3)(nil), // 1: presencetest.Optional3
+}
+var file_internal_testprotos_presencetest_optional_proto_depIdxs = []int32{
+	0, // 0: presencetest.Optional3.kind:type_name -> presencetest.Kind3
+	1, // 1: presencetest.Optional3.message:type_name -> presencetest.Optional3
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_presencetest_optional_proto_init() }
+func file_internal_testprotos_presencetest_optional_proto_init() {
+	if File_internal_testprotos_presencetest_optional_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_presencetest_optional_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Optional3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_presencetest_optional_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Optional3_Text)(nil),
+		(*Optional3_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_presencetest_optional_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_presencetest_optional_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_presencetest_optional_proto_depIdxs,
+		EnumInfos:         file_internal_testprotos_presencetest_optional_proto_enumTypes,
+		MessageInfos:      file_internal_testprotos_presencetest_optional_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_presencetest_optional_proto = out.File
+	file_internal_testprotos_presencetest_optional_proto_rawDesc = nil
+	file_internal_testprotos_presencetest_optional_proto_goTypes = nil
+	file_internal_testprotos_presencetest_optional_proto_depIdxs = nil
+}
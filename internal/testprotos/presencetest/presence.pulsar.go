@@ -0,0 +1,3782 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package presencetest
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+)
+
+var (
+	md_Optional          protoreflect.MessageDescriptor
+	fd_Optional_bool     protoreflect.FieldDescriptor
+	fd_Optional_int32    protoreflect.FieldDescriptor
+	fd_Optional_sint32   protoreflect.FieldDescriptor
+	fd_Optional_uint32   protoreflect.FieldDescriptor
+	fd_Optional_int64    protoreflect.FieldDescriptor
+	fd_Optional_sint64   protoreflect.FieldDescriptor
+	fd_Optional_uint64   protoreflect.FieldDescriptor
+	fd_Optional_sfixed32 protoreflect.FieldDescriptor
+	fd_Optional_fixed32  protoreflect.FieldDescriptor
+	fd_Optional_float    protoreflect.FieldDescriptor
+	fd_Optional_sfixed64 protoreflect.FieldDescriptor
+	fd_Optional_fixed64  protoreflect.FieldDescriptor
+	fd_Optional_double   protoreflect.FieldDescriptor
+	fd_Optional_string   protoreflect.FieldDescriptor
+	fd_Optional_bytes    protoreflect.FieldDescriptor
+	fd_Optional_kind     protoreflect.FieldDescriptor
+	fd_Optional_message  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_presencetest_presence_proto_init()
+	md_Optional = File_internal_testprotos_presencetest_presence_proto.Messages().ByName("Optional")
+	fd_Optional_bool = md_Optional.Fields().ByName("bool")
+	fd_Optional_int32 = md_Optional.Fields().ByName("int32")
+	fd_Optional_sint32 = md_Optional.Fields().ByName("sint32")
+	fd_Optional_uint32 = md_Optional.Fields().ByName("uint32")
+	fd_Optional_int64 = md_Optional.Fields().ByName("int64")
+	fd_Optional_sint64 = md_Optional.Fields().ByName("sint64")
+	fd_Optional_uint64 = md_Optional.Fields().ByName("uint64")
+	fd_Optional_sfixed32 = md_Optional.Fields().ByName("sfixed32")
+	fd_Optional_fixed32 = md_Optional.Fields().ByName("fixed32")
+	fd_Optional_float = md_Optional.Fields().ByName("float")
+	fd_Optional_sfixed64 = md_Optional.Fields().ByName("sfixed64")
+	fd_Optional_fixed64 = md_Optional.Fields().ByName("fixed64")
+	fd_Optional_double = md_Optional.Fields().ByName("double")
+	fd_Optional_string = md_Optional.Fields().ByName("string")
+	fd_Optional_bytes = md_Optional.Fields().ByName("bytes")
+	fd_Optional_kind = md_Optional.Fields().ByName("kind")
+	fd_Optional_message = md_Optional.Fields().ByName("message")
+}
+
+var _ protoreflect.Message = (*fastReflection_Optional)(nil)
+
+type fastReflection_Optional Optional
+
+func (x *Optional) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Optional)(x)
+}
+
+func (x *Optional) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_presencetest_presence_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Optional_messageType fastReflection_Optional_messageType
+var _ protoreflect.MessageType = fastReflection_Optional_messageType{}
+
+type fastReflection_Optional_messageType struct{}
+
+func (x fastReflection_Optional_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Optional)(nil)
+}
+func (x fastReflection_Optional_messageType) New() protoreflect.Message {
+	return new(fastReflection_Optional)
+}
+func (x fastReflection_Optional_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Optional
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Optional) Descriptor() protoreflect.MessageDescriptor {
+	return md_Optional
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Optional) Type() protoreflect.MessageType {
+	return _fastReflection_Optional_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Optional) New() protoreflect.Message {
+	return new(fastReflection_Optional)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Optional) Interface() protoreflect.ProtoMessage {
+	return (*Optional)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Optional) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bool != nil {
+		value := protoreflect.ValueOfBool(*x.Bool)
+		if !f(fd_Optional_bool, value) {
+			return
+		}
+	}
+	if x.Int32 != nil {
+		value := protoreflect.ValueOfInt32(*x.Int32)
+		if !f(fd_Optional_int32, value) {
+			return
+		}
+	}
+	if x.Sint32 != nil {
+		value := protoreflect.ValueOfInt32(*x.Sint32)
+		if !f(fd_Optional_sint32, value) {
+			return
+		}
+	}
+	if x.Uint32 != nil {
+		value := protoreflect.ValueOfUint32(*x.Uint32)
+		if !f(fd_Optional_uint32, value) {
+			return
+		}
+	}
+	if x.Int64 != nil {
+		value := protoreflect.ValueOfInt64(*x.Int64)
+		if !f(fd_Optional_int64, value) {
+			return
+		}
+	}
+	if x.Sint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.Sint64)
+		if !f(fd_Optional_sint64, value) {
+			return
+		}
+	}
+	if x.Uint64 != nil {
+		value := protoreflect.ValueOfUint64(*x.Uint64)
+		if !f(fd_Optional_uint64, value) {
+			return
+		}
+	}
+	if x.Sfixed32 != nil {
+		value := protoreflect.ValueOfInt32(*x.Sfixed32)
+		if !f(fd_Optional_sfixed32, value) {
+			return
+		}
+	}
+	if x.Fixed32 != nil {
+		value := protoreflect.ValueOfUint32(*x.Fixed32)
+		if !f(fd_Optional_fixed32, value) {
+			return
+		}
+	}
+	if x.Float != nil {
+		value := protoreflect.ValueOfFloat32(*x.Float)
+		if !f(fd_Optional_float, value) {
+			return
+		}
+	}
+	if x.Sfixed64 != nil {
+		value := protoreflect.ValueOfInt64(*x.Sfixed64)
+		if !f(fd_Optional_sfixed64, value) {
+			return
+		}
+	}
+	if x.Fixed64 != nil {
+		value := protoreflect.ValueOfUint64(*x.Fixed64)
+		if !f(fd_Optional_fixed64, value) {
+			return
+		}
+	}
+	if x.Double != nil {
+		value := protoreflect.ValueOfFloat64(*x.Double)
+		if !f(fd_Optional_double, value) {
+			return
+		}
+	}
+	if x.String_ != nil {
+		value := protoreflect.ValueOfString(*x.String_)
+		if !f(fd_Optional_string, value) {
+			return
+		}
+	}
+	if x.Bytes != nil {
+		value := protoreflect.ValueOfBytes(x.Bytes)
+		if !f(fd_Optional_bytes, value) {
+			return
+		}
+	}
+	if x.Kind != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.Kind))
+		if !f(fd_Optional_kind, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_Optional_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Optional) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "presencetest.Optional.bool":
+		return x.Bool != nil
+	case "presencetest.Optional.int32":
+		return x.Int32 != nil
+	case "presencetest.Optional.sint32":
+		return x.Sint32 != nil
+	case "presencetest.Optional.uint32":
+		return x.Uint32 != nil
+	case "presencetest.Optional.int64":
+		return x.Int64 != nil
+	case "presencetest.Optional.sint64":
+		return x.Sint64 != nil
+	case "presencetest.Optional.uint64":
+		return x.Uint64 != nil
+	case "presencetest.Optional.sfixed32":
+		return x.Sfixed32 != nil
+	case "presencetest.Optional.fixed32":
+		return x.Fixed32 != nil
+	case "presencetest.Optional.float":
+		return x.Float != nil
+	case "presencetest.Optional.sfixed64":
+		return x.Sfixed64 != nil
+	case "presencetest.Optional.fixed64":
+		return x.Fixed64 != nil
+	case "presencetest.Optional.double":
+		return x.Double != nil
+	case "presencetest.Optional.string":
+		return x.String_ != nil
+	case "presencetest.Optional.bytes":
+		return x.Bytes != nil
+	case "presencetest.Optional.kind":
+		return x.Kind != nil
+	case "presencetest.Optional.message":
+		return x.Message != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Optional does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "presencetest.Optional.bool":
+		x.Bool = nil
+	case "presencetest.Optional.int32":
+		x.Int32 = nil
+	case "presencetest.Optional.sint32":
+		x.Sint32 = nil
+	case "presencetest.Optional.uint32":
+		x.Uint32 = nil
+	case "presencetest.Optional.int64":
+		x.Int64 = nil
+	case "presencetest.Optional.sint64":
+		x.Sint64 = nil
+	case "presencetest.Optional.uint64":
+		x.Uint64 = nil
+	case "presencetest.Optional.sfixed32":
+		x.Sfixed32 = nil
+	case "presencetest.Optional.fixed32":
+		x.Fixed32 = nil
+	case "presencetest.Optional.float":
+		x.Float = nil
+	case "presencetest.Optional.sfixed64":
+		x.Sfixed64 = nil
+	case "presencetest.Optional.fixed64":
+		x.Fixed64 = nil
+	case "presencetest.Optional.double":
+		x.Double = nil
+	case "presencetest.Optional.string":
+		x.String_ = nil
+	case "presencetest.Optional.bytes":
+		x.Bytes = nil
+	case "presencetest.Optional.kind":
+		x.Kind = nil
+	case "presencetest.Optional.message":
+		x.Message = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Optional does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Optional) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "presencetest.Optional.bool":
+		if x.Bool == nil {
+			return fd_Optional_bool.Default()
+		}
+		value := *x.Bool
+		return protoreflect.ValueOfBool(value)
+	case "presencetest.Optional.int32":
+		if x.Int32 == nil {
+			return fd_Optional_int32.Default()
+		}
+		value := *x.Int32
+		return protoreflect.ValueOfInt32(value)
+	case "presencetest.Optional.sint32":
+		if x.Sint32 == nil {
+			return fd_Optional_sint32.Default()
+		}
+		value := *x.Sint32
+		return protoreflect.ValueOfInt32(value)
+	case "presencetest.Optional.uint32":
+		if x.Uint32 == nil {
+			return fd_Optional_uint32.Default()
+		}
+		value := *x.Uint32
+		return protoreflect.ValueOfUint32(value)
+	case "presencetest.Optional.int64":
+		if x.Int64 == nil {
+			return fd_Optional_int64.Default()
+		}
+		value := *x.Int64
+		return protoreflect.ValueOfInt64(value)
+	case "presencetest.Optional.sint64":
+		if x.Sint64 == nil {
+			return fd_Optional_sint64.Default()
+		}
+		value := *x.Sint64
+		return protoreflect.ValueOfInt64(value)
+	case "presencetest.Optional.uint64":
+		if x.Uint64 == nil {
+			return fd_Optional_uint64.Default()
+		}
+		value := *x.Uint64
+		return protoreflect.ValueOfUint64(value)
+	case "presencetest.Optional.sfixed32":
+		if x.Sfixed32 == nil {
+			return fd_Optional_sfixed32.Default()
+		}
+		value := *x.Sfixed32
+		return protoreflect.ValueOfInt32(value)
+	case "presencetest.Optional.fixed32":
+		if x.Fixed32 == nil {
+			return fd_Optional_fixed32.Default()
+		}
+		value := *x.Fixed32
+		return protoreflect.ValueOfUint32(value)
+	case "presencetest.Optional.float":
+		if x.Float == nil {
+			return fd_Optional_float.Default()
+		}
+		value := *x.Float
+		return protoreflect.ValueOfFloat32(value)
+	case "presencetest.Optional.sfixed64":
+		if x.Sfixed64 == nil {
+			return fd_Optional_sfixed64.Default()
+		}
+		value := *x.Sfixed64
+		return protoreflect.ValueOfInt64(value)
+	case "presencetest.Optional.fixed64":
+		if x.Fixed64 == nil {
+			return fd_Optional_fixed64.Default()
+		}
+		value := *x.Fixed64
+		return protoreflect.ValueOfUint64(value)
+	case "presencetest.Optional.double":
+		if x.Double == nil {
+			return fd_Optional_double.Default()
+		}
+		value := *x.Double
+		return protoreflect.ValueOfFloat64(value)
+	case "presencetest.Optional.string":
+		if x.String_ == nil {
+			return fd_Optional_string.Default()
+		}
+		value := *x.String_
+		return protoreflect.ValueOfString(value)
+	case "presencetest.Optional.bytes":
+		value := x.Bytes
+		return protoreflect.ValueOfBytes(value)
+	case "presencetest.Optional.kind":
+		if x.Kind == nil {
+			return fd_Optional_kind.Default()
+		}
+		value := *x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "presencetest.Optional.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Optional does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "presencetest.Optional.bool":
+		cv := value.Bool()
+		x.Bool = &cv
+	case "presencetest.Optional.int32":
+		cv := int32(value.Int())
+		x.Int32 = &cv
+	case "presencetest.Optional.sint32":
+		cv := int32(value.Int())
+		x.Sint32 = &cv
+	case "presencetest.Optional.uint32":
+		cv := uint32(value.Uint())
+		x.Uint32 = &cv
+	case "presencetest.Optional.int64":
+		cv := value.Int()
+		x.Int64 = &cv
+	case "presencetest.Optional.sint64":
+		cv := value.Int()
+		x.Sint64 = &cv
+	case "presencetest.Optional.uint64":
+		cv := value.Uint()
+		x.Uint64 = &cv
+	case "presencetest.Optional.sfixed32":
+		cv := int32(value.Int())
+		x.Sfixed32 = &cv
+	case "presencetest.Optional.fixed32":
+		cv := uint32(value.Uint())
+		x.Fixed32 = &cv
+	case "presencetest.Optional.float":
+		cv := float32(value.Float())
+		x.Float = &cv
+	case "presencetest.Optional.sfixed64":
+		cv := value.Int()
+		x.Sfixed64 = &cv
+	case "presencetest.Optional.fixed64":
+		cv := value.Uint()
+		x.Fixed64 = &cv
+	case "presencetest.Optional.double":
+		cv := value.Float()
+		x.Double = &cv
+	case "presencetest.Optional.string":
+		cv := value.Interface().(string)
+		x.String_ = &cv
+	case "presencetest.Optional.bytes":
+		cv := value.Bytes()
+		if cv == nil {
+			cv = []byte{}
+		}
+		x.Bytes = cv
+	case "presencetest.Optional.kind":
+		cv := (Kind)(value.Enum())
+		x.Kind = &cv
+	case "presencetest.Optional.message":
+		x.Message = value.Message().Interface().(*Optional)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Optional does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Optional.message":
+		if x.Message == nil {
+			x.Message = new(Optional)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "presencetest.Optional.bool":
+		panic(fmt.Errorf("field bool of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.int32":
+		panic(fmt.Errorf("field int32 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.sint32":
+		panic(fmt.Errorf("field sint32 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.uint32":
+		panic(fmt.Errorf("field uint32 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.int64":
+		panic(fmt.Errorf("field int64 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.sint64":
+		panic(fmt.Errorf("field sint64 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.uint64":
+		panic(fmt.Errorf("field uint64 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.sfixed32":
+		panic(fmt.Errorf("field sfixed32 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.fixed32":
+		panic(fmt.Errorf("field fixed32 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.float":
+		panic(fmt.Errorf("field float of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.sfixed64":
+		panic(fmt.Errorf("field sfixed64 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.fixed64":
+		panic(fmt.Errorf("field fixed64 of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.double":
+		panic(fmt.Errorf("field double of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.string":
+		panic(fmt.Errorf("field string of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.bytes":
+		panic(fmt.Errorf("field bytes of message presencetest.Optional is not mutable"))
+	case "presencetest.Optional.kind":
+		panic(fmt.Errorf("field kind of message presencetest.Optional is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Optional does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Optional) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Optional.bool":
+		return protoreflect.ValueOfBool(false)
+	case "presencetest.Optional.int32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "presencetest.Optional.sint32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "presencetest.Optional.uint32":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "presencetest.Optional.int64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "presencetest.Optional.sint64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "presencetest.Optional.uint64":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "presencetest.Optional.sfixed32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "presencetest.Optional.fixed32":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "presencetest.Optional.float":
+		return protoreflect.ValueOfFloat32(float32(0))
+	case "presencetest.Optional.sfixed64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "presencetest.Optional.fixed64":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "presencetest.Optional.double":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "presencetest.Optional.string":
+		return protoreflect.ValueOfString("")
+	case "presencetest.Optional.bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "presencetest.Optional.kind":
+		return protoreflect.ValueOfEnum(1)
+	case "presencetest.Optional.message":
+		m := new(Optional)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Optional does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Optional does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Optional) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in presencetest.Optional", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Optional) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optional) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Optional) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Optional) ProtoMethods() *protoiface.Methods {
+	return fastReflection_OptionalProtoMethods
+}
+
+var fastReflection_OptionalProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Optional)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Bool != nil {
+			n += 2
+		}
+		if x.Int32 != nil {
+			n += 1 + runtime.Sov(uint64(*x.Int32))
+		}
+		if x.Sint32 != nil {
+			n += 1 + runtime.Soz(uint64(*x.Sint32))
+		}
+		if x.Uint32 != nil {
+			n += 1 + runtime.Sov(uint64(*x.Uint32))
+		}
+		if x.Int64 != nil {
+			n += 1 + runtime.Sov(uint64(*x.Int64))
+		}
+		if x.Sint64 != nil {
+			n += 1 + runtime.Soz(uint64(*x.Sint64))
+		}
+		if x.Uint64 != nil {
+			n += 1 + runtime.Sov(uint64(*x.Uint64))
+		}
+		if x.Sfixed32 != nil {
+			n += 5
+		}
+		if x.Fixed32 != nil {
+			n += 5
+		}
+		if x.Float != nil {
+			n += 5
+		}
+		if x.Sfixed64 != nil {
+			n += 9
+		}
+		if x.Fixed64 != nil {
+			n += 9
+		}
+		if x.Double != nil {
+			n += 9
+		}
+		if x.String_ != nil {
+			l = len(*x.String_)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bytes != nil {
+			l = len(x.Bytes)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Kind != nil {
+			n += 2 + runtime.Sov(uint64(*x.Kind))
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Optional)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.Kind != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Kind))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.Bytes != nil {
+			i -= len(x.Bytes)
+			copy(dAtA[i:], x.Bytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bytes)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.String_ != nil {
+			i -= len(*x.String_)
+			copy(dAtA[i:], *x.String_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.String_)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.Double != nil {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.Double))))
+			i--
+			dAtA[i] = 0x69
+		}
+		if x.Fixed64 != nil {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.Fixed64))
+			i--
+			dAtA[i] = 0x61
+		}
+		if x.Sfixed64 != nil {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(*x.Sfixed64))
+			i--
+			dAtA[i] = 0x59
+		}
+		if x.Float != nil {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*x.Float))))
+			i--
+			dAtA[i] = 0x55
+		}
+		if x.Fixed32 != nil {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.Fixed32))
+			i--
+			dAtA[i] = 0x4d
+		}
+		if x.Sfixed32 != nil {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(*x.Sfixed32))
+			i--
+			dAtA[i] = 0x45
+		}
+		if x.Uint64 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Uint64))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Sint64 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint64(*x.Sint64)<<1)^uint64((*x.Sint64>>63))))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Int64 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Int64))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Uint32 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Uint32))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Sint32 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64((uint32(*x.Sint32)<<1)^uint32((*x.Sint32>>31))))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Int32 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Int32))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Bool != nil {
+			i--
+			if *x.Bool {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Optional)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Optional: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Optional: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bool", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				b := bool(v != 0)
+				x.Bool = &b
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Int32", wireType)
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Int32 = &v
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sint32", wireType)
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				x.Sint32 = &v
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uint32", wireType)
+				}
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Uint32 = &v
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Int64", wireType)
+				}
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Int64 = &v
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sint64", wireType)
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				v2 := int64(v)
+				x.Sint64 = &v2
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uint64", wireType)
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Uint64 = &v
+			case 8:
+				if wireType != 5 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sfixed32", wireType)
+				}
+				var v int32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.Sfixed32 = &v
+			case 9:
+				if wireType != 5 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fixed32", wireType)
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				x.Fixed32 = &v
+			case 10:
+				if wireType != 5 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
+				}
+				var v uint32
+				if (iNdEx + 4) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				x.Float = &v2
+			case 11:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sfixed64", wireType)
+				}
+				var v int64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Sfixed64 = &v
+			case 12:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fixed64", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Fixed64 = &v
+			case 13:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Double", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.Double = &v2
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				s := string(dAtA[iNdEx:postIndex])
+				x.String_ = &s
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bytes = append(x.Bytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bytes == nil {
+					x.Bytes = []byte{}
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				var v Kind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Kind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Kind = &v
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &Optional{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		return protoiface.CheckInitializedOutput{}, nil
+	}
+	fastReflection_OptionalProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+var (
+	md_Defaults        protoreflect.MessageDescriptor
+	fd_Defaults_int32  protoreflect.FieldDescriptor
+	fd_Defaults_double protoreflect.FieldDescriptor
+	fd_Defaults_string protoreflect.FieldDescriptor
+	fd_Defaults_bytes  protoreflect.FieldDescriptor
+	fd_Defaults_kind   protoreflect.FieldDescriptor
+	fd_Defaults_first  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_presencetest_presence_proto_init()
+	md_Defaults = File_internal_testprotos_presencetest_presence_proto.Messages().ByName("Defaults")
+	fd_Defaults_int32 = md_Defaults.Fields().ByName("int32")
+	fd_Defaults_double = md_Defaults.Fields().ByName("double")
+	fd_Defaults_string = md_Defaults.Fields().ByName("string")
+	fd_Defaults_bytes = md_Defaults.Fields().ByName("bytes")
+	fd_Defaults_kind = md_Defaults.Fields().ByName("kind")
+	fd_Defaults_first = md_Defaults.Fields().ByName("first")
+}
+
+var _ protoreflect.Message = (*fastReflection_Defaults)(nil)
+
+type fastReflection_Defaults Defaults
+
+func (x *Defaults) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Defaults)(x)
+}
+
+func (x *Defaults) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_presencetest_presence_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Defaults_messageType fastReflection_Defaults_messageType
+var _ protoreflect.MessageType = fastReflection_Defaults_messageType{}
+
+type fastReflection_Defaults_messageType struct{}
+
+func (x fastReflection_Defaults_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Defaults)(nil)
+}
+func (x fastReflection_Defaults_messageType) New() protoreflect.Message {
+	return new(fastReflection_Defaults)
+}
+func (x fastReflection_Defaults_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Defaults
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Defaults) Descriptor() protoreflect.MessageDescriptor {
+	return md_Defaults
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Defaults) Type() protoreflect.MessageType {
+	return _fastReflection_Defaults_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Defaults) New() protoreflect.Message {
+	return new(fastReflection_Defaults)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Defaults) Interface() protoreflect.ProtoMessage {
+	return (*Defaults)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Defaults) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Int32 != nil {
+		value := protoreflect.ValueOfInt32(*x.Int32)
+		if !f(fd_Defaults_int32, value) {
+			return
+		}
+	}
+	if x.Double != nil {
+		value := protoreflect.ValueOfFloat64(*x.Double)
+		if !f(fd_Defaults_double, value) {
+			return
+		}
+	}
+	if x.String_ != nil {
+		value := protoreflect.ValueOfString(*x.String_)
+		if !f(fd_Defaults_string, value) {
+			return
+		}
+	}
+	if x.Bytes != nil {
+		value := protoreflect.ValueOfBytes(x.Bytes)
+		if !f(fd_Defaults_bytes, value) {
+			return
+		}
+	}
+	if x.Kind != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.Kind))
+		if !f(fd_Defaults_kind, value) {
+			return
+		}
+	}
+	if x.First != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.First))
+		if !f(fd_Defaults_first, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Defaults) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "presencetest.Defaults.int32":
+		return x.Int32 != nil
+	case "presencetest.Defaults.double":
+		return x.Double != nil
+	case "presencetest.Defaults.string":
+		return x.String_ != nil
+	case "presencetest.Defaults.bytes":
+		return x.Bytes != nil
+	case "presencetest.Defaults.kind":
+		return x.Kind != nil
+	case "presencetest.Defaults.first":
+		return x.First != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Defaults does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Defaults does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Defaults) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "presencetest.Defaults.int32":
+		x.Int32 = nil
+	case "presencetest.Defaults.double":
+		x.Double = nil
+	case "presencetest.Defaults.string":
+		x.String_ = nil
+	case "presencetest.Defaults.bytes":
+		x.Bytes = nil
+	case "presencetest.Defaults.kind":
+		x.Kind = nil
+	case "presencetest.Defaults.first":
+		x.First = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Defaults does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Defaults does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Defaults) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "presencetest.Defaults.int32":
+		if x.Int32 == nil {
+			return fd_Defaults_int32.Default()
+		}
+		value := *x.Int32
+		return protoreflect.ValueOfInt32(value)
+	case "presencetest.Defaults.double":
+		if x.Double == nil {
+			return fd_Defaults_double.Default()
+		}
+		value := *x.Double
+		return protoreflect.ValueOfFloat64(value)
+	case "presencetest.Defaults.string":
+		if x.String_ == nil {
+			return fd_Defaults_string.Default()
+		}
+		value := *x.String_
+		return protoreflect.ValueOfString(value)
+	case "presencetest.Defaults.bytes":
+		if x.Bytes == nil {
+			return protoreflect.ValueOfBytes(append([]byte(nil), fd_Defaults_bytes.Default().Bytes()...))
+		}
+		value := x.Bytes
+		return protoreflect.ValueOfBytes(value)
+	case "presencetest.Defaults.kind":
+		if x.Kind == nil {
+			return fd_Defaults_kind.Default()
+		}
+		value := *x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "presencetest.Defaults.first":
+		if x.First == nil {
+			return fd_Defaults_first.Default()
+		}
+		value := *x.First
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Defaults does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Defaults does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Defaults) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "presencetest.Defaults.int32":
+		cv := int32(value.Int())
+		x.Int32 = &cv
+	case "presencetest.Defaults.double":
+		cv := value.Float()
+		x.Double = &cv
+	case "presencetest.Defaults.string":
+		cv := value.Interface().(string)
+		x.String_ = &cv
+	case "presencetest.Defaults.bytes":
+		cv := value.Bytes()
+		if cv == nil {
+			cv = []byte{}
+		}
+		x.Bytes = cv
+	case "presencetest.Defaults.kind":
+		cv := (Kind)(value.Enum())
+		x.Kind = &cv
+	case "presencetest.Defaults.first":
+		cv := (Kind)(value.Enum())
+		x.First = &cv
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Defaults does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Defaults does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Defaults) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Defaults.int32":
+		panic(fmt.Errorf("field int32 of message presencetest.Defaults is not mutable"))
+	case "presencetest.Defaults.double":
+		panic(fmt.Errorf("field double of message presencetest.Defaults is not mutable"))
+	case "presencetest.Defaults.string":
+		panic(fmt.Errorf("field string of message presencetest.Defaults is not mutable"))
+	case "presencetest.Defaults.bytes":
+		panic(fmt.Errorf("field bytes of message presencetest.Defaults is not mutable"))
+	case "presencetest.Defaults.kind":
+		panic(fmt.Errorf("field kind of message presencetest.Defaults is not mutable"))
+	case "presencetest.Defaults.first":
+		panic(fmt.Errorf("field first of message presencetest.Defaults is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Defaults does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Defaults does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Defaults) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Defaults.int32":
+		return fd_Defaults_int32.Default()
+	case "presencetest.Defaults.double":
+		return fd_Defaults_double.Default()
+	case "presencetest.Defaults.string":
+		return fd_Defaults_string.Default()
+	case "presencetest.Defaults.bytes":
+		return protoreflect.ValueOfBytes(append([]byte(nil), fd_Defaults_bytes.Default().Bytes()...))
+	case "presencetest.Defaults.kind":
+		return fd_Defaults_kind.Default()
+	case "presencetest.Defaults.first":
+		return protoreflect.ValueOfEnum(1)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Defaults does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Defaults does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Defaults) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in presencetest.Defaults", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Defaults) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Defaults) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Defaults) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Defaults) ProtoMethods() *protoiface.Methods {
+	return fastReflection_DefaultsProtoMethods
+}
+
+var fastReflection_DefaultsProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Defaults)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Int32 != nil {
+			n += 1 + runtime.Sov(uint64(*x.Int32))
+		}
+		if x.Double != nil {
+			n += 9
+		}
+		if x.String_ != nil {
+			l = len(*x.String_)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bytes != nil {
+			l = len(x.Bytes)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Kind != nil {
+			n += 1 + runtime.Sov(uint64(*x.Kind))
+		}
+		if x.First != nil {
+			n += 1 + runtime.Sov(uint64(*x.First))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Defaults)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.First != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.First))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Kind != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Kind))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Bytes != nil {
+			i -= len(x.Bytes)
+			copy(dAtA[i:], x.Bytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bytes)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.String_ != nil {
+			i -= len(*x.String_)
+			copy(dAtA[i:], *x.String_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.String_)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Double != nil {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*x.Double))))
+			i--
+			dAtA[i] = 0x11
+		}
+		if x.Int32 != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Int32))
+			i--
+			dAtA[i] = 0x8
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Defaults)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Defaults: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Defaults: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Int32", wireType)
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Int32 = &v
+			case 2:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Double", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				x.Double = &v2
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				s := string(dAtA[iNdEx:postIndex])
+				x.String_ = &s
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bytes = append(x.Bytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bytes == nil {
+					x.Bytes = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				var v Kind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Kind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Kind = &v
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+				}
+				var v Kind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Kind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.First = &v
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		return protoiface.CheckInitializedOutput{}, nil
+	}
+	fastReflection_DefaultsProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+var (
+	md_Required        protoreflect.MessageDescriptor
+	fd_Required_id     protoreflect.FieldDescriptor
+	fd_Required_name   protoreflect.FieldDescriptor
+	fd_Required_child  protoreflect.FieldDescriptor
+	fd_Required_list   protoreflect.FieldDescriptor
+	fd_Required_map    protoreflect.FieldDescriptor
+	fd_Required_member protoreflect.FieldDescriptor
+	fd_Required_text   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_presencetest_presence_proto_init()
+	md_Required = File_internal_testprotos_presencetest_presence_proto.Messages().ByName("Required")
+	fd_Required_id = md_Required.Fields().ByName("id")
+	fd_Required_name = md_Required.Fields().ByName("name")
+	fd_Required_child = md_Required.Fields().ByName("child")
+	fd_Required_list = md_Required.Fields().ByName("list")
+	fd_Required_map = md_Required.Fields().ByName("map")
+	fd_Required_member = md_Required.Fields().ByName("member")
+	fd_Required_text = md_Required.Fields().ByName("text")
+}
+
+var _ protoreflect.Message = (*fastReflection_Required)(nil)
+
+type fastReflection_Required Required
+
+func (x *Required) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Required)(x)
+}
+
+func (x *Required) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_presencetest_presence_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Required_messageType fastReflection_Required_messageType
+var _ protoreflect.MessageType = fastReflection_Required_messageType{}
+
+type fastReflection_Required_messageType struct{}
+
+func (x fastReflection_Required_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Required)(nil)
+}
+func (x fastReflection_Required_messageType) New() protoreflect.Message {
+	return new(fastReflection_Required)
+}
+func (x fastReflection_Required_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Required
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Required) Descriptor() protoreflect.MessageDescriptor {
+	return md_Required
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Required) Type() protoreflect.MessageType {
+	return _fastReflection_Required_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Required) New() protoreflect.Message {
+	return new(fastReflection_Required)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Required) Interface() protoreflect.ProtoMessage {
+	return (*Required)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Required) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != nil {
+		value := protoreflect.ValueOfInt32(*x.Id)
+		if !f(fd_Required_id, value) {
+			return
+		}
+	}
+	if x.Name != nil {
+		value := protoreflect.ValueOfString(*x.Name)
+		if !f(fd_Required_name, value) {
+			return
+		}
+	}
+	if x.Child != nil {
+		value := protoreflect.ValueOfMessage(x.Child.ProtoReflect())
+		if !f(fd_Required_child, value) {
+			return
+		}
+	}
+	if len(x.List) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[*Required, runtime.MessageValue[Required, *Required]](&x.List))
+		if !f(fd_Required_list, value) {
+			return
+		}
+	}
+	if len(x.Map) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, *Required, runtime.StringValue, runtime.MessageValue[Required, *Required]](&x.Map))
+		if !f(fd_Required_map, value) {
+			return
+		}
+	}
+	if x.Value != nil {
+		switch o := x.Value.(type) {
+		case *Required_Member:
+			v := o.Member
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_Required_member, value) {
+				return
+			}
+		case *Required_Text:
+			v := o.Text
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_Required_text, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Required) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "presencetest.Required.id":
+		return x.Id != nil
+	case "presencetest.Required.name":
+		return x.Name != nil
+	case "presencetest.Required.child":
+		return x.Child != nil
+	case "presencetest.Required.list":
+		return len(x.List) != 0
+	case "presencetest.Required.map":
+		return len(x.Map) != 0
+	case "presencetest.Required.member":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Required_Member); ok {
+			return true
+		} else {
+			return false
+		}
+	case "presencetest.Required.text":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Required_Text); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Required does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Required does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Required) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "presencetest.Required.id":
+		x.Id = nil
+	case "presencetest.Required.name":
+		x.Name = nil
+	case "presencetest.Required.child":
+		x.Child = nil
+	case "presencetest.Required.list":
+		x.List = nil
+	case "presencetest.Required.map":
+		x.Map = nil
+	case "presencetest.Required.member":
+		x.Value = nil
+	case "presencetest.Required.text":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Required does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Required does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Required) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "presencetest.Required.id":
+		if x.Id == nil {
+			return fd_Required_id.Default()
+		}
+		value := *x.Id
+		return protoreflect.ValueOfInt32(value)
+	case "presencetest.Required.name":
+		if x.Name == nil {
+			return fd_Required_name.Default()
+		}
+		value := *x.Name
+		return protoreflect.ValueOfString(value)
+	case "presencetest.Required.child":
+		value := x.Child
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "presencetest.Required.list":
+		if len(x.List) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[*Required, runtime.MessageValue[Required, *Required]](nil))
+		}
+		listValue := runtime.NewList[*Required, runtime.MessageValue[Required, *Required]](&x.List)
+		return protoreflect.ValueOfList(listValue)
+	case "presencetest.Required.map":
+		if len(x.Map) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[string, *Required, runtime.StringValue, runtime.MessageValue[Required, *Required]](nil))
+		}
+		mapValue := runtime.NewMap[string, *Required, runtime.StringValue, runtime.MessageValue[Required, *Required]](&x.Map)
+		return protoreflect.ValueOfMap(mapValue)
+	case "presencetest.Required.member":
+		if x.Value == nil {
+			return protoreflect.ValueOfMessage((*Required)(nil).ProtoReflect())
+		} else if v, ok := x.Value.(*Required_Member); ok {
+			return protoreflect.ValueOfMessage(v.Member.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*Required)(nil).ProtoReflect())
+		}
+	case "presencetest.Required.text":
+		if x.Value == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Value.(*Required_Text); ok {
+			return protoreflect.ValueOfString(v.Text)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Required does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Required does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Required) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "presencetest.Required.id":
+		cv := int32(value.Int())
+		x.Id = &cv
+	case "presencetest.Required.name":
+		cv := value.Interface().(string)
+		x.Name = &cv
+	case "presencetest.Required.child":
+		x.Child = value.Message().Interface().(*Required)
+	case "presencetest.Required.list":
+		lv := value.List()
+		clv := lv.(*runtime.List[*Required, runtime.MessageValue[Required, *Required]])
+		x.List = clv.Slice()
+	case "presencetest.Required.map":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[string, *Required, runtime.StringValue, runtime.MessageValue[Required, *Required]])
+		x.Map = cmv.GoMap()
+	case "presencetest.Required.member":
+		cv := value.Message().Interface().(*Required)
+		x.Value = &Required_Member{Member: cv}
+	case "presencetest.Required.text":
+		cv := value.Interface().(string)
+		x.Value = &Required_Text{Text: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Required does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Required does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Required) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Required.child":
+		if x.Child == nil {
+			x.Child = new(Required)
+		}
+		return protoreflect.ValueOfMessage(x.Child.ProtoReflect())
+	case "presencetest.Required.list":
+		if x.List == nil {
+			x.List = []*Required{}
+		}
+		value := runtime.NewList[*Required, runtime.MessageValue[Required, *Required]](&x.List)
+		return protoreflect.ValueOfList(value)
+	case "presencetest.Required.map":
+		if x.Map == nil {
+			x.Map = make(map[string]*Required)
+		}
+		value := runtime.NewMap[string, *Required, runtime.StringValue, runtime.MessageValue[Required, *Required]](&x.Map)
+		return protoreflect.ValueOfMap(value)
+	case "presencetest.Required.member":
+		if x.Value == nil {
+			value := &Required{}
+			oneofValue := &Required_Member{Member: value}
+			x.Value = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Value.(type) {
+		case *Required_Member:
+			return protoreflect.ValueOfMessage(m.Member.ProtoReflect())
+		default:
+			value := &Required{}
+			oneofValue := &Required_Member{Member: value}
+			x.Value = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "presencetest.Required.id":
+		panic(fmt.Errorf("field id of message presencetest.Required is not mutable"))
+	case "presencetest.Required.name":
+		panic(fmt.Errorf("field name of message presencetest.Required is not mutable"))
+	case "presencetest.Required.text":
+		panic(fmt.Errorf("field text of message presencetest.Required is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Required does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Required does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Required) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "presencetest.Required.id":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "presencetest.Required.name":
+		return protoreflect.ValueOfString("")
+	case "presencetest.Required.child":
+		m := new(Required)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "presencetest.Required.list":
+		list := []*Required{}
+		return protoreflect.ValueOfList(runtime.NewList[*Required, runtime.MessageValue[Required, *Required]](&list))
+	case "presencetest.Required.map":
+		m := make(map[string]*Required)
+		return protoreflect.ValueOfMap(runtime.NewMap[string, *Required, runtime.StringValue, runtime.MessageValue[Required, *Required]](&m))
+	case "presencetest.Required.member":
+		value := &Required{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "presencetest.Required.text":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("message presencetest.Required does not declare extension ranges"))
+		}
+		panic(fmt.Errorf("message presencetest.Required does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Required) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "presencetest.Required.value":
+		if x.Value == nil {
+			return nil
+		}
+		switch x.Value.(type) {
+		case *Required_Member:
+			return md_Required.Fields().ByName("member")
+		case *Required_Text:
+			return md_Required.Fields().ByName("text")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in presencetest.Required", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Required) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Required) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Required) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Required) ProtoMethods() *protoiface.Methods {
+	return fastReflection_RequiredProtoMethods
+}
+
+var fastReflection_RequiredProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Required)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != nil {
+			n += 1 + runtime.Sov(uint64(*x.Id))
+		}
+		if x.Name != nil {
+			l = len(*x.Name)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Child != nil {
+			l = options.Size(x.Child)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.List) > 0 {
+			for _, e := range x.List {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Map) > 0 {
+			SiZeMaP := func(k string, v *Required) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.Map))
+				for k := range x.Map {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.Map[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Map {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		switch x := x.Value.(type) {
+		case *Required_Member:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Member)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *Required_Text:
+			if x == nil {
+				break
+			}
+			l = len(x.Text)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Required)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Value.(type) {
+		case *Required_Member:
+			encoded, err := options.Marshal(x.Member)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		case *Required_Text:
+			i -= len(x.Text)
+			copy(dAtA[i:], x.Text)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Text)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Map) > 0 {
+			MaRsHaLmAp := func(k string, v *Required) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x2a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForMap := make([]string, 0, len(x.Map))
+				for k := range x.Map {
+					keysForMap = append(keysForMap, string(k))
+				}
+				sort.Slice(keysForMap, func(i, j int) bool {
+					return keysForMap[i] < keysForMap[j]
+				})
+				for iNdEx := len(keysForMap) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Map[string(keysForMap[iNdEx])]
+					out, err := MaRsHaLmAp(keysForMap[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Map {
+					v := x.Map[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.List) > 0 {
+			for iNdEx := len(x.List) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.List[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Child != nil {
+			encoded, err := options.Marshal(x.Child)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Name != nil {
+			i -= len(*x.Name)
+			copy(dAtA[i:], *x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(*x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != nil {
+			i = runtime.EncodeVarint(dAtA, i, uint64(*x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Required)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Required: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Required: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Id = &v
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				s := string(dAtA[iNdEx:postIndex])
+				x.Name = &s
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Child == nil {
+					x.Child = &Required{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Child); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.List = append(x.List, &Required{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.List[len(x.List)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Map == nil {
+					x.Map = make(map[string]*Required)
+				}
+				var mapkey string
+				var mapvalue *Required
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &Required{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Map[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &Required{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Value = &Required_Member{v}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = &Required_Text{string(dAtA[iNdEx:postIndex])}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Required)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		if x.Id == nil {
+			return output, runtime.RequiredNotSet(fd_Required_id)
+		}
+		if x.Name == nil {
+			return output, runtime.RequiredNotSet(fd_Required_name)
+		}
+		if x.Child != nil {
+			if err := proto.CheckInitialized(x.Child); err != nil {
+				return output, err
+			}
+		}
+		for _, v := range x.List {
+			if err := proto.CheckInitialized(v); err != nil {
+				return output, err
+			}
+		}
+		for _, v := range x.Map {
+			if err := proto.CheckInitialized(v); err != nil {
+				return output, err
+			}
+		}
+		if v, ok := x.Value.(*Required_Member); ok && v.Member != nil {
+			if err := proto.CheckInitialized(v.Member); err != nil {
+				return output, err
+			}
+		}
+		return output, nil
+	}
+	fastReflection_RequiredProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: internal/testprotos/presencetest/presence.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Kind int32
+
+const (
+	Kind_KIND_A Kind = 1
+	Kind_KIND_B Kind = 2
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		1: "KIND_A",
+		2: "KIND_B",
+	}
+	Kind_value = map[string]int32{
+		"KIND_A": 1,
+		"KIND_B": 2,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_presencetest_presence_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_presencetest_presence_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Kind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Kind(num)
+	return nil
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_presencetest_presence_proto_rawDescGZIP(), []int{0}
+}
+
+// Optional has proto2 optional scalar fields, whose presence is tracked by
+// pointers, or by nil slices for bytes.
+type Optional struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bool     *bool     `protobuf:"varint,1,opt,name=bool" json:"bool,omitempty"`
+	Int32    *int32    `protobuf:"varint,2,opt,name=int32" json:"int32,omitempty"`
+	Sint32   *int32    `protobuf:"zigzag32,3,opt,name=sint32" json:"sint32,omitempty"`
+	Uint32   *uint32   `protobuf:"varint,4,opt,name=uint32" json:"uint32,omitempty"`
+	Int64    *int64    `protobuf:"varint,5,opt,name=int64" json:"int64,omitempty"`
+	Sint64   *int64    `protobuf:"zigzag64,6,opt,name=sint64" json:"sint64,omitempty"`
+	Uint64   *uint64   `protobuf:"varint,7,opt,name=uint64" json:"uint64,omitempty"`
+	Sfixed32 *int32    `protobuf:"fixed32,8,opt,name=sfixed32" json:"sfixed32,omitempty"`
+	Fixed32  *uint32   `protobuf:"fixed32,9,opt,name=fixed32" json:"fixed32,omitempty"`
+	Float    *float32  `protobuf:"fixed32,10,opt,name=float" json:"float,omitempty"`
+	Sfixed64 *int64    `protobuf:"fixed64,11,opt,name=sfixed64" json:"sfixed64,omitempty"`
+	Fixed64  *uint64   `protobuf:"fixed64,12,opt,name=fixed64" json:"fixed64,omitempty"`
+	Double   *float64  `protobuf:"fixed64,13,opt,name=double" json:"double,omitempty"`
+	String_  *string   `protobuf:"bytes,14,opt,name=string" json:"string,omitempty"`
+	Bytes    []byte    `protobuf:"bytes,15,opt,name=bytes" json:"bytes,omitempty"`
+	Kind     *Kind     `protobuf:"varint,16,opt,name=kind,enum=presencetest.Kind" json:"kind,omitempty"`
+	Message  *Optional `protobuf:"bytes,17,opt,name=message" json:"message,omitempty"`
+}
+
+func (x *Optional) Reset() {
+	*x = Optional{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_presencetest_presence_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Optional) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Optional) ProtoMessage() {}
+
+// Deprecated: Use Optional.ProtoReflect.Descriptor instead.
+func (*Optional) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_presencetest_presence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Optional) GetBool() bool {
+	if x != nil && x.Bool != nil {
+		return *x.Bool
+	}
+	return false
+}
+
+func (x *Optional) GetInt32() int32 {
+	if x != nil && x.Int32 != nil {
+		return *x.Int32
+	}
+	return 0
+}
+
+func (x *Optional) GetSint32() int32 {
+	if x != nil && x.Sint32 != nil {
+		return *x.Sint32
+	}
+	return 0
+}
+
+func (x *Optional) GetUint32() uint32 {
+	if x != nil && x.Uint32 != nil {
+		return *x.Uint32
+	}
+	return 0
+}
+
+func (x *Optional) GetInt64() int64 {
+	if x != nil && x.Int64 != nil {
+		return *x.Int64
+	}
+	return 0
+}
+
+func (x *Optional) GetSint64() int64 {
+	if x != nil && x.Sint64 != nil {
+		return *x.Sint64
+	}
+	return 0
+}
+
+func (x *Optional) GetUint64() uint64 {
+	if x != nil && x.Uint64 != nil {
+		return *x.Uint64
+	}
+	return 0
+}
+
+func (x *Optional) GetSfixed32() int32 {
+	if x != nil && x.Sfixed32 != nil {
+		return *x.Sfixed32
+	}
+	return 0
+}
+
+func (x *Optional) GetFixed32() uint32 {
+	if x != nil && x.Fixed32 != nil {
+		return *x.Fixed32
+	}
+	return 0
+}
+
+func (x *Optional) GetFloat() float32 {
+	if x != nil && x.Float != nil {
+		return *x.Float
+	}
+	return 0
+}
+
+func (x *Optional) GetSfixed64() int64 {
+	if x != nil && x.Sfixed64 != nil {
+		return *x.Sfixed64
+	}
+	return 0
+}
+
+func (x *Optional) GetFixed64() uint64 {
+	if x != nil && x.Fixed64 != nil {
+		return *x.Fixed64
+	}
+	return 0
+}
+
+func (x *Optional) GetDouble() float64 {
+	if x != nil && x.Double != nil {
+		return *x.Double
+	}
+	return 0
+}
+
+func (x *Optional) GetString_() string {
+	if x != nil && x.String_ != nil {
+		return *x.String_
+	}
+	return ""
+}
+
+func (x *Optional) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Optional) GetKind() Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return Kind_KIND_A
+}
+
+func (x *Optional) GetMessage() *Optional {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Defaults has optional fields with default values, returned when they are not set.
+type Defaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32   *int32   `protobuf:"varint,1,opt,name=int32,def=-5" json:"int32,omitempty"`
+	Double  *float64 `protobuf:"fixed64,2,opt,name=double,def=1.5" json:"double,omitempty"`
+	String_ *string  `protobuf:"bytes,3,opt,name=string,def=default" json:"string,omitempty"`
+	Bytes   []byte   `protobuf:"bytes,4,opt,name=bytes,def=bytes" json:"bytes,omitempty"`
+	Kind    *Kind    `protobuf:"varint,5,opt,name=kind,enum=presencetest.Kind,def=2" json:"kind,omitempty"`
+	// the default value of an enum field without default is its first value
+	First *Kind `protobuf:"varint,6,opt,name=first,enum=presencetest.Kind" json:"first,omitempty"`
+}
+
+// Default values for Defaults fields.
+const (
+	Default_Defaults_Int32   = int32(-5)
+	Default_Defaults_Double  = float64(1.5)
+	Default_Defaults_String_ = string("default")
+	Default_Defaults_Kind    = Kind_KIND_B
+)
+
+// Default values for Defaults fields.
+var (
+	Default_Defaults_Bytes = []byte("bytes")
+)
+
+func (x *Defaults) Reset() {
+	*x = Defaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_presencetest_presence_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Defaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Defaults) ProtoMessage() {}
+
+// Deprecated: Use Defaults.ProtoReflect.Descriptor instead.
+func (*Defaults) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_presencetest_presence_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Defaults) GetInt32() int32 {
+	if x != nil && x.Int32 != nil {
+		return *x.Int32
+	}
+	return Default_Defaults_Int32
+}
+
+func (x *Defaults) GetDouble() float64 {
+	if x != nil && x.Double != nil {
+		return *x.Double
+	}
+	return Default_Defaults_Double
+}
+
+func (x *Defaults) GetString_() string {
+	if x != nil && x.String_ != nil {
+		return *x.String_
+	}
+	return Default_Defaults_String_
+}
+
+func (x *Defaults) GetBytes() []byte {
+	if x != nil && x.Bytes != nil {
+		return x.Bytes
+	}
+	return append([]byte(nil), Default_Defaults_Bytes...)
+}
+
+func (x *Defaults) GetKind() Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return Default_Defaults_Kind
+}
+
+func (x *Defaults) GetFirst() Kind {
+	if x != nil && x.First != nil {
+		return *x.First
+	}
+	return Kind_KIND_A
+}
+
+// Required has required fields, which are checked when marshaling or
+// unmarshaling, in the message and in the messages of its fields.
+type Required struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    *int32               `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Name  *string              `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	Child *Required            `protobuf:"bytes,3,opt,name=child" json:"child,omitempty"`
+	List  []*Required          `protobuf:"bytes,4,rep,name=list" json:"list,omitempty"`
+	Map   map[string]*Required `protobuf:"bytes,5,rep,name=map" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are assignable to Value:
+	//	*Required_Member
+	//	*Required_Text
+	Value isRequired_Value `protobuf_oneof:"value"`
+}
+
+func (x *Required) Reset() {
+	*x = Required{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_presencetest_presence_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Required) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Required) ProtoMessage() {}
+
+// Deprecated: Use Required.ProtoReflect.Descriptor instead.
+func (*Required) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_presencetest_presence_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Required) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Required) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Required) GetChild() *Required {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Required) GetList() []*Required {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *Required) GetMap() map[string]*Required {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *Required) GetValue() isRequired_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Required) GetMember() *Required {
+	if x, ok := x.GetValue().(*Required_Member); ok {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *Required) GetText() string {
+	if x, ok := x.GetValue().(*Required_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+type isRequired_Value interface {
+	isRequired_Value()
+}
+
+type Required_Member struct {
+	Member *Required `protobuf:"bytes,6,opt,name=member,oneof"`
+}
+
+type Required_Text struct {
+	Text string `protobuf:"bytes,7,opt,name=text,oneof"`
+}
+
+func (*Required_Member) isRequired_Value() {}
+
+func (*Required_Text) isRequired_Value() {}
+
+var File_internal_testprotos_presencetest_presence_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_presencetest_presence_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65,
+	0x73, 0x74, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x22,
+	0xcc, 0x03, 0x0a, 0x08, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x12, 0x52, 0x06, 0x73, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x08,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x10, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd9,
+	0x01, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x2d, 0x35, 0x52, 0x05,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x3a, 0x03, 0x31, 0x2e, 0x35, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x3a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x3a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69,
+	0x6e, 0x64, 0x3a, 0x06, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x28, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x08, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x1a, 0x4e, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x1e, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x10, 0x02, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74, 0x65, 0x73, 0x74,
+}
+
+var (
+	file_internal_testprotos_presencetest_presence_proto_rawDescOnce sync.Once
+	file_internal_testprotos_presencetest_presence_proto_rawDescData = file_internal_testprotos_presencetest_presence_proto_rawDesc
+)
+
+func file_internal_testprotos_presencetest_presence_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_presencetest_presence_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_presencetest_presence_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_presencetest_presence_proto_rawDescData)
+	})
+	return file_internal_testprotos_presencetest_presence_proto_rawDescData
+}
+
+var file_internal_testprotos_presencetest_presence_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_testprotos_presencetest_presence_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_internal_testprotos_presencetest_presence_proto_goTypes = []interface{}{
+	(Kind)(0),        // 0: presencetest.Kind
+	(*Optional)(nil), // 1: presencetest.Optional
+	(*Defaults)(nil), // 2: presencetest.Defaults
+	(*Required)(nil), // 3: presencetest.Required
+	nil,              // 4: presencetest.Required.MapEntry
+}
+var file_internal_testprotos_presencetest_presence_proto_depIdxs = []int32{
+	0, // 0: presencetest.Optional.kind:type_name -> presencetest.Kind
+	1, // 1: presencetest.Optional.message:type_name -> presencetest.Optional
+	0, // 2: presencetest.Defaults.kind:type_name -> presencetest.Kind
+	0, // 3: presencetest.Defaults.first:type_name -> presencetest.Kind
+	3, // 4: presencetest.Required.child:type_name -> presencetest.Required
+	3, // 5: presencetest.Required.list:type_name -> presencetest.Required
+	4, // 6: presencetest.Required.map:type_name -> presencetest.Required.MapEntry
+	3, // 7: presencetest.Required.member:type_name -> presencetest.Required
+	3, // 8: presencetest.Required.MapEntry.value:type_name -> presencetest.Required
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_presencetest_presence_proto_init() }
+func file_internal_testprotos_presencetest_presence_proto_init() {
+	if File_internal_testprotos_presencetest_presence_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_presencetest_presence_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Optional); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_presencetest_presence_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Defaults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_presencetest_presence_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Required); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_presencetest_presence_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Required_Member)(nil),
+		(*Required_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_presencetest_presence_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_presencetest_presence_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_presencetest_presence_proto_depIdxs,
+		EnumInfos:         file_internal_testprotos_presencetest_presence_proto_enumTypes,
+		MessageInfos:      file_internal_testprotos_presencetest_presence_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_presencetest_presence_proto = out.File
+	file_internal_testprotos_presencetest_presence_proto_rawDesc = nil
+	file_internal_testprotos_presencetest_presence_proto_goTypes = nil
+	file_internal_testprotos_presencetest_presence_proto_depIdxs = nil
+}
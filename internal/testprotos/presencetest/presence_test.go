@@ -0,0 +1,199 @@
+package presencetest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func newOptional() *Optional {
+	return &Optional{
+		Bool:     proto.Bool(false),
+		Int32:    proto.Int32(0),
+		Sint32:   proto.Int32(-1),
+		Uint32:   proto.Uint32(0),
+		Int64:    proto.Int64(-1 << 40),
+		Sint64:   proto.Int64(0),
+		Uint64:   proto.Uint64(1 << 50),
+		Sfixed32: proto.Int32(0),
+		Fixed32:  proto.Uint32(7),
+		Float:    proto.Float32(0),
+		Sfixed64: proto.Int64(-3),
+		Fixed64:  proto.Uint64(0),
+		Double:   proto.Float64(0),
+		String_:  proto.String(""),
+		Bytes:    []byte{},
+		Kind:     Kind_KIND_B.Enum(),
+		Message:  &Optional{Int32: proto.Int32(1)},
+	}
+}
+
+// checkDynamic checks that msg is encoded and reflected like the protobuf runtime does.
+func checkDynamic(t *testing.T, msg proto.Message) {
+	t.Helper()
+	require.Contains(t, fmt.Sprintf("%T", msg.ProtoReflect()), "fastReflection")
+	opts := proto.MarshalOptions{Deterministic: true}
+	b, err := opts.Marshal(msg)
+	require.NoError(t, err)
+
+	dyn := dynamicpb.NewMessage(msg.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(b, dyn))
+	dynBytes, err := opts.Marshal(dyn)
+	require.NoError(t, err)
+	require.Equal(t, dynBytes, b)
+
+	got := msg.ProtoReflect().New().Interface()
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.True(t, proto.Equal(msg, got), "%v", got)
+
+	// the fields are populated and have the values of the dynamic message
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		require.Equal(t, dyn.Has(fd), got.ProtoReflect().Has(fd), fd.FullName())
+		if fd.Message() == nil {
+			require.Equal(t, dyn.Get(fd).Interface(), got.ProtoReflect().Get(fd).Interface(), fd.FullName())
+		}
+	}
+}
+
+func TestOptional(t *testing.T) {
+	// the fields set to their zero value are populated and encoded
+	checkDynamic(t, newOptional())
+	checkDynamic(t, &Optional{})
+
+	msg := (&Optional{}).ProtoReflect()
+	int32Field := msg.Descriptor().Fields().ByName("int32")
+	bytesField := msg.Descriptor().Fields().ByName("bytes")
+	kindField := msg.Descriptor().Fields().ByName("kind")
+	require.False(t, msg.Has(int32Field))
+	msg.Set(int32Field, protoreflect.ValueOfInt32(0))
+	require.True(t, msg.Has(int32Field))
+	require.Equal(t, int32(0), *msg.Interface().(*Optional).Int32)
+
+	// setting nil bytes populates the field
+	msg.Set(bytesField, protoreflect.ValueOfBytes(nil))
+	require.True(t, msg.Has(bytesField))
+	require.NotNil(t, msg.Interface().(*Optional).Bytes)
+
+	// the default value of an enum is its first value
+	require.Equal(t, protoreflect.EnumNumber(Kind_KIND_A), msg.Get(kindField).Enum())
+	require.Equal(t, protoreflect.EnumNumber(Kind_KIND_A), msg.NewField(kindField).Enum())
+
+	var numbers []protoreflect.FieldNumber
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		numbers = append(numbers, fd.Number())
+		return true
+	})
+	require.ElementsMatch(t, []protoreflect.FieldNumber{2, 15}, numbers)
+
+	msg.Clear(int32Field)
+	msg.Clear(bytesField)
+	require.False(t, msg.Has(int32Field))
+	require.False(t, msg.Has(bytesField))
+}
+
+func TestDefaults(t *testing.T) {
+	msg := (&Defaults{}).ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	dyn := dynamicpb.NewMessage(msg.Descriptor())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		require.Equal(t, dyn.Get(fd).Interface(), msg.Get(fd).Interface(), fd.FullName())
+		require.Equal(t, dyn.NewField(fd).Interface(), msg.NewField(fd).Interface(), fd.FullName())
+	}
+	require.Equal(t, int64(-5), msg.Get(fields.ByName("int32")).Int())
+	require.Equal(t, "default", msg.Get(fields.ByName("string")).String())
+
+	// the default bytes are copied
+	msg.Get(fields.ByName("bytes")).Bytes()[0] = 'B'
+	require.Equal(t, []byte("bytes"), msg.Get(fields.ByName("bytes")).Bytes())
+
+	// set fields are populated even if set to their default value
+	msg.Set(fields.ByName("int32"), protoreflect.ValueOfInt32(-5))
+	require.True(t, msg.Has(fields.ByName("int32")))
+	checkDynamic(t, msg.Interface())
+}
+
+func newRequired(id int32) *Required {
+	return &Required{Id: proto.Int32(id), Name: proto.String("")}
+}
+
+func TestRequired(t *testing.T) {
+	msg := newRequired(1)
+	msg.Child = newRequired(2)
+	msg.List = []*Required{newRequired(3)}
+	msg.Map = map[string]*Required{"k": newRequired(4)}
+	msg.Value = &Required_Member{Member: newRequired(5)}
+	checkDynamic(t, msg)
+
+	for name, tc := range map[string]struct {
+		modify func(*Required)
+		field  string
+	}{
+		"missing":        {func(msg *Required) { msg.Name = nil }, "presencetest.Required.name"},
+		"child":          {func(msg *Required) { msg.Child.Id = nil }, "presencetest.Required.id"},
+		"list":           {func(msg *Required) { msg.List = append(msg.List, &Required{Id: proto.Int32(6)}) }, "presencetest.Required.name"},
+		"map":            {func(msg *Required) { msg.Map["k"].Name = nil }, "presencetest.Required.name"},
+		"oneof":          {func(msg *Required) { msg.Value = &Required_Member{Member: &Required{}} }, "presencetest.Required.id"},
+		"nested in list": {func(msg *Required) { msg.List[0].Child = &Required{Name: proto.String("")} }, "presencetest.Required.id"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			msg := proto.Clone(msg).(*Required)
+			tc.modify(msg)
+			want := fmt.Sprintf("proto: required field %s not set", tc.field)
+
+			err := proto.CheckInitialized(msg)
+			require.EqualError(t, err, want)
+			require.True(t, errors.Is(err, proto.Error))
+			_, err = proto.Marshal(msg)
+			require.EqualError(t, err, want)
+
+			// partial messages are marshaled and unmarshaled with AllowPartial
+			b, err := proto.MarshalOptions{AllowPartial: true}.Marshal(msg)
+			require.NoError(t, err)
+			require.EqualError(t, proto.Unmarshal(b, &Required{}), want)
+			got := &Required{}
+			require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(b, got))
+			require.True(t, proto.Equal(msg, got))
+		})
+	}
+
+	// the required fields may be set by a previous unmarshaling
+	got := &Required{}
+	require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal([]byte{1 << 3, 1}, got))
+	require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal([]byte{2<<3 | 2, 0}, got))
+	require.True(t, proto.Equal(newRequired(1), got))
+}
+
+func TestOptional3(t *testing.T) {
+	checkDynamic(t, &Optional3{})
+	checkDynamic(t, &Optional3{
+		Int32:   proto.Int32(0),
+		Sint64:  proto.Int64(-1),
+		Double:  proto.Float64(0),
+		Bool:    proto.Bool(false),
+		String_: proto.String(""),
+		Bytes:   []byte{},
+		Kind:    Kind3_KIND3_UNSPECIFIED.Enum(),
+		Message: &Optional3{},
+		Value:   &Optional3_Number{},
+	})
+
+	// the synthetic oneofs report their field when it is populated
+	msg := (&Optional3{Int32: proto.Int32(0)}).ProtoReflect()
+	oneofs := msg.Descriptor().Oneofs()
+	require.Equal(t, protoreflect.Name("int32"), msg.WhichOneof(oneofs.ByName("_int32")).Name())
+	require.Nil(t, msg.WhichOneof(oneofs.ByName("_message")))
+	require.Nil(t, msg.WhichOneof(oneofs.ByName("value")))
+
+	// messages are mutable
+	messageField := msg.Descriptor().Fields().ByName("message")
+	msg.Mutable(messageField).Message().Set(msg.Descriptor().Fields().ByName("bool"), protoreflect.ValueOfBool(false))
+	require.NotNil(t, msg.Interface().(*Optional3).Message.Bool)
+}
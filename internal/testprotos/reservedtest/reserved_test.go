@@ -0,0 +1,84 @@
+package reservedtest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func newReserved() *Reserved {
+	// the fields keep their names
+	return &Reserved{
+		Type:  "type",
+		Range: []string{"a", "b"},
+		Get:   map[string]string{"k": "v"},
+		Set:   &Reserved{Name: "nested", Kind: &Reserved_Clear{Clear: 7}},
+		Kind:  &Reserved_New{New: "new"},
+		Name:  "name",
+	}
+}
+
+func TestSeparateType(t *testing.T) {
+	msg := newReserved()
+	_, fast := msg.ProtoReflect().(*fastReflection_Reserved)
+	require.True(t, fast)
+	require.Same(t, msg, msg.ProtoReflect().Interface())
+	_, fast = msg.ProtoReflect().Type().New().(*fastReflection_Reserved)
+	require.True(t, fast)
+
+	var nilMsg *Reserved
+	require.False(t, nilMsg.ProtoReflect().IsValid())
+	require.Nil(t, nilMsg.ProtoReflect().Interface().(*Reserved))
+}
+
+func TestSeparateReflection(t *testing.T) {
+	msg := newReserved()
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	require.Equal(t, "type", m.Get(fields.ByName("type")).String())
+	require.Equal(t, 2, m.Get(fields.ByName("range")).List().Len())
+	require.Equal(t, "v", m.Get(fields.ByName("get")).Map().Get(protoreflect.ValueOfString("k").MapKey()).String())
+	require.Equal(t, fields.ByName("new"), m.WhichOneof(m.Descriptor().Oneofs().ByName("kind")))
+
+	m.Set(fields.ByName("type"), protoreflect.ValueOfString("changed"))
+	require.Equal(t, "changed", msg.Type)
+	m.Mutable(fields.ByName("range")).List().Append(protoreflect.ValueOfString("c"))
+	require.Equal(t, []string{"a", "b", "c"}, msg.Range)
+	m.Clear(fields.ByName("set"))
+	require.Nil(t, msg.Set)
+	require.False(t, m.Has(fields.ByName("set")))
+	m.Set(fields.ByName("clear"), protoreflect.ValueOfInt64(3))
+	require.Equal(t, &Reserved_Clear{Clear: 3}, msg.Kind)
+
+	var names []protoreflect.Name
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		names = append(names, fd.Name())
+		return true
+	})
+	require.ElementsMatch(t, []protoreflect.Name{"type", "range", "get", "clear", "name"}, names)
+
+	m.SetUnknown(protoreflect.RawFields{0xf8, 0x01, 0x01})
+	require.Equal(t, protoreflect.RawFields{0xf8, 0x01, 0x01}, m.GetUnknown())
+}
+
+func TestSeparateRoundTrip(t *testing.T) {
+	msg := &Plain{TypeUrl: "url", Reserved: newReserved()}
+
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &Plain{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.Empty(t, cmp.Diff(msg, got, protocmp.Transform()))
+
+	js, err := protojson.Marshal(msg)
+	require.NoError(t, err)
+	got = &Plain{}
+	require.NoError(t, protojson.Unmarshal(js, got))
+	require.Empty(t, cmp.Diff(msg, got, protocmp.Transform()))
+}
@@ -0,0 +1,1604 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package stabletest
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sort "sort"
+	sync "sync"
+)
+
+var (
+	md_Unordered          protoreflect.MessageDescriptor
+	fd_Unordered_name     protoreflect.FieldDescriptor
+	fd_Unordered_text     protoreflect.FieldDescriptor
+	fd_Unordered_nested   protoreflect.FieldDescriptor
+	fd_Unordered_counts   protoreflect.FieldDescriptor
+	fd_Unordered_values   protoreflect.FieldDescriptor
+	fd_Unordered_id       protoreflect.FieldDescriptor
+	fd_Unordered_flag     protoreflect.FieldDescriptor
+	fd_Unordered_children protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_stabletest_stable_proto_init()
+	md_Unordered = File_internal_testprotos_stabletest_stable_proto.Messages().ByName("Unordered")
+	fd_Unordered_name = md_Unordered.Fields().ByName("name")
+	fd_Unordered_text = md_Unordered.Fields().ByName("text")
+	fd_Unordered_nested = md_Unordered.Fields().ByName("nested")
+	fd_Unordered_counts = md_Unordered.Fields().ByName("counts")
+	fd_Unordered_values = md_Unordered.Fields().ByName("values")
+	fd_Unordered_id = md_Unordered.Fields().ByName("id")
+	fd_Unordered_flag = md_Unordered.Fields().ByName("flag")
+	fd_Unordered_children = md_Unordered.Fields().ByName("children")
+}
+
+var _ protoreflect.Message = (*fastReflection_Unordered)(nil)
+
+type fastReflection_Unordered Unordered
+
+func (x *Unordered) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Unordered)(x)
+}
+
+func (x *Unordered) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_stabletest_stable_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Unordered_messageType fastReflection_Unordered_messageType
+var _ protoreflect.MessageType = fastReflection_Unordered_messageType{}
+
+type fastReflection_Unordered_messageType struct{}
+
+func (x fastReflection_Unordered_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Unordered)(nil)
+}
+func (x fastReflection_Unordered_messageType) New() protoreflect.Message {
+	return new(fastReflection_Unordered)
+}
+func (x fastReflection_Unordered_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Unordered
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Unordered) Descriptor() protoreflect.MessageDescriptor {
+	return md_Unordered
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Unordered) Type() protoreflect.MessageType {
+	return _fastReflection_Unordered_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Unordered) New() protoreflect.Message {
+	return new(fastReflection_Unordered)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Unordered) Interface() protoreflect.ProtoMessage {
+	return (*Unordered)(x)
+}
+
+// Range iterates over every populated field in field number order,
+// calling f for each field descriptor and value encountered.
+// The entries of map fields are ranged over in key order.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Unordered) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Id)
+		if !f(fd_Unordered_id, value) {
+			return
+		}
+	}
+	if o, ok := x.Choice.(*Unordered_Nested); ok {
+		v := o.Nested
+		value := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(fd_Unordered_nested, value) {
+			return
+		}
+	}
+	if len(x.Counts) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewSortedMap[string, int64, runtime.StringValue, runtime.Int64Value](&x.Counts))
+		if !f(fd_Unordered_counts, value) {
+			return
+		}
+	}
+	if len(x.Children) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewSortedMap[int32, *Unordered, runtime.Int32Value, runtime.MessageValue[Unordered, *Unordered]](&x.Children))
+		if !f(fd_Unordered_children, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Unordered_name, value) {
+			return
+		}
+	}
+	if o, ok := x.Other.(*Unordered_Flag); ok {
+		v := o.Flag
+		value := protoreflect.ValueOfBool(v)
+		if !f(fd_Unordered_flag, value) {
+			return
+		}
+	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&x.Values))
+		if !f(fd_Unordered_values, value) {
+			return
+		}
+	}
+	if o, ok := x.Choice.(*Unordered_Text); ok {
+		v := o.Text
+		value := protoreflect.ValueOfString(v)
+		if !f(fd_Unordered_text, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Unordered) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stabletest.Unordered.name":
+		return x.Name != ""
+	case "stabletest.Unordered.text":
+		if x.Choice == nil {
+			return false
+		} else if _, ok := x.Choice.(*Unordered_Text); ok {
+			return true
+		} else {
+			return false
+		}
+	case "stabletest.Unordered.nested":
+		if x.Choice == nil {
+			return false
+		} else if _, ok := x.Choice.(*Unordered_Nested); ok {
+			return true
+		} else {
+			return false
+		}
+	case "stabletest.Unordered.counts":
+		return len(x.Counts) != 0
+	case "stabletest.Unordered.values":
+		return len(x.Values) != 0
+	case "stabletest.Unordered.id":
+		return x.Id != int64(0)
+	case "stabletest.Unordered.flag":
+		if x.Other == nil {
+			return false
+		} else if _, ok := x.Other.(*Unordered_Flag); ok {
+			return true
+		} else {
+			return false
+		}
+	case "stabletest.Unordered.children":
+		return len(x.Children) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stabletest.Unordered"))
+		}
+		panic(fmt.Errorf("message stabletest.Unordered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Unordered) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stabletest.Unordered.name":
+		x.Name = ""
+	case "stabletest.Unordered.text":
+		x.Choice = nil
+	case "stabletest.Unordered.nested":
+		x.Choice = nil
+	case "stabletest.Unordered.counts":
+		x.Counts = nil
+	case "stabletest.Unordered.values":
+		x.Values = nil
+	case "stabletest.Unordered.id":
+		x.Id = int64(0)
+	case "stabletest.Unordered.flag":
+		x.Other = nil
+	case "stabletest.Unordered.children":
+		x.Children = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stabletest.Unordered"))
+		}
+		panic(fmt.Errorf("message stabletest.Unordered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Unordered) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stabletest.Unordered.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "stabletest.Unordered.text":
+		if x.Choice == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Choice.(*Unordered_Text); ok {
+			return protoreflect.ValueOfString(v.Text)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case "stabletest.Unordered.nested":
+		if x.Choice == nil {
+			return protoreflect.ValueOfMessage((*Unordered)(nil).ProtoReflect())
+		} else if v, ok := x.Choice.(*Unordered_Nested); ok {
+			return protoreflect.ValueOfMessage(v.Nested.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*Unordered)(nil).ProtoReflect())
+		}
+	case "stabletest.Unordered.counts":
+		if len(x.Counts) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewSortedMap[string, int64, runtime.StringValue, runtime.Int64Value](nil))
+		}
+		mapValue := runtime.NewSortedMap[string, int64, runtime.StringValue, runtime.Int64Value](&x.Counts)
+		return protoreflect.ValueOfMap(mapValue)
+	case "stabletest.Unordered.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](nil))
+		}
+		listValue := runtime.NewList[uint32, runtime.Uint32Value](&x.Values)
+		return protoreflect.ValueOfList(listValue)
+	case "stabletest.Unordered.id":
+		value := x.Id
+		return protoreflect.ValueOfInt64(value)
+	case "stabletest.Unordered.flag":
+		if x.Other == nil {
+			return protoreflect.ValueOfBool(false)
+		} else if v, ok := x.Other.(*Unordered_Flag); ok {
+			return protoreflect.ValueOfBool(v.Flag)
+		} else {
+			return protoreflect.ValueOfBool(false)
+		}
+	case "stabletest.Unordered.children":
+		if len(x.Children) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewSortedMap[int32, *Unordered, runtime.Int32Value, runtime.MessageValue[Unordered, *Unordered]](nil))
+		}
+		mapValue := runtime.NewSortedMap[int32, *Unordered, runtime.Int32Value, runtime.MessageValue[Unordered, *Unordered]](&x.Children)
+		return protoreflect.ValueOfMap(mapValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stabletest.Unordered"))
+		}
+		panic(fmt.Errorf("message stabletest.Unordered does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Unordered) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stabletest.Unordered.name":
+		x.Name = value.Interface().(string)
+	case "stabletest.Unordered.text":
+		cv := value.Interface().(string)
+		x.Choice = &Unordered_Text{Text: cv}
+	case "stabletest.Unordered.nested":
+		cv := value.Message().Interface().(*Unordered)
+		x.Choice = &Unordered_Nested{Nested: cv}
+	case "stabletest.Unordered.counts":
+		mv := value.Map()
+		cmv := mv.(*runtime.SortedMap[string, int64, runtime.StringValue, runtime.Int64Value])
+		x.Counts = cmv.GoMap()
+	case "stabletest.Unordered.values":
+		lv := value.List()
+		clv := lv.(*runtime.List[uint32, runtime.Uint32Value])
+		x.Values = clv.Slice()
+	case "stabletest.Unordered.id":
+		x.Id = value.Int()
+	case "stabletest.Unordered.flag":
+		cv := value.Bool()
+		x.Other = &Unordered_Flag{Flag: cv}
+	case "stabletest.Unordered.children":
+		mv := value.Map()
+		cmv := mv.(*runtime.SortedMap[int32, *Unordered, runtime.Int32Value, runtime.MessageValue[Unordered, *Unordered]])
+		x.Children = cmv.GoMap()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stabletest.Unordered"))
+		}
+		panic(fmt.Errorf("message stabletest.Unordered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Unordered) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stabletest.Unordered.nested":
+		if x.Choice == nil {
+			value := &Unordered{}
+			oneofValue := &Unordered_Nested{Nested: value}
+			x.Choice = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Choice.(type) {
+		case *Unordered_Nested:
+			return protoreflect.ValueOfMessage(m.Nested.ProtoReflect())
+		default:
+			value := &Unordered{}
+			oneofValue := &Unordered_Nested{Nested: value}
+			x.Choice = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "stabletest.Unordered.counts":
+		if x.Counts == nil {
+			x.Counts = make(map[string]int64)
+		}
+		value := runtime.NewSortedMap[string, int64, runtime.StringValue, runtime.Int64Value](&x.Counts)
+		return protoreflect.ValueOfMap(value)
+	case "stabletest.Unordered.values":
+		if x.Values == nil {
+			x.Values = []uint32{}
+		}
+		value := runtime.NewList[uint32, runtime.Uint32Value](&x.Values)
+		return protoreflect.ValueOfList(value)
+	case "stabletest.Unordered.children":
+		if x.Children == nil {
+			x.Children = make(map[int32]*Unordered)
+		}
+		value := runtime.NewSortedMap[int32, *Unordered, runtime.Int32Value, runtime.MessageValue[Unordered, *Unordered]](&x.Children)
+		return protoreflect.ValueOfMap(value)
+	case "stabletest.Unordered.name":
+		panic(fmt.Errorf("field name of message stabletest.Unordered is not mutable"))
+	case "stabletest.Unordered.text":
+		panic(fmt.Errorf("field text of message stabletest.Unordered is not mutable"))
+	case "stabletest.Unordered.id":
+		panic(fmt.Errorf("field id of message stabletest.Unordered is not mutable"))
+	case "stabletest.Unordered.flag":
+		panic(fmt.Errorf("field flag of message stabletest.Unordered is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stabletest.Unordered"))
+		}
+		panic(fmt.Errorf("message stabletest.Unordered does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Unordered) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stabletest.Unordered.name":
+		return protoreflect.ValueOfString("")
+	case "stabletest.Unordered.text":
+		return protoreflect.ValueOfString("")
+	case "stabletest.Unordered.nested":
+		value := &Unordered{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stabletest.Unordered.counts":
+		m := make(map[string]int64)
+		return protoreflect.ValueOfMap(runtime.NewSortedMap[string, int64, runtime.StringValue, runtime.Int64Value](&m))
+	case "stabletest.Unordered.values":
+		list := []uint32{}
+		return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&list))
+	case "stabletest.Unordered.id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stabletest.Unordered.flag":
+		return protoreflect.ValueOfBool(false)
+	case "stabletest.Unordered.children":
+		m := make(map[int32]*Unordered)
+		return protoreflect.ValueOfMap(runtime.NewSortedMap[int32, *Unordered, runtime.Int32Value, runtime.MessageValue[Unordered, *Unordered]](&m))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stabletest.Unordered"))
+		}
+		panic(fmt.Errorf("message stabletest.Unordered does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Unordered) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "stabletest.Unordered.choice":
+		if x.Choice == nil {
+			return nil
+		}
+		switch x.Choice.(type) {
+		case *Unordered_Text:
+			return md_Unordered.Fields().ByName("text")
+		case *Unordered_Nested:
+			return md_Unordered.Fields().ByName("nested")
+		}
+	case "stabletest.Unordered.other":
+		if x.Other == nil {
+			return nil
+		}
+		switch x.Other.(type) {
+		case *Unordered_Flag:
+			return md_Unordered.Fields().ByName("flag")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stabletest.Unordered", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Unordered) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Unordered) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Unordered) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Unordered) ProtoMethods() *protoiface.Methods {
+	return fastReflection_UnorderedProtoMethods
+}
+
+var fastReflection_UnorderedProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Unordered)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		switch x := x.Choice.(type) {
+		case *Unordered_Text:
+			if x == nil {
+				break
+			}
+			l = len(x.Text)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *Unordered_Nested:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Nested)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Counts) > 0 {
+			SiZeMaP := func(k string, v int64) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.Counts))
+				for k := range x.Counts {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.Counts[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Counts {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.Values) > 0 {
+			l = 0
+			for _, e := range x.Values {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		switch x := x.Other.(type) {
+		case *Unordered_Flag:
+			if x == nil {
+				break
+			}
+			n += 2
+		}
+		if len(x.Children) > 0 {
+			SiZeMaP := func(k int32, v *Unordered) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]int32, 0, len(x.Children))
+				for k := range x.Children {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Children[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Children {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Unordered)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Other.(type) {
+		case *Unordered_Flag:
+			i--
+			if x.Flag {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		switch x := x.Choice.(type) {
+		case *Unordered_Text:
+			i -= len(x.Text)
+			copy(dAtA[i:], x.Text)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Text)))
+			i--
+			dAtA[i] = 0x42
+		case *Unordered_Nested:
+			encoded, err := options.Marshal(x.Nested)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Values) > 0 {
+			var pksize2 int
+			for _, num := range x.Values {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Values {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Children) > 0 {
+			MaRsHaLmAp := func(k int32, v *Unordered) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x22
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForChildren := make([]int32, 0, len(x.Children))
+				for k := range x.Children {
+					keysForChildren = append(keysForChildren, int32(k))
+				}
+				sort.Slice(keysForChildren, func(i, j int) bool {
+					return keysForChildren[i] < keysForChildren[j]
+				})
+				for iNdEx := len(keysForChildren) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Children[int32(keysForChildren[iNdEx])]
+					out, err := MaRsHaLmAp(keysForChildren[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Children {
+					v := x.Children[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.Counts) > 0 {
+			MaRsHaLmAp := func(k string, v int64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForCounts := make([]string, 0, len(x.Counts))
+				for k := range x.Counts {
+					keysForCounts = append(keysForCounts, string(k))
+				}
+				sort.Slice(keysForCounts, func(i, j int) bool {
+					return keysForCounts[i] < keysForCounts[j]
+				})
+				for iNdEx := len(keysForCounts) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Counts[string(keysForCounts[iNdEx])]
+					out, err := MaRsHaLmAp(keysForCounts[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Counts {
+					v := x.Counts[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Unordered)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Unordered: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Unordered: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Choice = &Unordered_Text{string(dAtA[iNdEx:postIndex])}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &Unordered{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Choice = &Unordered_Nested{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Counts == nil {
+					x.Counts = make(map[string]int64)
+				}
+				var mapkey string
+				var mapvalue int64
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvalue |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Counts[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 7:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Values = append(x.Values, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Values) == 0 {
+						x.Values = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Values = append(x.Values, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				b := bool(v != 0)
+				x.Other = &Unordered_Flag{b}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Children == nil {
+					x.Children = make(map[int32]*Unordered)
+				}
+				var mapkey int32
+				var mapvalue *Unordered
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= int32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &Unordered{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Children[mapkey] = mapvalue
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		return protoiface.CheckInitializedOutput{}, nil
+	}
+	fastReflection_UnorderedProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: internal/testprotos/stabletest/stable.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Unordered declares its fields out of field number order, with the fields of
+// its oneof interleaved with the other fields.
+type Unordered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Choice:
+	//	*Unordered_Text
+	//	*Unordered_Nested
+	Choice isUnordered_Choice `protobuf_oneof:"choice"`
+	Counts map[string]int64   `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Values []uint32           `protobuf:"varint,7,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Id     int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Other:
+	//	*Unordered_Flag
+	Other    isUnordered_Other    `protobuf_oneof:"other"`
+	Children map[int32]*Unordered `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Unordered) Reset() {
+	*x = Unordered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_stabletest_stable_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Unordered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unordered) ProtoMessage() {}
+
+// Deprecated: Use Unordered.ProtoReflect.Descriptor instead.
+func (*Unordered) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_stabletest_stable_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Unordered) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Unordered) GetChoice() isUnordered_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Unordered) GetText() string {
+	if x, ok := x.GetChoice().(*Unordered_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Unordered) GetNested() *Unordered {
+	if x, ok := x.GetChoice().(*Unordered_Nested); ok {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Unordered) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Unordered) GetValues() []uint32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Unordered) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Unordered) GetOther() isUnordered_Other {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *Unordered) GetFlag() bool {
+	if x, ok := x.GetOther().(*Unordered_Flag); ok {
+		return x.Flag
+	}
+	return false
+}
+
+func (x *Unordered) GetChildren() map[int32]*Unordered {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type isUnordered_Choice interface {
+	isUnordered_Choice()
+}
+
+type Unordered_Text struct {
+	Text string `protobuf:"bytes,8,opt,name=text,proto3,oneof"`
+}
+
+type Unordered_Nested struct {
+	Nested *Unordered `protobuf:"bytes,2,opt,name=nested,proto3,oneof"`
+}
+
+func (*Unordered_Text) isUnordered_Choice() {}
+
+func (*Unordered_Nested) isUnordered_Choice() {}
+
+type isUnordered_Other interface {
+	isUnordered_Other()
+}
+
+type Unordered_Flag struct {
+	Flag bool `protobuf:"varint,6,opt,name=flag,proto3,oneof"`
+}
+
+func (*Unordered_Flag) isUnordered_Other() {}
+
+var File_internal_testprotos_stabletest_stable_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_stabletest_stable_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74,
+	0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x03, 0x0a, 0x09, 0x55, 0x6e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x55, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x3f, 0x0a, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x65, 0x64, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x1a, 0x39, 0x0a, 0x0b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x42, 0x3f,
+	0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_testprotos_stabletest_stable_proto_rawDescOnce sync.Once
+	file_internal_testprotos_stabletest_stable_proto_rawDescData = file_internal_testprotos_stabletest_stable_proto_rawDesc
+)
+
+func file_internal_testprotos_stabletest_stable_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_stabletest_stable_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_stabletest_stable_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_stabletest_stable_proto_rawDescData)
+	})
+	return file_internal_testprotos_stabletest_stable_proto_rawDescData
+}
+
+var file_internal_testprotos_stabletest_stable_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_internal_testprotos_stabletest_stable_proto_goTypes = []interface{}{
+	(*Unordered)(nil), // 0: stabletest.Unordered
+	nil,               // 1: stabletest.Unordered.CountsEntry
+	nil,               // 2: stabletest.Unordered.ChildrenEntry
+}
+var file_internal_testprotos_stabletest_stable_proto_depIdxs = []int32{
+	0, // 0: stabletest.Unordered.nested:type_name -> stabletest.Unordered
+	1, // 1: stabletest.Unordered.counts:type_name -> stabletest.Unordered.CountsEntry
+	2, // 2: stabletest.Unordered.children:type_name -> stabletest.Unordered.ChildrenEntry
+	0, // 3: stabletest.Unordered.ChildrenEntry.value:type_name -> stabletest.Unordered
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_stabletest_stable_proto_init() }
+func file_internal_testprotos_stabletest_stable_proto_init() {
+	if File_internal_testprotos_stabletest_stable_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_stabletest_stable_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Unordered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_stabletest_stable_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Unordered_Text)(nil),
+		(*Unordered_Nested)(nil),
+		(*Unordered_Flag)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_stabletest_stable_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_stabletest_stable_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_stabletest_stable_proto_depIdxs,
+		MessageInfos:      file_internal_testprotos_stabletest_stable_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_stabletest_stable_proto = out.File
+	file_internal_testprotos_stabletest_stable_proto_rawDesc = nil
+	file_internal_testprotos_stabletest_stable_proto_goTypes = nil
+	file_internal_testprotos_stabletest_stable_proto_depIdxs = nil
+}
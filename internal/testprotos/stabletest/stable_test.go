@@ -0,0 +1,95 @@
+package stabletest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func newUnordered() *Unordered {
+	return &Unordered{
+		Name:     "name",
+		Choice:   &Unordered_Text{Text: "text"},
+		Counts:   map[string]int64{"c": 3, "a": 1, "b": 2, "": 0},
+		Values:   []uint32{3, 1, 2},
+		Id:       7,
+		Other:    &Unordered_Flag{Flag: false},
+		Children: map[int32]*Unordered{10: {}, -1: {}, 0: {}, 5: {}},
+	}
+}
+
+// rangeNumbers returns the numbers of the fields visited by Range.
+func rangeNumbers(msg protoreflect.Message) []protoreflect.FieldNumber {
+	var numbers []protoreflect.FieldNumber
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		numbers = append(numbers, fd.Number())
+		return true
+	})
+	return numbers
+}
+
+func TestRangeFieldOrder(t *testing.T) {
+	msg := newUnordered()
+	require.Equal(t, []protoreflect.FieldNumber{1, 3, 4, 5, 6, 7, 8}, rangeNumbers(msg.ProtoReflect()))
+
+	msg.Choice = &Unordered_Nested{Nested: &Unordered{}}
+	msg.Counts = nil
+	require.Equal(t, []protoreflect.FieldNumber{1, 2, 4, 5, 6, 7}, rangeNumbers(msg.ProtoReflect()))
+
+	require.Empty(t, rangeNumbers((&Unordered{}).ProtoReflect()))
+}
+
+func TestRangeStopsEarly(t *testing.T) {
+	var numbers []protoreflect.FieldNumber
+	newUnordered().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		numbers = append(numbers, fd.Number())
+		return fd.Number() < 4
+	})
+	require.Equal(t, []protoreflect.FieldNumber{1, 3, 4}, numbers)
+}
+
+func TestMapKeyOrder(t *testing.T) {
+	msg := newUnordered().ProtoReflect()
+	fields := msg.Descriptor().Fields()
+
+	// the maps are sorted whether they are got from Range, Get or Mutable
+	for i := 0; i < 10; i++ {
+		var keys []string
+		msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.Name() == "counts" {
+				v.Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+					keys = append(keys, k.String())
+					return true
+				})
+			}
+			return true
+		})
+		require.Equal(t, []string{"", "a", "b", "c"}, keys)
+
+		var ints []int32
+		msg.Get(fields.ByName("children")).Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			ints = append(ints, int32(k.Int()))
+			return true
+		})
+		require.Equal(t, []int32{-1, 0, 5, 10}, ints)
+
+		ints = ints[:0]
+		msg.Mutable(fields.ByName("children")).Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			ints = append(ints, int32(k.Int()))
+			return true
+		})
+		require.Equal(t, []int32{-1, 0, 5, 10}, ints)
+	}
+}
+
+func TestSetSortedMap(t *testing.T) {
+	src := newUnordered().ProtoReflect()
+	dst := (&Unordered{}).ProtoReflect()
+	fd := src.Descriptor().Fields().ByName("counts")
+
+	m := dst.NewField(fd)
+	m.Map().Set(protoreflect.ValueOfString("k").MapKey(), protoreflect.ValueOfInt64(1))
+	dst.Set(fd, m)
+	require.Equal(t, map[string]int64{"k": 1}, dst.Interface().(*Unordered).Counts)
+}
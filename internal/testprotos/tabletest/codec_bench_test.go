@@ -0,0 +1,60 @@
+package tabletest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-proto/internal/testprotos/tabletest/unrolled"
+)
+
+// benchMessages returns the same message generated with the table and the unrolled codecs.
+func benchMessages(b *testing.B) map[string]proto.Message {
+	msg := newEverything(10)
+	bz, err := proto.Marshal(msg)
+	require.NoError(b, err)
+	other := &unrolled.Everything{}
+	require.NoError(b, proto.Unmarshal(bz, other))
+	return map[string]proto.Message{"table": msg, "unrolled": other}
+}
+
+func BenchmarkSize(b *testing.B) {
+	for name, msg := range benchMessages(b) {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = proto.Size(msg)
+			}
+		})
+	}
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	for name, msg := range benchMessages(b) {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := proto.Marshal(msg); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	for name, msg := range benchMessages(b) {
+		bz, err := proto.Marshal(msg)
+		require.NoError(b, err)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(bz)))
+			for i := 0; i < b.N; i++ {
+				if err := proto.Unmarshal(bz, msg.ProtoReflect().Type().New().Interface()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
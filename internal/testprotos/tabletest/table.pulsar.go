@@ -0,0 +1,4244 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package tabletest
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	cosmostest "github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	presencetest "github.com/cosmos/cosmos-proto/internal/testprotos/presencetest"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	table "github.com/cosmos/cosmos-proto/runtime/table"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Scalars          protoreflect.MessageDescriptor
+	fd_Scalars_bool     protoreflect.FieldDescriptor
+	fd_Scalars_int32    protoreflect.FieldDescriptor
+	fd_Scalars_sint32   protoreflect.FieldDescriptor
+	fd_Scalars_uint32   protoreflect.FieldDescriptor
+	fd_Scalars_int64    protoreflect.FieldDescriptor
+	fd_Scalars_sint64   protoreflect.FieldDescriptor
+	fd_Scalars_uint64   protoreflect.FieldDescriptor
+	fd_Scalars_sfixed32 protoreflect.FieldDescriptor
+	fd_Scalars_fixed32  protoreflect.FieldDescriptor
+	fd_Scalars_float    protoreflect.FieldDescriptor
+	fd_Scalars_sfixed64 protoreflect.FieldDescriptor
+	fd_Scalars_fixed64  protoreflect.FieldDescriptor
+	fd_Scalars_double   protoreflect.FieldDescriptor
+	fd_Scalars_string   protoreflect.FieldDescriptor
+	fd_Scalars_bytes    protoreflect.FieldDescriptor
+	fd_Scalars_kind     protoreflect.FieldDescriptor
+	fd_Scalars_amount   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_tabletest_table_proto_init()
+	md_Scalars = File_internal_testprotos_tabletest_table_proto.Messages().ByName("Scalars")
+	fd_Scalars_bool = md_Scalars.Fields().ByName("bool")
+	fd_Scalars_int32 = md_Scalars.Fields().ByName("int32")
+	fd_Scalars_sint32 = md_Scalars.Fields().ByName("sint32")
+	fd_Scalars_uint32 = md_Scalars.Fields().ByName("uint32")
+	fd_Scalars_int64 = md_Scalars.Fields().ByName("int64")
+	fd_Scalars_sint64 = md_Scalars.Fields().ByName("sint64")
+	fd_Scalars_uint64 = md_Scalars.Fields().ByName("uint64")
+	fd_Scalars_sfixed32 = md_Scalars.Fields().ByName("sfixed32")
+	fd_Scalars_fixed32 = md_Scalars.Fields().ByName("fixed32")
+	fd_Scalars_float = md_Scalars.Fields().ByName("float")
+	fd_Scalars_sfixed64 = md_Scalars.Fields().ByName("sfixed64")
+	fd_Scalars_fixed64 = md_Scalars.Fields().ByName("fixed64")
+	fd_Scalars_double = md_Scalars.Fields().ByName("double")
+	fd_Scalars_string = md_Scalars.Fields().ByName("string")
+	fd_Scalars_bytes = md_Scalars.Fields().ByName("bytes")
+	fd_Scalars_kind = md_Scalars.Fields().ByName("kind")
+	fd_Scalars_amount = md_Scalars.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_Scalars)(nil)
+
+type fastReflection_Scalars Scalars
+
+func (x *Scalars) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Scalars)(x)
+}
+
+func (x *Scalars) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Scalars_messageType fastReflection_Scalars_messageType
+var _ protoreflect.MessageType = fastReflection_Scalars_messageType{}
+
+type fastReflection_Scalars_messageType struct{}
+
+func (x fastReflection_Scalars_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Scalars)(nil)
+}
+func (x fastReflection_Scalars_messageType) New() protoreflect.Message {
+	return new(fastReflection_Scalars)
+}
+func (x fastReflection_Scalars_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Scalars
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Scalars) Descriptor() protoreflect.MessageDescriptor {
+	return md_Scalars
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Scalars) Type() protoreflect.MessageType {
+	return _fastReflection_Scalars_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Scalars) New() protoreflect.Message {
+	return new(fastReflection_Scalars)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Scalars) Interface() protoreflect.ProtoMessage {
+	return (*Scalars)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Scalars) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bool != false {
+		value := protoreflect.ValueOfBool(x.Bool)
+		if !f(fd_Scalars_bool, value) {
+			return
+		}
+	}
+	if x.Int32 != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Int32)
+		if !f(fd_Scalars_int32, value) {
+			return
+		}
+	}
+	if x.Sint32 != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Sint32)
+		if !f(fd_Scalars_sint32, value) {
+			return
+		}
+	}
+	if x.Uint32 != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Uint32)
+		if !f(fd_Scalars_uint32, value) {
+			return
+		}
+	}
+	if x.Int64 != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Int64)
+		if !f(fd_Scalars_int64, value) {
+			return
+		}
+	}
+	if x.Sint64 != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Sint64)
+		if !f(fd_Scalars_sint64, value) {
+			return
+		}
+	}
+	if x.Uint64 != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Uint64)
+		if !f(fd_Scalars_uint64, value) {
+			return
+		}
+	}
+	if x.Sfixed32 != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Sfixed32)
+		if !f(fd_Scalars_sfixed32, value) {
+			return
+		}
+	}
+	if x.Fixed32 != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Fixed32)
+		if !f(fd_Scalars_fixed32, value) {
+			return
+		}
+	}
+	if x.Float != float32(0) || math.Signbit(float64(x.Float)) {
+		value := protoreflect.ValueOfFloat32(x.Float)
+		if !f(fd_Scalars_float, value) {
+			return
+		}
+	}
+	if x.Sfixed64 != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Sfixed64)
+		if !f(fd_Scalars_sfixed64, value) {
+			return
+		}
+	}
+	if x.Fixed64 != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Fixed64)
+		if !f(fd_Scalars_fixed64, value) {
+			return
+		}
+	}
+	if x.Double != float64(0) || math.Signbit(x.Double) {
+		value := protoreflect.ValueOfFloat64(x.Double)
+		if !f(fd_Scalars_double, value) {
+			return
+		}
+	}
+	if x.String_ != "" {
+		value := protoreflect.ValueOfString(x.String_)
+		if !f(fd_Scalars_string, value) {
+			return
+		}
+	}
+	if len(x.Bytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bytes)
+		if !f(fd_Scalars_bytes, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_Scalars_kind, value) {
+			return
+		}
+	}
+	if x.Amount.Size() != 0 {
+		bz := runtime.MarshalCustomType(&x.Amount)
+		value := protoreflect.ValueOfString(string(bz))
+		if !f(fd_Scalars_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Scalars) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "tabletest.Scalars.bool":
+		return x.Bool != false
+	case "tabletest.Scalars.int32":
+		return x.Int32 != int32(0)
+	case "tabletest.Scalars.sint32":
+		return x.Sint32 != int32(0)
+	case "tabletest.Scalars.uint32":
+		return x.Uint32 != uint32(0)
+	case "tabletest.Scalars.int64":
+		return x.Int64 != int64(0)
+	case "tabletest.Scalars.sint64":
+		return x.Sint64 != int64(0)
+	case "tabletest.Scalars.uint64":
+		return x.Uint64 != uint64(0)
+	case "tabletest.Scalars.sfixed32":
+		return x.Sfixed32 != int32(0)
+	case "tabletest.Scalars.fixed32":
+		return x.Fixed32 != uint32(0)
+	case "tabletest.Scalars.float":
+		return x.Float != float32(0) || math.Signbit(float64(x.Float))
+	case "tabletest.Scalars.sfixed64":
+		return x.Sfixed64 != int64(0)
+	case "tabletest.Scalars.fixed64":
+		return x.Fixed64 != uint64(0)
+	case "tabletest.Scalars.double":
+		return x.Double != float64(0) || math.Signbit(x.Double)
+	case "tabletest.Scalars.string":
+		return x.String_ != ""
+	case "tabletest.Scalars.bytes":
+		return len(x.Bytes) != 0
+	case "tabletest.Scalars.kind":
+		return x.Kind != 0
+	case "tabletest.Scalars.amount":
+		return x.Amount.Size() != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Scalars"))
+		}
+		panic(fmt.Errorf("message tabletest.Scalars does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Scalars) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "tabletest.Scalars.bool":
+		x.Bool = false
+	case "tabletest.Scalars.int32":
+		x.Int32 = int32(0)
+	case "tabletest.Scalars.sint32":
+		x.Sint32 = int32(0)
+	case "tabletest.Scalars.uint32":
+		x.Uint32 = uint32(0)
+	case "tabletest.Scalars.int64":
+		x.Int64 = int64(0)
+	case "tabletest.Scalars.sint64":
+		x.Sint64 = int64(0)
+	case "tabletest.Scalars.uint64":
+		x.Uint64 = uint64(0)
+	case "tabletest.Scalars.sfixed32":
+		x.Sfixed32 = int32(0)
+	case "tabletest.Scalars.fixed32":
+		x.Fixed32 = uint32(0)
+	case "tabletest.Scalars.float":
+		x.Float = float32(0)
+	case "tabletest.Scalars.sfixed64":
+		x.Sfixed64 = int64(0)
+	case "tabletest.Scalars.fixed64":
+		x.Fixed64 = uint64(0)
+	case "tabletest.Scalars.double":
+		x.Double = float64(0)
+	case "tabletest.Scalars.string":
+		x.String_ = ""
+	case "tabletest.Scalars.bytes":
+		x.Bytes = nil
+	case "tabletest.Scalars.kind":
+		x.Kind = 0
+	case "tabletest.Scalars.amount":
+		var zero cosmostest.Int
+		x.Amount = zero
+		runtime.ClearCustomType(&x.unknownFields, 17)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Scalars"))
+		}
+		panic(fmt.Errorf("message tabletest.Scalars does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Scalars) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "tabletest.Scalars.bool":
+		value := x.Bool
+		return protoreflect.ValueOfBool(value)
+	case "tabletest.Scalars.int32":
+		value := x.Int32
+		return protoreflect.ValueOfInt32(value)
+	case "tabletest.Scalars.sint32":
+		value := x.Sint32
+		return protoreflect.ValueOfInt32(value)
+	case "tabletest.Scalars.uint32":
+		value := x.Uint32
+		return protoreflect.ValueOfUint32(value)
+	case "tabletest.Scalars.int64":
+		value := x.Int64
+		return protoreflect.ValueOfInt64(value)
+	case "tabletest.Scalars.sint64":
+		value := x.Sint64
+		return protoreflect.ValueOfInt64(value)
+	case "tabletest.Scalars.uint64":
+		value := x.Uint64
+		return protoreflect.ValueOfUint64(value)
+	case "tabletest.Scalars.sfixed32":
+		value := x.Sfixed32
+		return protoreflect.ValueOfInt32(value)
+	case "tabletest.Scalars.fixed32":
+		value := x.Fixed32
+		return protoreflect.ValueOfUint32(value)
+	case "tabletest.Scalars.float":
+		value := x.Float
+		return protoreflect.ValueOfFloat32(value)
+	case "tabletest.Scalars.sfixed64":
+		value := x.Sfixed64
+		return protoreflect.ValueOfInt64(value)
+	case "tabletest.Scalars.fixed64":
+		value := x.Fixed64
+		return protoreflect.ValueOfUint64(value)
+	case "tabletest.Scalars.double":
+		value := x.Double
+		return protoreflect.ValueOfFloat64(value)
+	case "tabletest.Scalars.string":
+		value := x.String_
+		return protoreflect.ValueOfString(value)
+	case "tabletest.Scalars.bytes":
+		value := x.Bytes
+		return protoreflect.ValueOfBytes(value)
+	case "tabletest.Scalars.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "tabletest.Scalars.amount":
+		value := runtime.MarshalCustomType(&x.Amount)
+		return protoreflect.ValueOfString(string(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Scalars"))
+		}
+		panic(fmt.Errorf("message tabletest.Scalars does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Scalars) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "tabletest.Scalars.bool":
+		x.Bool = value.Bool()
+	case "tabletest.Scalars.int32":
+		x.Int32 = int32(value.Int())
+	case "tabletest.Scalars.sint32":
+		x.Sint32 = int32(value.Int())
+	case "tabletest.Scalars.uint32":
+		x.Uint32 = uint32(value.Uint())
+	case "tabletest.Scalars.int64":
+		x.Int64 = value.Int()
+	case "tabletest.Scalars.sint64":
+		x.Sint64 = value.Int()
+	case "tabletest.Scalars.uint64":
+		x.Uint64 = value.Uint()
+	case "tabletest.Scalars.sfixed32":
+		x.Sfixed32 = int32(value.Int())
+	case "tabletest.Scalars.fixed32":
+		x.Fixed32 = uint32(value.Uint())
+	case "tabletest.Scalars.float":
+		x.Float = float32(value.Float())
+	case "tabletest.Scalars.sfixed64":
+		x.Sfixed64 = value.Int()
+	case "tabletest.Scalars.fixed64":
+		x.Fixed64 = value.Uint()
+	case "tabletest.Scalars.double":
+		x.Double = value.Float()
+	case "tabletest.Scalars.string":
+		x.String_ = value.Interface().(string)
+	case "tabletest.Scalars.bytes":
+		x.Bytes = value.Bytes()
+	case "tabletest.Scalars.kind":
+		x.Kind = (Kind)(value.Enum())
+	case "tabletest.Scalars.amount":
+		if !runtime.SetCustomType(&x.Amount, &x.unknownFields, 17, []byte(value.Interface().(string))) {
+			var zero cosmostest.Int
+			x.Amount = zero
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Scalars"))
+		}
+		panic(fmt.Errorf("message tabletest.Scalars does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Scalars) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Scalars.bool":
+		panic(fmt.Errorf("field bool of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.int32":
+		panic(fmt.Errorf("field int32 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.sint32":
+		panic(fmt.Errorf("field sint32 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.uint32":
+		panic(fmt.Errorf("field uint32 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.int64":
+		panic(fmt.Errorf("field int64 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.sint64":
+		panic(fmt.Errorf("field sint64 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.uint64":
+		panic(fmt.Errorf("field uint64 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.sfixed32":
+		panic(fmt.Errorf("field sfixed32 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.fixed32":
+		panic(fmt.Errorf("field fixed32 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.float":
+		panic(fmt.Errorf("field float of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.sfixed64":
+		panic(fmt.Errorf("field sfixed64 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.fixed64":
+		panic(fmt.Errorf("field fixed64 of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.double":
+		panic(fmt.Errorf("field double of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.string":
+		panic(fmt.Errorf("field string of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.bytes":
+		panic(fmt.Errorf("field bytes of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.kind":
+		panic(fmt.Errorf("field kind of message tabletest.Scalars is not mutable"))
+	case "tabletest.Scalars.amount":
+		panic(fmt.Errorf("field amount of message tabletest.Scalars is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Scalars"))
+		}
+		panic(fmt.Errorf("message tabletest.Scalars does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Scalars) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Scalars.bool":
+		return protoreflect.ValueOfBool(false)
+	case "tabletest.Scalars.int32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "tabletest.Scalars.sint32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "tabletest.Scalars.uint32":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "tabletest.Scalars.int64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "tabletest.Scalars.sint64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "tabletest.Scalars.uint64":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "tabletest.Scalars.sfixed32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "tabletest.Scalars.fixed32":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "tabletest.Scalars.float":
+		return protoreflect.ValueOfFloat32(float32(0))
+	case "tabletest.Scalars.sfixed64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "tabletest.Scalars.fixed64":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "tabletest.Scalars.double":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "tabletest.Scalars.string":
+		return protoreflect.ValueOfString("")
+	case "tabletest.Scalars.bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "tabletest.Scalars.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "tabletest.Scalars.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Scalars"))
+		}
+		panic(fmt.Errorf("message tabletest.Scalars does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Scalars) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in tabletest.Scalars", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Scalars) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Scalars) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Scalars) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Scalars) ProtoMethods() *protoiface.Methods {
+	return fastReflection_ScalarsProtoMethods
+}
+
+var fastReflection_ScalarsProtoMethods *protoiface.Methods
+
+func init() {
+	fastReflection_ScalarsProtoMethods = table.New(func(x *Scalars) *[]byte { return &x.unknownFields },
+		table.Scalar(1, table.Bool, func(x *Scalars) *bool { return &x.Bool }),
+		table.Scalar(2, table.Int32, func(x *Scalars) *int32 { return &x.Int32 }),
+		table.Scalar(3, table.Sint32, func(x *Scalars) *int32 { return &x.Sint32 }),
+		table.Scalar(4, table.Uint32, func(x *Scalars) *uint32 { return &x.Uint32 }),
+		table.Scalar(5, table.Int64, func(x *Scalars) *int64 { return &x.Int64 }),
+		table.Scalar(6, table.Sint64, func(x *Scalars) *int64 { return &x.Sint64 }),
+		table.Scalar(7, table.Uint64, func(x *Scalars) *uint64 { return &x.Uint64 }),
+		table.Scalar(8, table.Sfixed32, func(x *Scalars) *int32 { return &x.Sfixed32 }),
+		table.Scalar(9, table.Fixed32, func(x *Scalars) *uint32 { return &x.Fixed32 }),
+		table.Scalar(10, table.Float, func(x *Scalars) *float32 { return &x.Float }),
+		table.Scalar(11, table.Sfixed64, func(x *Scalars) *int64 { return &x.Sfixed64 }),
+		table.Scalar(12, table.Fixed64, func(x *Scalars) *uint64 { return &x.Fixed64 }),
+		table.Scalar(13, table.Double, func(x *Scalars) *float64 { return &x.Double }),
+		table.Scalar(14, table.String, func(x *Scalars) *string { return &x.String_ }),
+		table.Scalar(15, table.Bytes, func(x *Scalars) *[]byte { return &x.Bytes }),
+		table.Scalar(16, table.Enum[Kind](), func(x *Scalars) *Kind { return &x.Kind }),
+		table.Custom(17, func(x *Scalars) *cosmostest.Int { return &x.Amount }),
+	).Methods()
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Scalars)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		if err := runtime.CheckCustomType(x.unknownFields, fd_Scalars_amount, new(cosmostest.Int)); err != nil {
+			return output, err
+		}
+		return output, nil
+	}
+	fastReflection_ScalarsProtoMethods.CheckInitialized = checkInitialized
+}
+
+var (
+	md_Repeated          protoreflect.MessageDescriptor
+	fd_Repeated_int32    protoreflect.FieldDescriptor
+	fd_Repeated_sint64   protoreflect.FieldDescriptor
+	fd_Repeated_fixed32  protoreflect.FieldDescriptor
+	fd_Repeated_double   protoreflect.FieldDescriptor
+	fd_Repeated_bool     protoreflect.FieldDescriptor
+	fd_Repeated_kind     protoreflect.FieldDescriptor
+	fd_Repeated_unpacked protoreflect.FieldDescriptor
+	fd_Repeated_string   protoreflect.FieldDescriptor
+	fd_Repeated_bytes    protoreflect.FieldDescriptor
+	fd_Repeated_scalars  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_tabletest_table_proto_init()
+	md_Repeated = File_internal_testprotos_tabletest_table_proto.Messages().ByName("Repeated")
+	fd_Repeated_int32 = md_Repeated.Fields().ByName("int32")
+	fd_Repeated_sint64 = md_Repeated.Fields().ByName("sint64")
+	fd_Repeated_fixed32 = md_Repeated.Fields().ByName("fixed32")
+	fd_Repeated_double = md_Repeated.Fields().ByName("double")
+	fd_Repeated_bool = md_Repeated.Fields().ByName("bool")
+	fd_Repeated_kind = md_Repeated.Fields().ByName("kind")
+	fd_Repeated_unpacked = md_Repeated.Fields().ByName("unpacked")
+	fd_Repeated_string = md_Repeated.Fields().ByName("string")
+	fd_Repeated_bytes = md_Repeated.Fields().ByName("bytes")
+	fd_Repeated_scalars = md_Repeated.Fields().ByName("scalars")
+}
+
+var _ protoreflect.Message = (*fastReflection_Repeated)(nil)
+
+type fastReflection_Repeated Repeated
+
+func (x *Repeated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Repeated)(x)
+}
+
+func (x *Repeated) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Repeated_messageType fastReflection_Repeated_messageType
+var _ protoreflect.MessageType = fastReflection_Repeated_messageType{}
+
+type fastReflection_Repeated_messageType struct{}
+
+func (x fastReflection_Repeated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Repeated)(nil)
+}
+func (x fastReflection_Repeated_messageType) New() protoreflect.Message {
+	return new(fastReflection_Repeated)
+}
+func (x fastReflection_Repeated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Repeated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Repeated) Descriptor() protoreflect.MessageDescriptor {
+	return md_Repeated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Repeated) Type() protoreflect.MessageType {
+	return _fastReflection_Repeated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Repeated) New() protoreflect.Message {
+	return new(fastReflection_Repeated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Repeated) Interface() protoreflect.ProtoMessage {
+	return (*Repeated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Repeated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Int32) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&x.Int32))
+		if !f(fd_Repeated_int32, value) {
+			return
+		}
+	}
+	if len(x.Sint64) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&x.Sint64))
+		if !f(fd_Repeated_sint64, value) {
+			return
+		}
+	}
+	if len(x.Fixed32) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&x.Fixed32))
+		if !f(fd_Repeated_fixed32, value) {
+			return
+		}
+	}
+	if len(x.Double) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[float64, runtime.Float64Value](&x.Double))
+		if !f(fd_Repeated_double, value) {
+			return
+		}
+	}
+	if len(x.Bool) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[bool, runtime.BoolValue](&x.Bool))
+		if !f(fd_Repeated_bool, value) {
+			return
+		}
+	}
+	if len(x.Kind) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[Kind, runtime.EnumValue[Kind]](&x.Kind))
+		if !f(fd_Repeated_kind, value) {
+			return
+		}
+	}
+	if len(x.Unpacked) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](&x.Unpacked))
+		if !f(fd_Repeated_unpacked, value) {
+			return
+		}
+	}
+	if len(x.String_) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](&x.String_))
+		if !f(fd_Repeated_string, value) {
+			return
+		}
+	}
+	if len(x.Bytes) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[[]byte, runtime.BytesValue](&x.Bytes))
+		if !f(fd_Repeated_bytes, value) {
+			return
+		}
+	}
+	if len(x.Scalars) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[*Scalars, runtime.MessageValue[Scalars, *Scalars]](&x.Scalars))
+		if !f(fd_Repeated_scalars, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Repeated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "tabletest.Repeated.int32":
+		return len(x.Int32) != 0
+	case "tabletest.Repeated.sint64":
+		return len(x.Sint64) != 0
+	case "tabletest.Repeated.fixed32":
+		return len(x.Fixed32) != 0
+	case "tabletest.Repeated.double":
+		return len(x.Double) != 0
+	case "tabletest.Repeated.bool":
+		return len(x.Bool) != 0
+	case "tabletest.Repeated.kind":
+		return len(x.Kind) != 0
+	case "tabletest.Repeated.unpacked":
+		return len(x.Unpacked) != 0
+	case "tabletest.Repeated.string":
+		return len(x.String_) != 0
+	case "tabletest.Repeated.bytes":
+		return len(x.Bytes) != 0
+	case "tabletest.Repeated.scalars":
+		return len(x.Scalars) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Repeated"))
+		}
+		panic(fmt.Errorf("message tabletest.Repeated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Repeated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "tabletest.Repeated.int32":
+		x.Int32 = nil
+	case "tabletest.Repeated.sint64":
+		x.Sint64 = nil
+	case "tabletest.Repeated.fixed32":
+		x.Fixed32 = nil
+	case "tabletest.Repeated.double":
+		x.Double = nil
+	case "tabletest.Repeated.bool":
+		x.Bool = nil
+	case "tabletest.Repeated.kind":
+		x.Kind = nil
+	case "tabletest.Repeated.unpacked":
+		x.Unpacked = nil
+	case "tabletest.Repeated.string":
+		x.String_ = nil
+	case "tabletest.Repeated.bytes":
+		x.Bytes = nil
+	case "tabletest.Repeated.scalars":
+		x.Scalars = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Repeated"))
+		}
+		panic(fmt.Errorf("message tabletest.Repeated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Repeated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "tabletest.Repeated.int32":
+		if len(x.Int32) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](nil))
+		}
+		listValue := runtime.NewList[int32, runtime.Int32Value](&x.Int32)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.sint64":
+		if len(x.Sint64) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](nil))
+		}
+		listValue := runtime.NewList[int64, runtime.Int64Value](&x.Sint64)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.fixed32":
+		if len(x.Fixed32) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](nil))
+		}
+		listValue := runtime.NewList[uint32, runtime.Uint32Value](&x.Fixed32)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.double":
+		if len(x.Double) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[float64, runtime.Float64Value](nil))
+		}
+		listValue := runtime.NewList[float64, runtime.Float64Value](&x.Double)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.bool":
+		if len(x.Bool) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[bool, runtime.BoolValue](nil))
+		}
+		listValue := runtime.NewList[bool, runtime.BoolValue](&x.Bool)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.kind":
+		if len(x.Kind) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[Kind, runtime.EnumValue[Kind]](nil))
+		}
+		listValue := runtime.NewList[Kind, runtime.EnumValue[Kind]](&x.Kind)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.unpacked":
+		if len(x.Unpacked) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](nil))
+		}
+		listValue := runtime.NewList[uint64, runtime.Uint64Value](&x.Unpacked)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.string":
+		if len(x.String_) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](nil))
+		}
+		listValue := runtime.NewList[string, runtime.StringValue](&x.String_)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.bytes":
+		if len(x.Bytes) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[[]byte, runtime.BytesValue](nil))
+		}
+		listValue := runtime.NewList[[]byte, runtime.BytesValue](&x.Bytes)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Repeated.scalars":
+		if len(x.Scalars) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[*Scalars, runtime.MessageValue[Scalars, *Scalars]](nil))
+		}
+		listValue := runtime.NewList[*Scalars, runtime.MessageValue[Scalars, *Scalars]](&x.Scalars)
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Repeated"))
+		}
+		panic(fmt.Errorf("message tabletest.Repeated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Repeated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "tabletest.Repeated.int32":
+		lv := value.List()
+		clv := lv.(*runtime.List[int32, runtime.Int32Value])
+		x.Int32 = clv.Slice()
+	case "tabletest.Repeated.sint64":
+		lv := value.List()
+		clv := lv.(*runtime.List[int64, runtime.Int64Value])
+		x.Sint64 = clv.Slice()
+	case "tabletest.Repeated.fixed32":
+		lv := value.List()
+		clv := lv.(*runtime.List[uint32, runtime.Uint32Value])
+		x.Fixed32 = clv.Slice()
+	case "tabletest.Repeated.double":
+		lv := value.List()
+		clv := lv.(*runtime.List[float64, runtime.Float64Value])
+		x.Double = clv.Slice()
+	case "tabletest.Repeated.bool":
+		lv := value.List()
+		clv := lv.(*runtime.List[bool, runtime.BoolValue])
+		x.Bool = clv.Slice()
+	case "tabletest.Repeated.kind":
+		lv := value.List()
+		clv := lv.(*runtime.List[Kind, runtime.EnumValue[Kind]])
+		x.Kind = clv.Slice()
+	case "tabletest.Repeated.unpacked":
+		lv := value.List()
+		clv := lv.(*runtime.List[uint64, runtime.Uint64Value])
+		x.Unpacked = clv.Slice()
+	case "tabletest.Repeated.string":
+		lv := value.List()
+		clv := lv.(*runtime.List[string, runtime.StringValue])
+		x.String_ = clv.Slice()
+	case "tabletest.Repeated.bytes":
+		lv := value.List()
+		clv := lv.(*runtime.List[[]byte, runtime.BytesValue])
+		x.Bytes = clv.Slice()
+	case "tabletest.Repeated.scalars":
+		lv := value.List()
+		clv := lv.(*runtime.List[*Scalars, runtime.MessageValue[Scalars, *Scalars]])
+		x.Scalars = clv.Slice()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Repeated"))
+		}
+		panic(fmt.Errorf("message tabletest.Repeated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Repeated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Repeated.int32":
+		if x.Int32 == nil {
+			x.Int32 = []int32{}
+		}
+		value := runtime.NewList[int32, runtime.Int32Value](&x.Int32)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.sint64":
+		if x.Sint64 == nil {
+			x.Sint64 = []int64{}
+		}
+		value := runtime.NewList[int64, runtime.Int64Value](&x.Sint64)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.fixed32":
+		if x.Fixed32 == nil {
+			x.Fixed32 = []uint32{}
+		}
+		value := runtime.NewList[uint32, runtime.Uint32Value](&x.Fixed32)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.double":
+		if x.Double == nil {
+			x.Double = []float64{}
+		}
+		value := runtime.NewList[float64, runtime.Float64Value](&x.Double)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.bool":
+		if x.Bool == nil {
+			x.Bool = []bool{}
+		}
+		value := runtime.NewList[bool, runtime.BoolValue](&x.Bool)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.kind":
+		if x.Kind == nil {
+			x.Kind = []Kind{}
+		}
+		value := runtime.NewList[Kind, runtime.EnumValue[Kind]](&x.Kind)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.unpacked":
+		if x.Unpacked == nil {
+			x.Unpacked = []uint64{}
+		}
+		value := runtime.NewList[uint64, runtime.Uint64Value](&x.Unpacked)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.string":
+		if x.String_ == nil {
+			x.String_ = []string{}
+		}
+		value := runtime.NewList[string, runtime.StringValue](&x.String_)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.bytes":
+		if x.Bytes == nil {
+			x.Bytes = [][]byte{}
+		}
+		value := runtime.NewList[[]byte, runtime.BytesValue](&x.Bytes)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Repeated.scalars":
+		if x.Scalars == nil {
+			x.Scalars = []*Scalars{}
+		}
+		value := runtime.NewList[*Scalars, runtime.MessageValue[Scalars, *Scalars]](&x.Scalars)
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Repeated"))
+		}
+		panic(fmt.Errorf("message tabletest.Repeated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Repeated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Repeated.int32":
+		list := []int32{}
+		return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&list))
+	case "tabletest.Repeated.sint64":
+		list := []int64{}
+		return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&list))
+	case "tabletest.Repeated.fixed32":
+		list := []uint32{}
+		return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&list))
+	case "tabletest.Repeated.double":
+		list := []float64{}
+		return protoreflect.ValueOfList(runtime.NewList[float64, runtime.Float64Value](&list))
+	case "tabletest.Repeated.bool":
+		list := []bool{}
+		return protoreflect.ValueOfList(runtime.NewList[bool, runtime.BoolValue](&list))
+	case "tabletest.Repeated.kind":
+		list := []Kind{}
+		return protoreflect.ValueOfList(runtime.NewList[Kind, runtime.EnumValue[Kind]](&list))
+	case "tabletest.Repeated.unpacked":
+		list := []uint64{}
+		return protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](&list))
+	case "tabletest.Repeated.string":
+		list := []string{}
+		return protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](&list))
+	case "tabletest.Repeated.bytes":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(runtime.NewList[[]byte, runtime.BytesValue](&list))
+	case "tabletest.Repeated.scalars":
+		list := []*Scalars{}
+		return protoreflect.ValueOfList(runtime.NewList[*Scalars, runtime.MessageValue[Scalars, *Scalars]](&list))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Repeated"))
+		}
+		panic(fmt.Errorf("message tabletest.Repeated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Repeated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in tabletest.Repeated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Repeated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Repeated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Repeated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Repeated) ProtoMethods() *protoiface.Methods {
+	return fastReflection_RepeatedProtoMethods
+}
+
+var fastReflection_RepeatedProtoMethods *protoiface.Methods
+
+func init() {
+	fastReflection_RepeatedProtoMethods = table.New(func(x *Repeated) *[]byte { return &x.unknownFields },
+		table.Packed(1, table.Int32, func(x *Repeated) *[]int32 { return &x.Int32 }),
+		table.Packed(2, table.Sint64, func(x *Repeated) *[]int64 { return &x.Sint64 }),
+		table.Packed(3, table.Fixed32, func(x *Repeated) *[]uint32 { return &x.Fixed32 }),
+		table.Packed(4, table.Double, func(x *Repeated) *[]float64 { return &x.Double }),
+		table.Packed(5, table.Bool, func(x *Repeated) *[]bool { return &x.Bool }),
+		table.Packed(6, table.Enum[Kind](), func(x *Repeated) *[]Kind { return &x.Kind }),
+		table.Repeated(7, table.Uint64, func(x *Repeated) *[]uint64 { return &x.Unpacked }),
+		table.Repeated(8, table.String, func(x *Repeated) *[]string { return &x.String_ }),
+		table.Repeated(9, table.Bytes, func(x *Repeated) *[][]byte { return &x.Bytes }),
+		table.RepeatedMessage(10, func(x *Repeated) *[]*Scalars { return &x.Scalars }),
+	).Methods()
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Repeated)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		for _, v := range x.Scalars {
+			if err := proto.CheckInitialized(v); err != nil {
+				return output, err
+			}
+		}
+		return output, nil
+	}
+	fastReflection_RepeatedProtoMethods.CheckInitialized = checkInitialized
+}
+
+var (
+	md_Maps                protoreflect.MessageDescriptor
+	fd_Maps_string_string  protoreflect.FieldDescriptor
+	fd_Maps_int32_int64    protoreflect.FieldDescriptor
+	fd_Maps_bool_bytes     protoreflect.FieldDescriptor
+	fd_Maps_uint64_scalars protoreflect.FieldDescriptor
+	fd_Maps_sint32_kind    protoreflect.FieldDescriptor
+	fd_Maps_fixed64_double protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_tabletest_table_proto_init()
+	md_Maps = File_internal_testprotos_tabletest_table_proto.Messages().ByName("Maps")
+	fd_Maps_string_string = md_Maps.Fields().ByName("string_string")
+	fd_Maps_int32_int64 = md_Maps.Fields().ByName("int32_int64")
+	fd_Maps_bool_bytes = md_Maps.Fields().ByName("bool_bytes")
+	fd_Maps_uint64_scalars = md_Maps.Fields().ByName("uint64_scalars")
+	fd_Maps_sint32_kind = md_Maps.Fields().ByName("sint32_kind")
+	fd_Maps_fixed64_double = md_Maps.Fields().ByName("fixed64_double")
+}
+
+var _ protoreflect.Message = (*fastReflection_Maps)(nil)
+
+type fastReflection_Maps Maps
+
+func (x *Maps) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Maps)(x)
+}
+
+func (x *Maps) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Maps_messageType fastReflection_Maps_messageType
+var _ protoreflect.MessageType = fastReflection_Maps_messageType{}
+
+type fastReflection_Maps_messageType struct{}
+
+func (x fastReflection_Maps_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Maps)(nil)
+}
+func (x fastReflection_Maps_messageType) New() protoreflect.Message {
+	return new(fastReflection_Maps)
+}
+func (x fastReflection_Maps_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Maps
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Maps) Descriptor() protoreflect.MessageDescriptor {
+	return md_Maps
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Maps) Type() protoreflect.MessageType {
+	return _fastReflection_Maps_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Maps) New() protoreflect.Message {
+	return new(fastReflection_Maps)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Maps) Interface() protoreflect.ProtoMessage {
+	return (*Maps)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Maps) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.StringString) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&x.StringString))
+		if !f(fd_Maps_string_string, value) {
+			return
+		}
+	}
+	if len(x.Int32Int64) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, int64, runtime.Int32Value, runtime.Int64Value](&x.Int32Int64))
+		if !f(fd_Maps_int32_int64, value) {
+			return
+		}
+	}
+	if len(x.BoolBytes) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[bool, []byte, runtime.BoolValue, runtime.BytesValue](&x.BoolBytes))
+		if !f(fd_Maps_bool_bytes, value) {
+			return
+		}
+	}
+	if len(x.Uint64Scalars) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[uint64, *Scalars, runtime.Uint64Value, runtime.MessageValue[Scalars, *Scalars]](&x.Uint64Scalars))
+		if !f(fd_Maps_uint64_scalars, value) {
+			return
+		}
+	}
+	if len(x.Sint32Kind) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, Kind, runtime.Int32Value, runtime.EnumValue[Kind]](&x.Sint32Kind))
+		if !f(fd_Maps_sint32_kind, value) {
+			return
+		}
+	}
+	if len(x.Fixed64Double) != 0 {
+		value := protoreflect.ValueOfMap(runtime.NewMap[uint64, float64, runtime.Uint64Value, runtime.Float64Value](&x.Fixed64Double))
+		if !f(fd_Maps_fixed64_double, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Maps) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "tabletest.Maps.string_string":
+		return len(x.StringString) != 0
+	case "tabletest.Maps.int32_int64":
+		return len(x.Int32Int64) != 0
+	case "tabletest.Maps.bool_bytes":
+		return len(x.BoolBytes) != 0
+	case "tabletest.Maps.uint64_scalars":
+		return len(x.Uint64Scalars) != 0
+	case "tabletest.Maps.sint32_kind":
+		return len(x.Sint32Kind) != 0
+	case "tabletest.Maps.fixed64_double":
+		return len(x.Fixed64Double) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Maps"))
+		}
+		panic(fmt.Errorf("message tabletest.Maps does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Maps) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "tabletest.Maps.string_string":
+		x.StringString = nil
+	case "tabletest.Maps.int32_int64":
+		x.Int32Int64 = nil
+	case "tabletest.Maps.bool_bytes":
+		x.BoolBytes = nil
+	case "tabletest.Maps.uint64_scalars":
+		x.Uint64Scalars = nil
+	case "tabletest.Maps.sint32_kind":
+		x.Sint32Kind = nil
+	case "tabletest.Maps.fixed64_double":
+		x.Fixed64Double = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Maps"))
+		}
+		panic(fmt.Errorf("message tabletest.Maps does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Maps) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "tabletest.Maps.string_string":
+		if len(x.StringString) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](nil))
+		}
+		mapValue := runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&x.StringString)
+		return protoreflect.ValueOfMap(mapValue)
+	case "tabletest.Maps.int32_int64":
+		if len(x.Int32Int64) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, int64, runtime.Int32Value, runtime.Int64Value](nil))
+		}
+		mapValue := runtime.NewMap[int32, int64, runtime.Int32Value, runtime.Int64Value](&x.Int32Int64)
+		return protoreflect.ValueOfMap(mapValue)
+	case "tabletest.Maps.bool_bytes":
+		if len(x.BoolBytes) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[bool, []byte, runtime.BoolValue, runtime.BytesValue](nil))
+		}
+		mapValue := runtime.NewMap[bool, []byte, runtime.BoolValue, runtime.BytesValue](&x.BoolBytes)
+		return protoreflect.ValueOfMap(mapValue)
+	case "tabletest.Maps.uint64_scalars":
+		if len(x.Uint64Scalars) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[uint64, *Scalars, runtime.Uint64Value, runtime.MessageValue[Scalars, *Scalars]](nil))
+		}
+		mapValue := runtime.NewMap[uint64, *Scalars, runtime.Uint64Value, runtime.MessageValue[Scalars, *Scalars]](&x.Uint64Scalars)
+		return protoreflect.ValueOfMap(mapValue)
+	case "tabletest.Maps.sint32_kind":
+		if len(x.Sint32Kind) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, Kind, runtime.Int32Value, runtime.EnumValue[Kind]](nil))
+		}
+		mapValue := runtime.NewMap[int32, Kind, runtime.Int32Value, runtime.EnumValue[Kind]](&x.Sint32Kind)
+		return protoreflect.ValueOfMap(mapValue)
+	case "tabletest.Maps.fixed64_double":
+		if len(x.Fixed64Double) == 0 {
+			return protoreflect.ValueOfMap(runtime.NewMap[uint64, float64, runtime.Uint64Value, runtime.Float64Value](nil))
+		}
+		mapValue := runtime.NewMap[uint64, float64, runtime.Uint64Value, runtime.Float64Value](&x.Fixed64Double)
+		return protoreflect.ValueOfMap(mapValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Maps"))
+		}
+		panic(fmt.Errorf("message tabletest.Maps does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Maps) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "tabletest.Maps.string_string":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[string, string, runtime.StringValue, runtime.StringValue])
+		x.StringString = cmv.GoMap()
+	case "tabletest.Maps.int32_int64":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[int32, int64, runtime.Int32Value, runtime.Int64Value])
+		x.Int32Int64 = cmv.GoMap()
+	case "tabletest.Maps.bool_bytes":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[bool, []byte, runtime.BoolValue, runtime.BytesValue])
+		x.BoolBytes = cmv.GoMap()
+	case "tabletest.Maps.uint64_scalars":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[uint64, *Scalars, runtime.Uint64Value, runtime.MessageValue[Scalars, *Scalars]])
+		x.Uint64Scalars = cmv.GoMap()
+	case "tabletest.Maps.sint32_kind":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[int32, Kind, runtime.Int32Value, runtime.EnumValue[Kind]])
+		x.Sint32Kind = cmv.GoMap()
+	case "tabletest.Maps.fixed64_double":
+		mv := value.Map()
+		cmv := mv.(*runtime.Map[uint64, float64, runtime.Uint64Value, runtime.Float64Value])
+		x.Fixed64Double = cmv.GoMap()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Maps"))
+		}
+		panic(fmt.Errorf("message tabletest.Maps does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Maps) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Maps.string_string":
+		if x.StringString == nil {
+			x.StringString = make(map[string]string)
+		}
+		value := runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&x.StringString)
+		return protoreflect.ValueOfMap(value)
+	case "tabletest.Maps.int32_int64":
+		if x.Int32Int64 == nil {
+			x.Int32Int64 = make(map[int32]int64)
+		}
+		value := runtime.NewMap[int32, int64, runtime.Int32Value, runtime.Int64Value](&x.Int32Int64)
+		return protoreflect.ValueOfMap(value)
+	case "tabletest.Maps.bool_bytes":
+		if x.BoolBytes == nil {
+			x.BoolBytes = make(map[bool][]byte)
+		}
+		value := runtime.NewMap[bool, []byte, runtime.BoolValue, runtime.BytesValue](&x.BoolBytes)
+		return protoreflect.ValueOfMap(value)
+	case "tabletest.Maps.uint64_scalars":
+		if x.Uint64Scalars == nil {
+			x.Uint64Scalars = make(map[uint64]*Scalars)
+		}
+		value := runtime.NewMap[uint64, *Scalars, runtime.Uint64Value, runtime.MessageValue[Scalars, *Scalars]](&x.Uint64Scalars)
+		return protoreflect.ValueOfMap(value)
+	case "tabletest.Maps.sint32_kind":
+		if x.Sint32Kind == nil {
+			x.Sint32Kind = make(map[int32]Kind)
+		}
+		value := runtime.NewMap[int32, Kind, runtime.Int32Value, runtime.EnumValue[Kind]](&x.Sint32Kind)
+		return protoreflect.ValueOfMap(value)
+	case "tabletest.Maps.fixed64_double":
+		if x.Fixed64Double == nil {
+			x.Fixed64Double = make(map[uint64]float64)
+		}
+		value := runtime.NewMap[uint64, float64, runtime.Uint64Value, runtime.Float64Value](&x.Fixed64Double)
+		return protoreflect.ValueOfMap(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Maps"))
+		}
+		panic(fmt.Errorf("message tabletest.Maps does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Maps) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Maps.string_string":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&m))
+	case "tabletest.Maps.int32_int64":
+		m := make(map[int32]int64)
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, int64, runtime.Int32Value, runtime.Int64Value](&m))
+	case "tabletest.Maps.bool_bytes":
+		m := make(map[bool][]byte)
+		return protoreflect.ValueOfMap(runtime.NewMap[bool, []byte, runtime.BoolValue, runtime.BytesValue](&m))
+	case "tabletest.Maps.uint64_scalars":
+		m := make(map[uint64]*Scalars)
+		return protoreflect.ValueOfMap(runtime.NewMap[uint64, *Scalars, runtime.Uint64Value, runtime.MessageValue[Scalars, *Scalars]](&m))
+	case "tabletest.Maps.sint32_kind":
+		m := make(map[int32]Kind)
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, Kind, runtime.Int32Value, runtime.EnumValue[Kind]](&m))
+	case "tabletest.Maps.fixed64_double":
+		m := make(map[uint64]float64)
+		return protoreflect.ValueOfMap(runtime.NewMap[uint64, float64, runtime.Uint64Value, runtime.Float64Value](&m))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Maps"))
+		}
+		panic(fmt.Errorf("message tabletest.Maps does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Maps) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in tabletest.Maps", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Maps) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Maps) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Maps) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Maps) ProtoMethods() *protoiface.Methods {
+	return fastReflection_MapsProtoMethods
+}
+
+var fastReflection_MapsProtoMethods *protoiface.Methods
+
+func init() {
+	fastReflection_MapsProtoMethods = table.New(func(x *Maps) *[]byte { return &x.unknownFields },
+		table.Map(1, table.String, table.String, func(x *Maps) *map[string]string { return &x.StringString }),
+		table.Map(2, table.Int32, table.Int64, func(x *Maps) *map[int32]int64 { return &x.Int32Int64 }),
+		table.Map(3, table.Bool, table.Bytes, func(x *Maps) *map[bool][]byte { return &x.BoolBytes }),
+		table.MessageMap(4, table.Uint64, func(x *Maps) *map[uint64]*Scalars { return &x.Uint64Scalars }),
+		table.Map(5, table.Sint32, table.Enum[Kind](), func(x *Maps) *map[int32]Kind { return &x.Sint32Kind }),
+		table.Map(6, table.Fixed64, table.Double, func(x *Maps) *map[uint64]float64 { return &x.Fixed64Double }),
+	).Methods()
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Maps)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		for _, v := range x.Uint64Scalars {
+			if err := proto.CheckInitialized(v); err != nil {
+				return output, err
+			}
+		}
+		return output, nil
+	}
+	fastReflection_MapsProtoMethods.CheckInitialized = checkInitialized
+}
+
+var (
+	md_Oneofs         protoreflect.MessageDescriptor
+	fd_Oneofs_text    protoreflect.FieldDescriptor
+	fd_Oneofs_number  protoreflect.FieldDescriptor
+	fd_Oneofs_scalars protoreflect.FieldDescriptor
+	fd_Oneofs_data    protoreflect.FieldDescriptor
+	fd_Oneofs_kind    protoreflect.FieldDescriptor
+	fd_Oneofs_flag    protoreflect.FieldDescriptor
+	fd_Oneofs_plain   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_tabletest_table_proto_init()
+	md_Oneofs = File_internal_testprotos_tabletest_table_proto.Messages().ByName("Oneofs")
+	fd_Oneofs_text = md_Oneofs.Fields().ByName("text")
+	fd_Oneofs_number = md_Oneofs.Fields().ByName("number")
+	fd_Oneofs_scalars = md_Oneofs.Fields().ByName("scalars")
+	fd_Oneofs_data = md_Oneofs.Fields().ByName("data")
+	fd_Oneofs_kind = md_Oneofs.Fields().ByName("kind")
+	fd_Oneofs_flag = md_Oneofs.Fields().ByName("flag")
+	fd_Oneofs_plain = md_Oneofs.Fields().ByName("plain")
+}
+
+var _ protoreflect.Message = (*fastReflection_Oneofs)(nil)
+
+type fastReflection_Oneofs Oneofs
+
+func (x *Oneofs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Oneofs)(x)
+}
+
+func (x *Oneofs) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Oneofs_messageType fastReflection_Oneofs_messageType
+var _ protoreflect.MessageType = fastReflection_Oneofs_messageType{}
+
+type fastReflection_Oneofs_messageType struct{}
+
+func (x fastReflection_Oneofs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Oneofs)(nil)
+}
+func (x fastReflection_Oneofs_messageType) New() protoreflect.Message {
+	return new(fastReflection_Oneofs)
+}
+func (x fastReflection_Oneofs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Oneofs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Oneofs) Descriptor() protoreflect.MessageDescriptor {
+	return md_Oneofs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Oneofs) Type() protoreflect.MessageType {
+	return _fastReflection_Oneofs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Oneofs) New() protoreflect.Message {
+	return new(fastReflection_Oneofs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Oneofs) Interface() protoreflect.ProtoMessage {
+	return (*Oneofs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Oneofs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != nil {
+		switch o := x.Value.(type) {
+		case *Oneofs_Text:
+			v := o.Text
+			value := protoreflect.ValueOfString(v)
+			if !f(fd_Oneofs_text, value) {
+				return
+			}
+		case *Oneofs_Number:
+			v := o.Number
+			value := protoreflect.ValueOfInt64(v)
+			if !f(fd_Oneofs_number, value) {
+				return
+			}
+		case *Oneofs_Scalars:
+			v := o.Scalars
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_Oneofs_scalars, value) {
+				return
+			}
+		case *Oneofs_Data:
+			v := o.Data
+			value := protoreflect.ValueOfBytes(v)
+			if !f(fd_Oneofs_data, value) {
+				return
+			}
+		case *Oneofs_Kind:
+			v := o.Kind
+			value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+			if !f(fd_Oneofs_kind, value) {
+				return
+			}
+		}
+	}
+	if x.Other != nil {
+		switch o := x.Other.(type) {
+		case *Oneofs_Flag:
+			v := o.Flag
+			value := protoreflect.ValueOfBool(v)
+			if !f(fd_Oneofs_flag, value) {
+				return
+			}
+		}
+	}
+	if x.Plain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Plain)
+		if !f(fd_Oneofs_plain, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Oneofs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "tabletest.Oneofs.text":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Oneofs_Text); ok {
+			return true
+		} else {
+			return false
+		}
+	case "tabletest.Oneofs.number":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Oneofs_Number); ok {
+			return true
+		} else {
+			return false
+		}
+	case "tabletest.Oneofs.scalars":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Oneofs_Scalars); ok {
+			return true
+		} else {
+			return false
+		}
+	case "tabletest.Oneofs.data":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Oneofs_Data); ok {
+			return true
+		} else {
+			return false
+		}
+	case "tabletest.Oneofs.kind":
+		if x.Value == nil {
+			return false
+		} else if _, ok := x.Value.(*Oneofs_Kind); ok {
+			return true
+		} else {
+			return false
+		}
+	case "tabletest.Oneofs.flag":
+		if x.Other == nil {
+			return false
+		} else if _, ok := x.Other.(*Oneofs_Flag); ok {
+			return true
+		} else {
+			return false
+		}
+	case "tabletest.Oneofs.plain":
+		return x.Plain != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Oneofs"))
+		}
+		panic(fmt.Errorf("message tabletest.Oneofs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Oneofs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "tabletest.Oneofs.text":
+		x.Value = nil
+	case "tabletest.Oneofs.number":
+		x.Value = nil
+	case "tabletest.Oneofs.scalars":
+		x.Value = nil
+	case "tabletest.Oneofs.data":
+		x.Value = nil
+	case "tabletest.Oneofs.kind":
+		x.Value = nil
+	case "tabletest.Oneofs.flag":
+		x.Other = nil
+	case "tabletest.Oneofs.plain":
+		x.Plain = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Oneofs"))
+		}
+		panic(fmt.Errorf("message tabletest.Oneofs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Oneofs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "tabletest.Oneofs.text":
+		if x.Value == nil {
+			return protoreflect.ValueOfString("")
+		} else if v, ok := x.Value.(*Oneofs_Text); ok {
+			return protoreflect.ValueOfString(v.Text)
+		} else {
+			return protoreflect.ValueOfString("")
+		}
+	case "tabletest.Oneofs.number":
+		if x.Value == nil {
+			return protoreflect.ValueOfInt64(int64(0))
+		} else if v, ok := x.Value.(*Oneofs_Number); ok {
+			return protoreflect.ValueOfInt64(v.Number)
+		} else {
+			return protoreflect.ValueOfInt64(int64(0))
+		}
+	case "tabletest.Oneofs.scalars":
+		if x.Value == nil {
+			return protoreflect.ValueOfMessage((*Scalars)(nil).ProtoReflect())
+		} else if v, ok := x.Value.(*Oneofs_Scalars); ok {
+			return protoreflect.ValueOfMessage(v.Scalars.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*Scalars)(nil).ProtoReflect())
+		}
+	case "tabletest.Oneofs.data":
+		if x.Value == nil {
+			return protoreflect.ValueOfBytes(nil)
+		} else if v, ok := x.Value.(*Oneofs_Data); ok {
+			return protoreflect.ValueOfBytes(v.Data)
+		} else {
+			return protoreflect.ValueOfBytes(nil)
+		}
+	case "tabletest.Oneofs.kind":
+		if x.Value == nil {
+			return protoreflect.ValueOfEnum(0)
+		} else if v, ok := x.Value.(*Oneofs_Kind); ok {
+			return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v.Kind))
+		} else {
+			return protoreflect.ValueOfEnum(0)
+		}
+	case "tabletest.Oneofs.flag":
+		if x.Other == nil {
+			return protoreflect.ValueOfBool(false)
+		} else if v, ok := x.Other.(*Oneofs_Flag); ok {
+			return protoreflect.ValueOfBool(v.Flag)
+		} else {
+			return protoreflect.ValueOfBool(false)
+		}
+	case "tabletest.Oneofs.plain":
+		value := x.Plain
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Oneofs"))
+		}
+		panic(fmt.Errorf("message tabletest.Oneofs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Oneofs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "tabletest.Oneofs.text":
+		cv := value.Interface().(string)
+		x.Value = &Oneofs_Text{Text: cv}
+	case "tabletest.Oneofs.number":
+		cv := value.Int()
+		x.Value = &Oneofs_Number{Number: cv}
+	case "tabletest.Oneofs.scalars":
+		cv := value.Message().Interface().(*Scalars)
+		x.Value = &Oneofs_Scalars{Scalars: cv}
+	case "tabletest.Oneofs.data":
+		cv := value.Bytes()
+		x.Value = &Oneofs_Data{Data: cv}
+	case "tabletest.Oneofs.kind":
+		cv := (Kind)(value.Enum())
+		x.Value = &Oneofs_Kind{Kind: cv}
+	case "tabletest.Oneofs.flag":
+		cv := value.Bool()
+		x.Other = &Oneofs_Flag{Flag: cv}
+	case "tabletest.Oneofs.plain":
+		x.Plain = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Oneofs"))
+		}
+		panic(fmt.Errorf("message tabletest.Oneofs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Oneofs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Oneofs.scalars":
+		if x.Value == nil {
+			value := &Scalars{}
+			oneofValue := &Oneofs_Scalars{Scalars: value}
+			x.Value = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Value.(type) {
+		case *Oneofs_Scalars:
+			return protoreflect.ValueOfMessage(m.Scalars.ProtoReflect())
+		default:
+			value := &Scalars{}
+			oneofValue := &Oneofs_Scalars{Scalars: value}
+			x.Value = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "tabletest.Oneofs.text":
+		panic(fmt.Errorf("field text of message tabletest.Oneofs is not mutable"))
+	case "tabletest.Oneofs.number":
+		panic(fmt.Errorf("field number of message tabletest.Oneofs is not mutable"))
+	case "tabletest.Oneofs.data":
+		panic(fmt.Errorf("field data of message tabletest.Oneofs is not mutable"))
+	case "tabletest.Oneofs.kind":
+		panic(fmt.Errorf("field kind of message tabletest.Oneofs is not mutable"))
+	case "tabletest.Oneofs.flag":
+		panic(fmt.Errorf("field flag of message tabletest.Oneofs is not mutable"))
+	case "tabletest.Oneofs.plain":
+		panic(fmt.Errorf("field plain of message tabletest.Oneofs is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Oneofs"))
+		}
+		panic(fmt.Errorf("message tabletest.Oneofs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Oneofs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Oneofs.text":
+		return protoreflect.ValueOfString("")
+	case "tabletest.Oneofs.number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "tabletest.Oneofs.scalars":
+		value := &Scalars{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Oneofs.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "tabletest.Oneofs.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "tabletest.Oneofs.flag":
+		return protoreflect.ValueOfBool(false)
+	case "tabletest.Oneofs.plain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Oneofs"))
+		}
+		panic(fmt.Errorf("message tabletest.Oneofs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Oneofs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "tabletest.Oneofs.value":
+		if x.Value == nil {
+			return nil
+		}
+		switch x.Value.(type) {
+		case *Oneofs_Text:
+			return md_Oneofs.Fields().ByName("text")
+		case *Oneofs_Number:
+			return md_Oneofs.Fields().ByName("number")
+		case *Oneofs_Scalars:
+			return md_Oneofs.Fields().ByName("scalars")
+		case *Oneofs_Data:
+			return md_Oneofs.Fields().ByName("data")
+		case *Oneofs_Kind:
+			return md_Oneofs.Fields().ByName("kind")
+		}
+	case "tabletest.Oneofs.other":
+		if x.Other == nil {
+			return nil
+		}
+		switch x.Other.(type) {
+		case *Oneofs_Flag:
+			return md_Oneofs.Fields().ByName("flag")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in tabletest.Oneofs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Oneofs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Oneofs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Oneofs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Oneofs) ProtoMethods() *protoiface.Methods {
+	return fastReflection_OneofsProtoMethods
+}
+
+var fastReflection_OneofsProtoMethods *protoiface.Methods
+
+func init() {
+	fastReflection_OneofsProtoMethods = table.New(func(x *Oneofs) *[]byte { return &x.unknownFields },
+		table.Scalar(7, table.Uint32, func(x *Oneofs) *uint32 { return &x.Plain }),
+		table.OneofScalar(1, table.String, func(x *Oneofs) *isOneofs_Value { return &x.Value }, func(w *Oneofs_Text) *string { return &w.Text }),
+		table.OneofScalar(2, table.Int64, func(x *Oneofs) *isOneofs_Value { return &x.Value }, func(w *Oneofs_Number) *int64 { return &w.Number }),
+		table.OneofMessage(3, func(x *Oneofs) *isOneofs_Value { return &x.Value }, func(w *Oneofs_Scalars) **Scalars { return &w.Scalars }),
+		table.OneofScalar(4, table.Bytes, func(x *Oneofs) *isOneofs_Value { return &x.Value }, func(w *Oneofs_Data) *[]byte { return &w.Data }),
+		table.OneofScalar(5, table.Enum[Kind](), func(x *Oneofs) *isOneofs_Value { return &x.Value }, func(w *Oneofs_Kind) *Kind { return &w.Kind }),
+		table.OneofScalar(6, table.Bool, func(x *Oneofs) *isOneofs_Other { return &x.Other }, func(w *Oneofs_Flag) *bool { return &w.Flag }),
+	).Methods()
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Oneofs)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		if v, ok := x.Value.(*Oneofs_Scalars); ok && v.Scalars != nil {
+			if err := proto.CheckInitialized(v.Scalars); err != nil {
+				return output, err
+			}
+		}
+		return output, nil
+	}
+	fastReflection_OneofsProtoMethods.CheckInitialized = checkInitialized
+}
+
+var (
+	md_Optionals         protoreflect.MessageDescriptor
+	fd_Optionals_int32   protoreflect.FieldDescriptor
+	fd_Optionals_sint64  protoreflect.FieldDescriptor
+	fd_Optionals_double  protoreflect.FieldDescriptor
+	fd_Optionals_string  protoreflect.FieldDescriptor
+	fd_Optionals_bytes   protoreflect.FieldDescriptor
+	fd_Optionals_kind    protoreflect.FieldDescriptor
+	fd_Optionals_scalars protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_tabletest_table_proto_init()
+	md_Optionals = File_internal_testprotos_tabletest_table_proto.Messages().ByName("Optionals")
+	fd_Optionals_int32 = md_Optionals.Fields().ByName("int32")
+	fd_Optionals_sint64 = md_Optionals.Fields().ByName("sint64")
+	fd_Optionals_double = md_Optionals.Fields().ByName("double")
+	fd_Optionals_string = md_Optionals.Fields().ByName("string")
+	fd_Optionals_bytes = md_Optionals.Fields().ByName("bytes")
+	fd_Optionals_kind = md_Optionals.Fields().ByName("kind")
+	fd_Optionals_scalars = md_Optionals.Fields().ByName("scalars")
+}
+
+var _ protoreflect.Message = (*fastReflection_Optionals)(nil)
+
+type fastReflection_Optionals Optionals
+
+func (x *Optionals) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Optionals)(x)
+}
+
+func (x *Optionals) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Optionals_messageType fastReflection_Optionals_messageType
+var _ protoreflect.MessageType = fastReflection_Optionals_messageType{}
+
+type fastReflection_Optionals_messageType struct{}
+
+func (x fastReflection_Optionals_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Optionals)(nil)
+}
+func (x fastReflection_Optionals_messageType) New() protoreflect.Message {
+	return new(fastReflection_Optionals)
+}
+func (x fastReflection_Optionals_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Optionals
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Optionals) Descriptor() protoreflect.MessageDescriptor {
+	return md_Optionals
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Optionals) Type() protoreflect.MessageType {
+	return _fastReflection_Optionals_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Optionals) New() protoreflect.Message {
+	return new(fastReflection_Optionals)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Optionals) Interface() protoreflect.ProtoMessage {
+	return (*Optionals)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Optionals) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Int32 != nil {
+		value := protoreflect.ValueOfInt32(*x.Int32)
+		if !f(fd_Optionals_int32, value) {
+			return
+		}
+	}
+	if x.Sint64 != nil {
+		value := protoreflect.ValueOfInt64(*x.Sint64)
+		if !f(fd_Optionals_sint64, value) {
+			return
+		}
+	}
+	if x.Double != nil {
+		value := protoreflect.ValueOfFloat64(*x.Double)
+		if !f(fd_Optionals_double, value) {
+			return
+		}
+	}
+	if x.String_ != nil {
+		value := protoreflect.ValueOfString(*x.String_)
+		if !f(fd_Optionals_string, value) {
+			return
+		}
+	}
+	if x.Bytes != nil {
+		value := protoreflect.ValueOfBytes(x.Bytes)
+		if !f(fd_Optionals_bytes, value) {
+			return
+		}
+	}
+	if x.Kind != nil {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(*x.Kind))
+		if !f(fd_Optionals_kind, value) {
+			return
+		}
+	}
+	if x.Scalars != nil {
+		value := protoreflect.ValueOfMessage(x.Scalars.ProtoReflect())
+		if !f(fd_Optionals_scalars, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Optionals) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "tabletest.Optionals.int32":
+		return x.Int32 != nil
+	case "tabletest.Optionals.sint64":
+		return x.Sint64 != nil
+	case "tabletest.Optionals.double":
+		return x.Double != nil
+	case "tabletest.Optionals.string":
+		return x.String_ != nil
+	case "tabletest.Optionals.bytes":
+		return x.Bytes != nil
+	case "tabletest.Optionals.kind":
+		return x.Kind != nil
+	case "tabletest.Optionals.scalars":
+		return x.Scalars != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Optionals"))
+		}
+		panic(fmt.Errorf("message tabletest.Optionals does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optionals) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "tabletest.Optionals.int32":
+		x.Int32 = nil
+	case "tabletest.Optionals.sint64":
+		x.Sint64 = nil
+	case "tabletest.Optionals.double":
+		x.Double = nil
+	case "tabletest.Optionals.string":
+		x.String_ = nil
+	case "tabletest.Optionals.bytes":
+		x.Bytes = nil
+	case "tabletest.Optionals.kind":
+		x.Kind = nil
+	case "tabletest.Optionals.scalars":
+		x.Scalars = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Optionals"))
+		}
+		panic(fmt.Errorf("message tabletest.Optionals does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Optionals) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "tabletest.Optionals.int32":
+		if x.Int32 == nil {
+			return fd_Optionals_int32.Default()
+		}
+		value := *x.Int32
+		return protoreflect.ValueOfInt32(value)
+	case "tabletest.Optionals.sint64":
+		if x.Sint64 == nil {
+			return fd_Optionals_sint64.Default()
+		}
+		value := *x.Sint64
+		return protoreflect.ValueOfInt64(value)
+	case "tabletest.Optionals.double":
+		if x.Double == nil {
+			return fd_Optionals_double.Default()
+		}
+		value := *x.Double
+		return protoreflect.ValueOfFloat64(value)
+	case "tabletest.Optionals.string":
+		if x.String_ == nil {
+			return fd_Optionals_string.Default()
+		}
+		value := *x.String_
+		return protoreflect.ValueOfString(value)
+	case "tabletest.Optionals.bytes":
+		value := x.Bytes
+		return protoreflect.ValueOfBytes(value)
+	case "tabletest.Optionals.kind":
+		if x.Kind == nil {
+			return fd_Optionals_kind.Default()
+		}
+		value := *x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "tabletest.Optionals.scalars":
+		value := x.Scalars
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Optionals"))
+		}
+		panic(fmt.Errorf("message tabletest.Optionals does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optionals) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "tabletest.Optionals.int32":
+		cv := int32(value.Int())
+		x.Int32 = &cv
+	case "tabletest.Optionals.sint64":
+		cv := value.Int()
+		x.Sint64 = &cv
+	case "tabletest.Optionals.double":
+		cv := value.Float()
+		x.Double = &cv
+	case "tabletest.Optionals.string":
+		cv := value.Interface().(string)
+		x.String_ = &cv
+	case "tabletest.Optionals.bytes":
+		cv := value.Bytes()
+		if cv == nil {
+			cv = []byte{}
+		}
+		x.Bytes = cv
+	case "tabletest.Optionals.kind":
+		cv := (Kind)(value.Enum())
+		x.Kind = &cv
+	case "tabletest.Optionals.scalars":
+		x.Scalars = value.Message().Interface().(*Scalars)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Optionals"))
+		}
+		panic(fmt.Errorf("message tabletest.Optionals does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optionals) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Optionals.scalars":
+		if x.Scalars == nil {
+			x.Scalars = new(Scalars)
+		}
+		return protoreflect.ValueOfMessage(x.Scalars.ProtoReflect())
+	case "tabletest.Optionals.int32":
+		panic(fmt.Errorf("field int32 of message tabletest.Optionals is not mutable"))
+	case "tabletest.Optionals.sint64":
+		panic(fmt.Errorf("field sint64 of message tabletest.Optionals is not mutable"))
+	case "tabletest.Optionals.double":
+		panic(fmt.Errorf("field double of message tabletest.Optionals is not mutable"))
+	case "tabletest.Optionals.string":
+		panic(fmt.Errorf("field string of message tabletest.Optionals is not mutable"))
+	case "tabletest.Optionals.bytes":
+		panic(fmt.Errorf("field bytes of message tabletest.Optionals is not mutable"))
+	case "tabletest.Optionals.kind":
+		panic(fmt.Errorf("field kind of message tabletest.Optionals is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Optionals"))
+		}
+		panic(fmt.Errorf("message tabletest.Optionals does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Optionals) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Optionals.int32":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "tabletest.Optionals.sint64":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "tabletest.Optionals.double":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "tabletest.Optionals.string":
+		return protoreflect.ValueOfString("")
+	case "tabletest.Optionals.bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "tabletest.Optionals.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "tabletest.Optionals.scalars":
+		m := new(Scalars)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Optionals"))
+		}
+		panic(fmt.Errorf("message tabletest.Optionals does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Optionals) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "tabletest.Optionals._int32":
+		if x.Int32 == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("int32")
+	case "tabletest.Optionals._sint64":
+		if x.Sint64 == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("sint64")
+	case "tabletest.Optionals._double":
+		if x.Double == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("double")
+	case "tabletest.Optionals._string":
+		if x.String_ == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("string")
+	case "tabletest.Optionals._bytes":
+		if x.Bytes == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("bytes")
+	case "tabletest.Optionals._kind":
+		if x.Kind == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("kind")
+	case "tabletest.Optionals._scalars":
+		if x.Scalars == nil {
+			return nil
+		}
+		return md_Optionals.Fields().ByName("scalars")
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in tabletest.Optionals", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Optionals) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Optionals) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Optionals) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Optionals) ProtoMethods() *protoiface.Methods {
+	return fastReflection_OptionalsProtoMethods
+}
+
+var fastReflection_OptionalsProtoMethods *protoiface.Methods
+
+func init() {
+	fastReflection_OptionalsProtoMethods = table.New(func(x *Optionals) *[]byte { return &x.unknownFields },
+		table.Optional(1, table.Int32, func(x *Optionals) **int32 { return &x.Int32 }),
+		table.Optional(2, table.Sint64, func(x *Optionals) **int64 { return &x.Sint64 }),
+		table.Optional(3, table.Double, func(x *Optionals) **float64 { return &x.Double }),
+		table.Optional(4, table.String, func(x *Optionals) **string { return &x.String_ }),
+		table.OptionalBytes(5, func(x *Optionals) *[]byte { return &x.Bytes }),
+		table.Optional(6, table.Enum[Kind](), func(x *Optionals) **Kind { return &x.Kind }),
+		table.Message(7, func(x *Optionals) **Scalars { return &x.Scalars }),
+	).Methods()
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Optionals)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		if x.Scalars != nil {
+			if err := proto.CheckInitialized(x.Scalars); err != nil {
+				return output, err
+			}
+		}
+		return output, nil
+	}
+	fastReflection_OptionalsProtoMethods.CheckInitialized = checkInitialized
+}
+
+var (
+	md_Everything              protoreflect.MessageDescriptor
+	fd_Everything_scalars      protoreflect.FieldDescriptor
+	fd_Everything_repeated     protoreflect.FieldDescriptor
+	fd_Everything_maps         protoreflect.FieldDescriptor
+	fd_Everything_oneofs       protoreflect.FieldDescriptor
+	fd_Everything_children     protoreflect.FieldDescriptor
+	fd_Everything_optionals    protoreflect.FieldDescriptor
+	fd_Everything_required     protoreflect.FieldDescriptor
+	fd_Everything_large_number protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_internal_testprotos_tabletest_table_proto_init()
+	md_Everything = File_internal_testprotos_tabletest_table_proto.Messages().ByName("Everything")
+	fd_Everything_scalars = md_Everything.Fields().ByName("scalars")
+	fd_Everything_repeated = md_Everything.Fields().ByName("repeated")
+	fd_Everything_maps = md_Everything.Fields().ByName("maps")
+	fd_Everything_oneofs = md_Everything.Fields().ByName("oneofs")
+	fd_Everything_children = md_Everything.Fields().ByName("children")
+	fd_Everything_optionals = md_Everything.Fields().ByName("optionals")
+	fd_Everything_required = md_Everything.Fields().ByName("required")
+	fd_Everything_large_number = md_Everything.Fields().ByName("large_number")
+}
+
+var _ protoreflect.Message = (*fastReflection_Everything)(nil)
+
+type fastReflection_Everything Everything
+
+func (x *Everything) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Everything)(x)
+}
+
+func (x *Everything) slowProtoReflect() protoreflect.Message {
+	mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Everything_messageType fastReflection_Everything_messageType
+var _ protoreflect.MessageType = fastReflection_Everything_messageType{}
+
+type fastReflection_Everything_messageType struct{}
+
+func (x fastReflection_Everything_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Everything)(nil)
+}
+func (x fastReflection_Everything_messageType) New() protoreflect.Message {
+	return new(fastReflection_Everything)
+}
+func (x fastReflection_Everything_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Everything
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Everything) Descriptor() protoreflect.MessageDescriptor {
+	return md_Everything
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Everything) Type() protoreflect.MessageType {
+	return _fastReflection_Everything_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Everything) New() protoreflect.Message {
+	return new(fastReflection_Everything)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Everything) Interface() protoreflect.ProtoMessage {
+	return (*Everything)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Everything) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Scalars != nil {
+		value := protoreflect.ValueOfMessage(x.Scalars.ProtoReflect())
+		if !f(fd_Everything_scalars, value) {
+			return
+		}
+	}
+	if x.Repeated != nil {
+		value := protoreflect.ValueOfMessage(x.Repeated.ProtoReflect())
+		if !f(fd_Everything_repeated, value) {
+			return
+		}
+	}
+	if x.Maps != nil {
+		value := protoreflect.ValueOfMessage(x.Maps.ProtoReflect())
+		if !f(fd_Everything_maps, value) {
+			return
+		}
+	}
+	if x.Oneofs != nil {
+		value := protoreflect.ValueOfMessage(x.Oneofs.ProtoReflect())
+		if !f(fd_Everything_oneofs, value) {
+			return
+		}
+	}
+	if len(x.Children) != 0 {
+		value := protoreflect.ValueOfList(runtime.NewList[*Everything, runtime.MessageValue[Everything, *Everything]](&x.Children))
+		if !f(fd_Everything_children, value) {
+			return
+		}
+	}
+	if x.Optionals != nil {
+		value := protoreflect.ValueOfMessage(x.Optionals.ProtoReflect())
+		if !f(fd_Everything_optionals, value) {
+			return
+		}
+	}
+	if x.Required != nil {
+		value := protoreflect.ValueOfMessage(x.Required.ProtoReflect())
+		if !f(fd_Everything_required, value) {
+			return
+		}
+	}
+	if x.LargeNumber != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LargeNumber)
+		if !f(fd_Everything_large_number, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Everything) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "tabletest.Everything.scalars":
+		return x.Scalars != nil
+	case "tabletest.Everything.repeated":
+		return x.Repeated != nil
+	case "tabletest.Everything.maps":
+		return x.Maps != nil
+	case "tabletest.Everything.oneofs":
+		return x.Oneofs != nil
+	case "tabletest.Everything.children":
+		return len(x.Children) != 0
+	case "tabletest.Everything.optionals":
+		return x.Optionals != nil
+	case "tabletest.Everything.required":
+		return x.Required != nil
+	case "tabletest.Everything.large_number":
+		return x.LargeNumber != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Everything"))
+		}
+		panic(fmt.Errorf("message tabletest.Everything does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Everything) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "tabletest.Everything.scalars":
+		x.Scalars = nil
+	case "tabletest.Everything.repeated":
+		x.Repeated = nil
+	case "tabletest.Everything.maps":
+		x.Maps = nil
+	case "tabletest.Everything.oneofs":
+		x.Oneofs = nil
+	case "tabletest.Everything.children":
+		x.Children = nil
+	case "tabletest.Everything.optionals":
+		x.Optionals = nil
+	case "tabletest.Everything.required":
+		x.Required = nil
+	case "tabletest.Everything.large_number":
+		x.LargeNumber = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Everything"))
+		}
+		panic(fmt.Errorf("message tabletest.Everything does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Everything) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "tabletest.Everything.scalars":
+		value := x.Scalars
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Everything.repeated":
+		value := x.Repeated
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Everything.maps":
+		value := x.Maps
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Everything.oneofs":
+		value := x.Oneofs
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Everything.children":
+		if len(x.Children) == 0 {
+			return protoreflect.ValueOfList(runtime.NewList[*Everything, runtime.MessageValue[Everything, *Everything]](nil))
+		}
+		listValue := runtime.NewList[*Everything, runtime.MessageValue[Everything, *Everything]](&x.Children)
+		return protoreflect.ValueOfList(listValue)
+	case "tabletest.Everything.optionals":
+		value := x.Optionals
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Everything.required":
+		value := x.Required
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "tabletest.Everything.large_number":
+		value := x.LargeNumber
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Everything"))
+		}
+		panic(fmt.Errorf("message tabletest.Everything does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Everything) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "tabletest.Everything.scalars":
+		x.Scalars = value.Message().Interface().(*Scalars)
+	case "tabletest.Everything.repeated":
+		x.Repeated = value.Message().Interface().(*Repeated)
+	case "tabletest.Everything.maps":
+		x.Maps = value.Message().Interface().(*Maps)
+	case "tabletest.Everything.oneofs":
+		x.Oneofs = value.Message().Interface().(*Oneofs)
+	case "tabletest.Everything.children":
+		lv := value.List()
+		clv := lv.(*runtime.List[*Everything, runtime.MessageValue[Everything, *Everything]])
+		x.Children = clv.Slice()
+	case "tabletest.Everything.optionals":
+		x.Optionals = value.Message().Interface().(*Optionals)
+	case "tabletest.Everything.required":
+		x.Required = value.Message().Interface().(*presencetest.Required)
+	case "tabletest.Everything.large_number":
+		x.LargeNumber = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Everything"))
+		}
+		panic(fmt.Errorf("message tabletest.Everything does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Everything) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Everything.scalars":
+		if x.Scalars == nil {
+			x.Scalars = new(Scalars)
+		}
+		return protoreflect.ValueOfMessage(x.Scalars.ProtoReflect())
+	case "tabletest.Everything.repeated":
+		if x.Repeated == nil {
+			x.Repeated = new(Repeated)
+		}
+		return protoreflect.ValueOfMessage(x.Repeated.ProtoReflect())
+	case "tabletest.Everything.maps":
+		if x.Maps == nil {
+			x.Maps = new(Maps)
+		}
+		return protoreflect.ValueOfMessage(x.Maps.ProtoReflect())
+	case "tabletest.Everything.oneofs":
+		if x.Oneofs == nil {
+			x.Oneofs = new(Oneofs)
+		}
+		return protoreflect.ValueOfMessage(x.Oneofs.ProtoReflect())
+	case "tabletest.Everything.children":
+		if x.Children == nil {
+			x.Children = []*Everything{}
+		}
+		value := runtime.NewList[*Everything, runtime.MessageValue[Everything, *Everything]](&x.Children)
+		return protoreflect.ValueOfList(value)
+	case "tabletest.Everything.optionals":
+		if x.Optionals == nil {
+			x.Optionals = new(Optionals)
+		}
+		return protoreflect.ValueOfMessage(x.Optionals.ProtoReflect())
+	case "tabletest.Everything.required":
+		if x.Required == nil {
+			x.Required = new(presencetest.Required)
+		}
+		return protoreflect.ValueOfMessage(x.Required.ProtoReflect())
+	case "tabletest.Everything.large_number":
+		panic(fmt.Errorf("field large_number of message tabletest.Everything is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Everything"))
+		}
+		panic(fmt.Errorf("message tabletest.Everything does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Everything) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "tabletest.Everything.scalars":
+		m := new(Scalars)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "tabletest.Everything.repeated":
+		m := new(Repeated)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "tabletest.Everything.maps":
+		m := new(Maps)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "tabletest.Everything.oneofs":
+		m := new(Oneofs)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "tabletest.Everything.children":
+		list := []*Everything{}
+		return protoreflect.ValueOfList(runtime.NewList[*Everything, runtime.MessageValue[Everything, *Everything]](&list))
+	case "tabletest.Everything.optionals":
+		m := new(Optionals)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "tabletest.Everything.required":
+		m := new(presencetest.Required)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "tabletest.Everything.large_number":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: tabletest.Everything"))
+		}
+		panic(fmt.Errorf("message tabletest.Everything does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Everything) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in tabletest.Everything", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Everything) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Everything) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Everything) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_Everything) ProtoMethods() *protoiface.Methods {
+	return fastReflection_EverythingProtoMethods
+}
+
+var fastReflection_EverythingProtoMethods *protoiface.Methods
+
+func init() {
+	fastReflection_EverythingProtoMethods = table.New(func(x *Everything) *[]byte { return &x.unknownFields },
+		table.Message(1, func(x *Everything) **Scalars { return &x.Scalars }),
+		table.Message(2, func(x *Everything) **Repeated { return &x.Repeated }),
+		table.Message(3, func(x *Everything) **Maps { return &x.Maps }),
+		table.Message(4, func(x *Everything) **Oneofs { return &x.Oneofs }),
+		table.RepeatedMessage(5, func(x *Everything) *[]*Everything { return &x.Children }),
+		table.Message(6, func(x *Everything) **Optionals { return &x.Optionals }),
+		table.Message(7, func(x *Everything) **presencetest.Required { return &x.Required }),
+		table.Scalar(1000, table.Uint32, func(x *Everything) *uint32 { return &x.LargeNumber }),
+	).Methods()
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		x := input.Message.Interface().(*Everything)
+		output := protoiface.CheckInitializedOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+		if x == nil {
+			return output, nil
+		}
+		if x.Scalars != nil {
+			if err := proto.CheckInitialized(x.Scalars); err != nil {
+				return output, err
+			}
+		}
+		if x.Repeated != nil {
+			if err := proto.CheckInitialized(x.Repeated); err != nil {
+				return output, err
+			}
+		}
+		if x.Maps != nil {
+			if err := proto.CheckInitialized(x.Maps); err != nil {
+				return output, err
+			}
+		}
+		if x.Oneofs != nil {
+			if err := proto.CheckInitialized(x.Oneofs); err != nil {
+				return output, err
+			}
+		}
+		for _, v := range x.Children {
+			if err := proto.CheckInitialized(v); err != nil {
+				return output, err
+			}
+		}
+		if x.Optionals != nil {
+			if err := proto.CheckInitialized(x.Optionals); err != nil {
+				return output, err
+			}
+		}
+		if x.Required != nil {
+			if err := proto.CheckInitialized(x.Required); err != nil {
+				return output, err
+			}
+		}
+		return output, nil
+	}
+	fastReflection_EverythingProtoMethods.CheckInitialized = checkInitialized
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: internal/testprotos/tabletest/table.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED Kind = 0
+	Kind_KIND_A           Kind = 1
+	Kind_KIND_B           Kind = 2
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_A",
+		2: "KIND_B",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_A":           1,
+		"KIND_B":           2,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testprotos_tabletest_table_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_internal_testprotos_tabletest_table_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{0}
+}
+
+type Scalars struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bool     bool           `protobuf:"varint,1,opt,name=bool,proto3" json:"bool,omitempty"`
+	Int32    int32          `protobuf:"varint,2,opt,name=int32,proto3" json:"int32,omitempty"`
+	Sint32   int32          `protobuf:"zigzag32,3,opt,name=sint32,proto3" json:"sint32,omitempty"`
+	Uint32   uint32         `protobuf:"varint,4,opt,name=uint32,proto3" json:"uint32,omitempty"`
+	Int64    int64          `protobuf:"varint,5,opt,name=int64,proto3" json:"int64,omitempty"`
+	Sint64   int64          `protobuf:"zigzag64,6,opt,name=sint64,proto3" json:"sint64,omitempty"`
+	Uint64   uint64         `protobuf:"varint,7,opt,name=uint64,proto3" json:"uint64,omitempty"`
+	Sfixed32 int32          `protobuf:"fixed32,8,opt,name=sfixed32,proto3" json:"sfixed32,omitempty"`
+	Fixed32  uint32         `protobuf:"fixed32,9,opt,name=fixed32,proto3" json:"fixed32,omitempty"`
+	Float    float32        `protobuf:"fixed32,10,opt,name=float,proto3" json:"float,omitempty"`
+	Sfixed64 int64          `protobuf:"fixed64,11,opt,name=sfixed64,proto3" json:"sfixed64,omitempty"`
+	Fixed64  uint64         `protobuf:"fixed64,12,opt,name=fixed64,proto3" json:"fixed64,omitempty"`
+	Double   float64        `protobuf:"fixed64,13,opt,name=double,proto3" json:"double,omitempty"`
+	String_  string         `protobuf:"bytes,14,opt,name=string,proto3" json:"string,omitempty"`
+	Bytes    []byte         `protobuf:"bytes,15,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Kind     Kind           `protobuf:"varint,16,opt,name=kind,proto3,enum=tabletest.Kind" json:"kind,omitempty"`
+	Amount   cosmostest.Int `protobuf:"bytes,17,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Scalars) Reset() {
+	*x = Scalars{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scalars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scalars) ProtoMessage() {}
+
+// Deprecated: Use Scalars.ProtoReflect.Descriptor instead.
+func (*Scalars) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Scalars) GetBool() bool {
+	if x != nil {
+		return x.Bool
+	}
+	return false
+}
+
+func (x *Scalars) GetInt32() int32 {
+	if x != nil {
+		return x.Int32
+	}
+	return 0
+}
+
+func (x *Scalars) GetSint32() int32 {
+	if x != nil {
+		return x.Sint32
+	}
+	return 0
+}
+
+func (x *Scalars) GetUint32() uint32 {
+	if x != nil {
+		return x.Uint32
+	}
+	return 0
+}
+
+func (x *Scalars) GetInt64() int64 {
+	if x != nil {
+		return x.Int64
+	}
+	return 0
+}
+
+func (x *Scalars) GetSint64() int64 {
+	if x != nil {
+		return x.Sint64
+	}
+	return 0
+}
+
+func (x *Scalars) GetUint64() uint64 {
+	if x != nil {
+		return x.Uint64
+	}
+	return 0
+}
+
+func (x *Scalars) GetSfixed32() int32 {
+	if x != nil {
+		return x.Sfixed32
+	}
+	return 0
+}
+
+func (x *Scalars) GetFixed32() uint32 {
+	if x != nil {
+		return x.Fixed32
+	}
+	return 0
+}
+
+func (x *Scalars) GetFloat() float32 {
+	if x != nil {
+		return x.Float
+	}
+	return 0
+}
+
+func (x *Scalars) GetSfixed64() int64 {
+	if x != nil {
+		return x.Sfixed64
+	}
+	return 0
+}
+
+func (x *Scalars) GetFixed64() uint64 {
+	if x != nil {
+		return x.Fixed64
+	}
+	return 0
+}
+
+func (x *Scalars) GetDouble() float64 {
+	if x != nil {
+		return x.Double
+	}
+	return 0
+}
+
+func (x *Scalars) GetString_() string {
+	if x != nil {
+		return x.String_
+	}
+	return ""
+}
+
+func (x *Scalars) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Scalars) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *Scalars) GetAmount() cosmostest.Int {
+	var v cosmostest.Int
+	if x != nil {
+		v = x.Amount
+	}
+	return v
+}
+
+type Repeated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32    []int32    `protobuf:"varint,1,rep,packed,name=int32,proto3" json:"int32,omitempty"`
+	Sint64   []int64    `protobuf:"zigzag64,2,rep,packed,name=sint64,proto3" json:"sint64,omitempty"`
+	Fixed32  []uint32   `protobuf:"fixed32,3,rep,packed,name=fixed32,proto3" json:"fixed32,omitempty"`
+	Double   []float64  `protobuf:"fixed64,4,rep,packed,name=double,proto3" json:"double,omitempty"`
+	Bool     []bool     `protobuf:"varint,5,rep,packed,name=bool,proto3" json:"bool,omitempty"`
+	Kind     []Kind     `protobuf:"varint,6,rep,packed,name=kind,proto3,enum=tabletest.Kind" json:"kind,omitempty"`
+	Unpacked []uint64   `protobuf:"varint,7,rep,name=unpacked,proto3" json:"unpacked,omitempty"`
+	String_  []string   `protobuf:"bytes,8,rep,name=string,proto3" json:"string,omitempty"`
+	Bytes    [][]byte   `protobuf:"bytes,9,rep,name=bytes,proto3" json:"bytes,omitempty"`
+	Scalars  []*Scalars `protobuf:"bytes,10,rep,name=scalars,proto3" json:"scalars,omitempty"`
+}
+
+func (x *Repeated) Reset() {
+	*x = Repeated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repeated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repeated) ProtoMessage() {}
+
+// Deprecated: Use Repeated.ProtoReflect.Descriptor instead.
+func (*Repeated) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Repeated) GetInt32() []int32 {
+	if x != nil {
+		return x.Int32
+	}
+	return nil
+}
+
+func (x *Repeated) GetSint64() []int64 {
+	if x != nil {
+		return x.Sint64
+	}
+	return nil
+}
+
+func (x *Repeated) GetFixed32() []uint32 {
+	if x != nil {
+		return x.Fixed32
+	}
+	return nil
+}
+
+func (x *Repeated) GetDouble() []float64 {
+	if x != nil {
+		return x.Double
+	}
+	return nil
+}
+
+func (x *Repeated) GetBool() []bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *Repeated) GetKind() []Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Repeated) GetUnpacked() []uint64 {
+	if x != nil {
+		return x.Unpacked
+	}
+	return nil
+}
+
+func (x *Repeated) GetString_() []string {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Repeated) GetBytes() [][]byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Repeated) GetScalars() []*Scalars {
+	if x != nil {
+		return x.Scalars
+	}
+	return nil
+}
+
+type Maps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StringString  map[string]string   `protobuf:"bytes,1,rep,name=string_string,json=stringString,proto3" json:"string_string,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Int32Int64    map[int32]int64     `protobuf:"bytes,2,rep,name=int32_int64,json=int32Int64,proto3" json:"int32_int64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	BoolBytes     map[bool][]byte     `protobuf:"bytes,3,rep,name=bool_bytes,json=boolBytes,proto3" json:"bool_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Uint64Scalars map[uint64]*Scalars `protobuf:"bytes,4,rep,name=uint64_scalars,json=uint64Scalars,proto3" json:"uint64_scalars,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sint32Kind    map[int32]Kind      `protobuf:"bytes,5,rep,name=sint32_kind,json=sint32Kind,proto3" json:"sint32_kind,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=tabletest.Kind"`
+	Fixed64Double map[uint64]float64  `protobuf:"bytes,6,rep,name=fixed64_double,json=fixed64Double,proto3" json:"fixed64_double,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *Maps) Reset() {
+	*x = Maps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maps) ProtoMessage() {}
+
+// Deprecated: Use Maps.ProtoReflect.Descriptor instead.
+func (*Maps) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Maps) GetStringString() map[string]string {
+	if x != nil {
+		return x.StringString
+	}
+	return nil
+}
+
+func (x *Maps) GetInt32Int64() map[int32]int64 {
+	if x != nil {
+		return x.Int32Int64
+	}
+	return nil
+}
+
+func (x *Maps) GetBoolBytes() map[bool][]byte {
+	if x != nil {
+		return x.BoolBytes
+	}
+	return nil
+}
+
+func (x *Maps) GetUint64Scalars() map[uint64]*Scalars {
+	if x != nil {
+		return x.Uint64Scalars
+	}
+	return nil
+}
+
+func (x *Maps) GetSint32Kind() map[int32]Kind {
+	if x != nil {
+		return x.Sint32Kind
+	}
+	return nil
+}
+
+func (x *Maps) GetFixed64Double() map[uint64]float64 {
+	if x != nil {
+		return x.Fixed64Double
+	}
+	return nil
+}
+
+type Oneofs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*Oneofs_Text
+	//	*Oneofs_Number
+	//	*Oneofs_Scalars
+	//	*Oneofs_Data
+	//	*Oneofs_Kind
+	Value isOneofs_Value `protobuf_oneof:"value"`
+	// Types that are assignable to Other:
+	//	*Oneofs_Flag
+	Other isOneofs_Other `protobuf_oneof:"other"`
+	Plain uint32         `protobuf:"varint,7,opt,name=plain,proto3" json:"plain,omitempty"`
+}
+
+func (x *Oneofs) Reset() {
+	*x = Oneofs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Oneofs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Oneofs) ProtoMessage() {}
+
+// Deprecated: Use Oneofs.ProtoReflect.Descriptor instead.
+func (*Oneofs) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Oneofs) GetValue() isOneofs_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Oneofs) GetText() string {
+	if x, ok := x.GetValue().(*Oneofs_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Oneofs) GetNumber() int64 {
+	if x, ok := x.GetValue().(*Oneofs_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Oneofs) GetScalars() *Scalars {
+	if x, ok := x.GetValue().(*Oneofs_Scalars); ok {
+		return x.Scalars
+	}
+	return nil
+}
+
+func (x *Oneofs) GetData() []byte {
+	if x, ok := x.GetValue().(*Oneofs_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Oneofs) GetKind() Kind {
+	if x, ok := x.GetValue().(*Oneofs_Kind); ok {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *Oneofs) GetOther() isOneofs_Other {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *Oneofs) GetFlag() bool {
+	if x, ok := x.GetOther().(*Oneofs_Flag); ok {
+		return x.Flag
+	}
+	return false
+}
+
+func (x *Oneofs) GetPlain() uint32 {
+	if x != nil {
+		return x.Plain
+	}
+	return 0
+}
+
+type isOneofs_Value interface {
+	isOneofs_Value()
+}
+
+type Oneofs_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type Oneofs_Number struct {
+	Number int64 `protobuf:"varint,2,opt,name=number,proto3,oneof"`
+}
+
+type Oneofs_Scalars struct {
+	Scalars *Scalars `protobuf:"bytes,3,opt,name=scalars,proto3,oneof"`
+}
+
+type Oneofs_Data struct {
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3,oneof"`
+}
+
+type Oneofs_Kind struct {
+	Kind Kind `protobuf:"varint,5,opt,name=kind,proto3,enum=tabletest.Kind,oneof"`
+}
+
+func (*Oneofs_Text) isOneofs_Value() {}
+
+func (*Oneofs_Number) isOneofs_Value() {}
+
+func (*Oneofs_Scalars) isOneofs_Value() {}
+
+func (*Oneofs_Data) isOneofs_Value() {}
+
+func (*Oneofs_Kind) isOneofs_Value() {}
+
+type isOneofs_Other interface {
+	isOneofs_Other()
+}
+
+type Oneofs_Flag struct {
+	Flag bool `protobuf:"varint,6,opt,name=flag,proto3,oneof"`
+}
+
+func (*Oneofs_Flag) isOneofs_Other() {}
+
+type Optionals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32   *int32   `protobuf:"varint,1,opt,name=int32,proto3,oneof" json:"int32,omitempty"`
+	Sint64  *int64   `protobuf:"zigzag64,2,opt,name=sint64,proto3,oneof" json:"sint64,omitempty"`
+	Double  *float64 `protobuf:"fixed64,3,opt,name=double,proto3,oneof" json:"double,omitempty"`
+	String_ *string  `protobuf:"bytes,4,opt,name=string,proto3,oneof" json:"string,omitempty"`
+	Bytes   []byte   `protobuf:"bytes,5,opt,name=bytes,proto3,oneof" json:"bytes,omitempty"`
+	Kind    *Kind    `protobuf:"varint,6,opt,name=kind,proto3,enum=tabletest.Kind,oneof" json:"kind,omitempty"`
+	Scalars *Scalars `protobuf:"bytes,7,opt,name=scalars,proto3,oneof" json:"scalars,omitempty"`
+}
+
+func (x *Optionals) Reset() {
+	*x = Optionals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Optionals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Optionals) ProtoMessage() {}
+
+// Deprecated: Use Optionals.ProtoReflect.Descriptor instead.
+func (*Optionals) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Optionals) GetInt32() int32 {
+	if x != nil && x.Int32 != nil {
+		return *x.Int32
+	}
+	return 0
+}
+
+func (x *Optionals) GetSint64() int64 {
+	if x != nil && x.Sint64 != nil {
+		return *x.Sint64
+	}
+	return 0
+}
+
+func (x *Optionals) GetDouble() float64 {
+	if x != nil && x.Double != nil {
+		return *x.Double
+	}
+	return 0
+}
+
+func (x *Optionals) GetString_() string {
+	if x != nil && x.String_ != nil {
+		return *x.String_
+	}
+	return ""
+}
+
+func (x *Optionals) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Optionals) GetKind() Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *Optionals) GetScalars() *Scalars {
+	if x != nil {
+		return x.Scalars
+	}
+	return nil
+}
+
+type Everything struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scalars   *Scalars      `protobuf:"bytes,1,opt,name=scalars,proto3" json:"scalars,omitempty"`
+	Repeated  *Repeated     `protobuf:"bytes,2,opt,name=repeated,proto3" json:"repeated,omitempty"`
+	Maps      *Maps         `protobuf:"bytes,3,opt,name=maps,proto3" json:"maps,omitempty"`
+	Oneofs    *Oneofs       `protobuf:"bytes,4,opt,name=oneofs,proto3" json:"oneofs,omitempty"`
+	Children  []*Everything `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	Optionals *Optionals    `protobuf:"bytes,6,opt,name=optionals,proto3" json:"optionals,omitempty"`
+	// the messages with required fields are checked
+	Required    *presencetest.Required `protobuf:"bytes,7,opt,name=required,proto3" json:"required,omitempty"`
+	LargeNumber uint32                 `protobuf:"varint,1000,opt,name=large_number,json=largeNumber,proto3" json:"large_number,omitempty"`
+}
+
+func (x *Everything) Reset() {
+	*x = Everything{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testprotos_tabletest_table_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Everything) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Everything) ProtoMessage() {}
+
+// Deprecated: Use Everything.ProtoReflect.Descriptor instead.
+func (*Everything) Descriptor() ([]byte, []int) {
+	return file_internal_testprotos_tabletest_table_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Everything) GetScalars() *Scalars {
+	if x != nil {
+		return x.Scalars
+	}
+	return nil
+}
+
+func (x *Everything) GetRepeated() *Repeated {
+	if x != nil {
+		return x.Repeated
+	}
+	return nil
+}
+
+func (x *Everything) GetMaps() *Maps {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *Everything) GetOneofs() *Oneofs {
+	if x != nil {
+		return x.Oneofs
+	}
+	return nil
+}
+
+func (x *Everything) GetChildren() []*Everything {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Everything) GetOptionals() *Optionals {
+	if x != nil {
+		return x.Optionals
+	}
+	return nil
+}
+
+func (x *Everything) GetRequired() *presencetest.Required {
+	if x != nil {
+		return x.Required
+	}
+	return nil
+}
+
+func (x *Everything) GetLargeNumber() uint32 {
+	if x != nil {
+		return x.LargeNumber
+	}
+	return 0
+}
+
+var File_internal_testprotos_tabletest_table_proto protoreflect.FileDescriptor
+
+var file_internal_testprotos_tabletest_table_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x74,
+	0x65, 0x73, 0x74, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xc1, 0x03, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x12, 0x52, 0x06,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0f,
+	0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x10, 0x52, 0x08, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xd2, 0xb4, 0x2d,
+	0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x03, 0x28, 0x12, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x07, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x08,
+	0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02,
+	0x10, 0x00, 0x52, 0x08, 0x75, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x52,
+	0x07, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x22, 0xcd, 0x06, 0x0a, 0x04, 0x4d, 0x61, 0x70,
+	0x73, 0x12, 0x46, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a, 0x0b, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x3d, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x73,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x61, 0x70, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x63,
+	0x61, 0x6c, 0x61, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x4b, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x49, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x73,
+	0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x54, 0x0a, 0x12, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x0f, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x4b, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe3, 0x01, 0x0a, 0x06, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x73, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x22, 0xbf,
+	0x02, 0x0a, 0x09, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x05,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x48, 0x01, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x04, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x48, 0x05, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x48, 0x06, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6b, 0x69, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73,
+	0x22, 0xfa, 0x02, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x2c, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x73, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x12, 0x2f, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x52, 0x04, 0x6d,
+	0x61, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x52, 0x06, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x12, 0x31,
+	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65,
+	0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x12, 0x32, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x72,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2a, 0x34, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x42, 0x10, 0x02, 0x42, 0x99, 0x01, 0xf2, 0x9b, 0x83, 0x03, 0x56, 0x0a, 0x03, 0x49, 0x6e, 0x74,
+	0x12, 0x4c, 0x49, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x72, 0x62, 0x69,
+	0x74, 0x72, 0x61, 0x72, 0x79, 0x2d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20,
+	0x61, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x2d, 0x31, 0x30, 0x20, 0x72,
+	0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x1a, 0x01,
+	0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_testprotos_tabletest_table_proto_rawDescOnce sync.Once
+	file_internal_testprotos_tabletest_table_proto_rawDescData = file_internal_testprotos_tabletest_table_proto_rawDesc
+)
+
+func file_internal_testprotos_tabletest_table_proto_rawDescGZIP() []byte {
+	file_internal_testprotos_tabletest_table_proto_rawDescOnce.Do(func() {
+		file_internal_testprotos_tabletest_table_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testprotos_tabletest_table_proto_rawDescData)
+	})
+	return file_internal_testprotos_tabletest_table_proto_rawDescData
+}
+
+var file_internal_testprotos_tabletest_table_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_testprotos_tabletest_table_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_testprotos_tabletest_table_proto_goTypes = []interface{}{
+	(Kind)(0),                     // 0: tabletest.Kind
+	(*Scalars)(nil),               // 1: tabletest.Scalars
+	(*Repeated)(nil),              // 2: tabletest.Repeated
+	(*Maps)(nil),                  // 3: tabletest.Maps
+	(*Oneofs)(nil),                // 4: tabletest.Oneofs
+	(*Optionals)(nil),             // 5: tabletest.Optionals
+	(*Everything)(nil),            // 6: tabletest.Everything
+	nil,                           // 7: tabletest.Maps.StringStringEntry
+	nil,                           // 8: tabletest.Maps.Int32Int64Entry
+	nil,                           // 9: tabletest.Maps.BoolBytesEntry
+	nil,                           // 10: tabletest.Maps.Uint64ScalarsEntry
+	nil,                           // 11: tabletest.Maps.Sint32KindEntry
+	nil,                           // 12: tabletest.Maps.Fixed64DoubleEntry
+	(*presencetest.Required)(nil), // 13: presencetest.Required
+}
+var file_internal_testprotos_tabletest_table_proto_depIdxs = []int32{
+	0,  // 0: tabletest.Scalars.kind:type_name -> tabletest.Kind
+	0,  // 1: tabletest.Repeated.kind:type_name -> tabletest.Kind
+	1,  // 2: tabletest.Repeated.scalars:type_name -> tabletest.Scalars
+	7,  // 3: tabletest.Maps.string_string:type_name -> tabletest.Maps.StringStringEntry
+	8,  // 4: tabletest.Maps.int32_int64:type_name -> tabletest.Maps.Int32Int64Entry
+	9,  // 5: tabletest.Maps.bool_bytes:type_name -> tabletest.Maps.BoolBytesEntry
+	10, // 6: tabletest.Maps.uint64_scalars:type_name -> tabletest.Maps.Uint64ScalarsEntry
+	11, // 7: tabletest.Maps.sint32_kind:type_name -> tabletest.Maps.Sint32KindEntry
+	12, // 8: tabletest.Maps.fixed64_double:type_name -> tabletest.Maps.Fixed64DoubleEntry
+	1,  // 9: tabletest.Oneofs.scalars:type_name -> tabletest.Scalars
+	0,  // 10: tabletest.Oneofs.kind:type_name -> tabletest.Kind
+	0,  // 11: tabletest.Optionals.kind:type_name -> tabletest.Kind
+	1,  // 12: tabletest.Optionals.scalars:type_name -> tabletest.Scalars
+	1,  // 13: tabletest.Everything.scalars:type_name -> tabletest.Scalars
+	2,  // 14: tabletest.Everything.repeated:type_name -> tabletest.Repeated
+	3,  // 15: tabletest.Everything.maps:type_name -> tabletest.Maps
+	4,  // 16: tabletest.Everything.oneofs:type_name -> tabletest.Oneofs
+	6,  // 17: tabletest.Everything.children:type_name -> tabletest.Everything
+	5,  // 18: tabletest.Everything.optionals:type_name -> tabletest.Optionals
+	13, // 19: tabletest.Everything.required:type_name -> presencetest.Required
+	1,  // 20: tabletest.Maps.Uint64ScalarsEntry.value:type_name -> tabletest.Scalars
+	0,  // 21: tabletest.Maps.Sint32KindEntry.value:type_name -> tabletest.Kind
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_internal_testprotos_tabletest_table_proto_init() }
+func file_internal_testprotos_tabletest_table_proto_init() {
+	if File_internal_testprotos_tabletest_table_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testprotos_tabletest_table_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scalars); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_tabletest_table_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repeated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_tabletest_table_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Maps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_tabletest_table_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Oneofs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_tabletest_table_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Optionals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testprotos_tabletest_table_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Everything); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testprotos_tabletest_table_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Oneofs_Text)(nil),
+		(*Oneofs_Number)(nil),
+		(*Oneofs_Scalars)(nil),
+		(*Oneofs_Data)(nil),
+		(*Oneofs_Kind)(nil),
+		(*Oneofs_Flag)(nil),
+	}
+	file_internal_testprotos_tabletest_table_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testprotos_tabletest_table_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testprotos_tabletest_table_proto_goTypes,
+		DependencyIndexes: file_internal_testprotos_tabletest_table_proto_depIdxs,
+		EnumInfos:         file_internal_testprotos_tabletest_table_proto_enumTypes,
+		MessageInfos:      file_internal_testprotos_tabletest_table_proto_msgTypes,
+	}.Build()
+	File_internal_testprotos_tabletest_table_proto = out.File
+	file_internal_testprotos_tabletest_table_proto_rawDesc = nil
+	file_internal_testprotos_tabletest_table_proto_goTypes = nil
+	file_internal_testprotos_tabletest_table_proto_depIdxs = nil
+}
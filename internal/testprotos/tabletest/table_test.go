@@ -0,0 +1,221 @@
+package tabletest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/internal/testprotos/presencetest"
+	"github.com/cosmos/cosmos-proto/internal/testprotos/tabletest/unrolled"
+)
+
+func newScalars(i int) *Scalars {
+	return &Scalars{
+		Bool:     true,
+		Int32:    -int32(i),
+		Sint32:   -int32(i) * 1000,
+		Uint32:   uint32(i),
+		Int64:    -int64(i) << 40,
+		Sint64:   -int64(i) << 20,
+		Uint64:   uint64(i) << 50,
+		Sfixed32: -int32(i),
+		Fixed32:  uint32(i),
+		Float:    float32(math.Copysign(0, -1)),
+		Sfixed64: -int64(i),
+		Fixed64:  uint64(i),
+		Double:   float64(i) / 3,
+		String_:  "string",
+		Bytes:    []byte{byte(i), 1, 2},
+		Kind:     Kind_KIND_B,
+		Amount:   cosmostest.NewInt(int64(i) * 1000000),
+	}
+}
+
+func newEverything(children int) *Everything {
+	msg := &Everything{
+		Scalars: newScalars(1),
+		Repeated: &Repeated{
+			Int32:    []int32{-1, 0, 1, math.MaxInt32},
+			Sint64:   []int64{-1, 0, 1, math.MinInt64},
+			Fixed32:  []uint32{1, 2, 3},
+			Double:   []float64{1.5, math.Inf(1)},
+			Bool:     []bool{true, false, true},
+			Kind:     []Kind{Kind_KIND_A, Kind_KIND_B},
+			Unpacked: []uint64{1, 1 << 60},
+			String_:  []string{"a", "", "c"},
+			Bytes:    [][]byte{{1}, {}, {2, 3}},
+			Scalars:  []*Scalars{newScalars(2), {}, newScalars(3)},
+		},
+		Maps: &Maps{
+			StringString:  map[string]string{"a": "b", "": "empty", "c": ""},
+			Int32Int64:    map[int32]int64{-1: 1, 0: 0, 1: -1},
+			BoolBytes:     map[bool][]byte{true: {1}, false: {}},
+			Uint64Scalars: map[uint64]*Scalars{1: newScalars(4), 2: {}},
+			Sint32Kind:    map[int32]Kind{-5: Kind_KIND_A, 5: Kind_KIND_UNSPECIFIED},
+			Fixed64Double: map[uint64]float64{7: 0.25},
+		},
+		Oneofs: &Oneofs{Value: &Oneofs_Scalars{Scalars: newScalars(5)}, Other: &Oneofs_Flag{}, Plain: 3},
+		Optionals: &Optionals{
+			Int32:   proto.Int32(-1),
+			Sint64:  proto.Int64(-1 << 40),
+			Double:  proto.Float64(0.5),
+			String_: proto.String("string"),
+			Bytes:   []byte{1},
+			Kind:    Kind_KIND_B.Enum(),
+			Scalars: newScalars(6),
+		},
+		Required:    &presencetest.Required{Id: proto.Int32(1), Name: proto.String("name")},
+		LargeNumber: 42,
+	}
+	for i := 0; i < children; i++ {
+		child := newEverything(0)
+		child.Oneofs.Value = &Oneofs_Kind{Kind: Kind(i % 3)}
+		msg.Children = append(msg.Children, child)
+	}
+	return msg
+}
+
+var deterministic = proto.MarshalOptions{Deterministic: true}
+
+// TestSameEncoding checks that the table codec encodes the messages as the unrolled codec.
+func TestSameEncoding(t *testing.T) {
+	msgs := map[string]*Everything{
+		"empty":       {},
+		"everything":  newEverything(3),
+		"text oneof":  {Oneofs: &Oneofs{Value: &Oneofs_Text{}}},
+		"bytes oneof": {Oneofs: &Oneofs{Value: &Oneofs_Data{Data: []byte("data")}}},
+		"large field": {LargeNumber: 1},
+		// the optional fields set to their zero value are encoded
+		"zero optionals": {Optionals: &Optionals{
+			Int32:   proto.Int32(0),
+			Sint64:  proto.Int64(0),
+			Double:  proto.Float64(0),
+			String_: proto.String(""),
+			Bytes:   []byte{},
+			Kind:    Kind_KIND_UNSPECIFIED.Enum(),
+			Scalars: &Scalars{},
+		}},
+	}
+	for name, msg := range msgs {
+		t.Run(name, func(t *testing.T) {
+			bz, err := deterministic.Marshal(msg)
+			require.NoError(t, err)
+			require.Equal(t, len(bz), proto.Size(msg))
+
+			other := &unrolled.Everything{}
+			require.NoError(t, proto.Unmarshal(bz, other))
+			otherBz, err := deterministic.Marshal(other)
+			require.NoError(t, err)
+			require.Equal(t, otherBz, bz)
+
+			got := &Everything{}
+			require.NoError(t, proto.Unmarshal(otherBz, got))
+			require.Empty(t, cmp.Diff(msg, got, protocmp.Transform()))
+		})
+	}
+}
+
+// TestReflectionCodec checks the table codec against the codec of the protobuf runtime.
+func TestReflectionCodec(t *testing.T) {
+	msg := newEverything(2)
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	dyn := dynamicpb.NewMessage(msg.ProtoReflect().Descriptor())
+	require.NoError(t, proto.Unmarshal(bz, dyn))
+	require.Empty(t, cmp.Diff(msg, dyn, protocmp.Transform()))
+
+	dynBz, err := proto.Marshal(dyn)
+	require.NoError(t, err)
+	got := &Everything{}
+	require.NoError(t, proto.Unmarshal(dynBz, got))
+	require.Empty(t, cmp.Diff(msg, got, protocmp.Transform()))
+}
+
+func TestRequired(t *testing.T) {
+	// the messages with required fields are checked by the table codec
+	msg := &Everything{Required: &presencetest.Required{Id: proto.Int32(1)}}
+	_, err := proto.Marshal(msg)
+	require.EqualError(t, err, "proto: required field presencetest.Required.name not set")
+	bz, err := proto.MarshalOptions{AllowPartial: true}.Marshal(msg)
+	require.NoError(t, err)
+	require.EqualError(t, proto.Unmarshal(bz, &Everything{}), "proto: required field presencetest.Required.name not set")
+	require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(bz, &Everything{}))
+}
+
+func TestUnknownFields(t *testing.T) {
+	var unknown []byte
+	unknown = protowire.AppendTag(unknown, 99, protowire.BytesType)
+	unknown = protowire.AppendString(unknown, "unknown")
+	unknown = protowire.AppendTag(unknown, 300, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 7)
+
+	bz, err := proto.Marshal(&Everything{LargeNumber: 1})
+	require.NoError(t, err)
+	bz = append(bz, unknown...)
+
+	msg := &Everything{}
+	require.NoError(t, proto.Unmarshal(bz, msg))
+	require.Equal(t, uint32(1), msg.LargeNumber)
+	require.Equal(t, unknown, []byte(msg.ProtoReflect().GetUnknown()))
+
+	again, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, bz, again)
+
+	msg = &Everything{}
+	require.NoError(t, proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(bz, msg))
+	require.Empty(t, msg.ProtoReflect().GetUnknown())
+	require.Equal(t, uint32(1), msg.LargeNumber)
+}
+
+func TestPackedAndUnpacked(t *testing.T) {
+	// parsers accept both encodings of repeated scalars
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 5)
+	bz = protowire.AppendTag(bz, 7, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, protowire.AppendVarint(protowire.AppendVarint(nil, 1), 2))
+
+	msg := &Repeated{}
+	require.NoError(t, proto.Unmarshal(bz, msg))
+	require.Equal(t, []int32{5}, msg.Int32)
+	require.Equal(t, []uint64{1, 2}, msg.Unpacked)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	bz, err := proto.Marshal(newEverything(1))
+	require.NoError(t, err)
+	for i := 1; i < len(bz); i += 7 {
+		// the truncations at a field boundary are valid encodings
+		if fieldBoundary(bz[:len(bz)-i]) {
+			continue
+		}
+		require.Error(t, proto.Unmarshal(bz[:len(bz)-i], &Everything{}), "truncated by %d bytes", i)
+	}
+
+	var wrongType []byte
+	wrongType = protowire.AppendTag(wrongType, 1, protowire.Fixed32Type)
+	wrongType = protowire.AppendFixed32(wrongType, 1)
+	require.ErrorContains(t, proto.Unmarshal(wrongType, &Scalars{}), "wrong wireType = 5 for field 1")
+	require.Error(t, proto.Unmarshal([]byte{0}, &Scalars{}), "field number 0")
+}
+
+// fieldBoundary reports whether b is a sequence of complete fields.
+func fieldBoundary(b []byte) bool {
+	for len(b) > 0 {
+		_, _, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return true
+}
@@ -15,2389 +15,6 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_TestAllTypes_31_list)(nil)
-
-type _TestAllTypes_31_list struct {
-	list *[]int32
-}
-
-func (x *_TestAllTypes_31_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_31_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfInt32((*x.list)[i])
-}
-
-func (x *_TestAllTypes_31_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_31_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_31_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedInt32 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_31_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_31_list) NewElement() protoreflect.Value {
-	v := int32(0)
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_31_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_32_list)(nil)
-
-type _TestAllTypes_32_list struct {
-	list *[]int64
-}
-
-func (x *_TestAllTypes_32_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_32_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfInt64((*x.list)[i])
-}
-
-func (x *_TestAllTypes_32_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_32_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_32_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedInt64 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_32_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_32_list) NewElement() protoreflect.Value {
-	v := int64(0)
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_32_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_33_list)(nil)
-
-type _TestAllTypes_33_list struct {
-	list *[]uint32
-}
-
-func (x *_TestAllTypes_33_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_33_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint32((*x.list)[i])
-}
-
-func (x *_TestAllTypes_33_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_33_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_33_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedUint32 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_33_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_33_list) NewElement() protoreflect.Value {
-	v := uint32(0)
-	return protoreflect.ValueOfUint32(v)
-}
-
-func (x *_TestAllTypes_33_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_34_list)(nil)
-
-type _TestAllTypes_34_list struct {
-	list *[]uint64
-}
-
-func (x *_TestAllTypes_34_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_34_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
-}
-
-func (x *_TestAllTypes_34_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_34_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_34_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedUint64 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_34_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_34_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TestAllTypes_34_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_35_list)(nil)
-
-type _TestAllTypes_35_list struct {
-	list *[]int32
-}
-
-func (x *_TestAllTypes_35_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_35_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfInt32((*x.list)[i])
-}
-
-func (x *_TestAllTypes_35_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_35_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_35_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSint32 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_35_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_35_list) NewElement() protoreflect.Value {
-	v := int32(0)
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_35_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_36_list)(nil)
-
-type _TestAllTypes_36_list struct {
-	list *[]int64
-}
-
-func (x *_TestAllTypes_36_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_36_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfInt64((*x.list)[i])
-}
-
-func (x *_TestAllTypes_36_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_36_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_36_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSint64 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_36_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_36_list) NewElement() protoreflect.Value {
-	v := int64(0)
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_36_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_37_list)(nil)
-
-type _TestAllTypes_37_list struct {
-	list *[]uint32
-}
-
-func (x *_TestAllTypes_37_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_37_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint32((*x.list)[i])
-}
-
-func (x *_TestAllTypes_37_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_37_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_37_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFixed32 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_37_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_37_list) NewElement() protoreflect.Value {
-	v := uint32(0)
-	return protoreflect.ValueOfUint32(v)
-}
-
-func (x *_TestAllTypes_37_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_38_list)(nil)
-
-type _TestAllTypes_38_list struct {
-	list *[]uint64
-}
-
-func (x *_TestAllTypes_38_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_38_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfUint64((*x.list)[i])
-}
-
-func (x *_TestAllTypes_38_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_38_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_38_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFixed64 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_38_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_38_list) NewElement() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TestAllTypes_38_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_39_list)(nil)
-
-type _TestAllTypes_39_list struct {
-	list *[]int32
-}
-
-func (x *_TestAllTypes_39_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_39_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfInt32((*x.list)[i])
-}
-
-func (x *_TestAllTypes_39_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_39_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_39_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSfixed32 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_39_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_39_list) NewElement() protoreflect.Value {
-	v := int32(0)
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_39_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_40_list)(nil)
-
-type _TestAllTypes_40_list struct {
-	list *[]int64
-}
-
-func (x *_TestAllTypes_40_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_40_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfInt64((*x.list)[i])
-}
-
-func (x *_TestAllTypes_40_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_40_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_40_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedSfixed64 as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_40_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_40_list) NewElement() protoreflect.Value {
-	v := int64(0)
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_40_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_41_list)(nil)
-
-type _TestAllTypes_41_list struct {
-	list *[]float32
-}
-
-func (x *_TestAllTypes_41_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_41_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfFloat32((*x.list)[i])
-}
-
-func (x *_TestAllTypes_41_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Float()
-	concreteValue := (float32)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_41_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Float()
-	concreteValue := (float32)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_41_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedFloat as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_41_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_41_list) NewElement() protoreflect.Value {
-	v := float32(0)
-	return protoreflect.ValueOfFloat32(v)
-}
-
-func (x *_TestAllTypes_41_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_42_list)(nil)
-
-type _TestAllTypes_42_list struct {
-	list *[]float64
-}
-
-func (x *_TestAllTypes_42_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_42_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfFloat64((*x.list)[i])
-}
-
-func (x *_TestAllTypes_42_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Float()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_42_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Float()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_42_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedDouble as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_42_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_42_list) NewElement() protoreflect.Value {
-	v := float64(0)
-	return protoreflect.ValueOfFloat64(v)
-}
-
-func (x *_TestAllTypes_42_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_43_list)(nil)
-
-type _TestAllTypes_43_list struct {
-	list *[]bool
-}
-
-func (x *_TestAllTypes_43_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_43_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfBool((*x.list)[i])
-}
-
-func (x *_TestAllTypes_43_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Bool()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_43_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Bool()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_43_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedBool as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_43_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_43_list) NewElement() protoreflect.Value {
-	v := false
-	return protoreflect.ValueOfBool(v)
-}
-
-func (x *_TestAllTypes_43_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_44_list)(nil)
-
-type _TestAllTypes_44_list struct {
-	list *[]string
-}
-
-func (x *_TestAllTypes_44_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_44_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_TestAllTypes_44_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_44_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_44_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedString as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_44_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_44_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_TestAllTypes_44_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_45_list)(nil)
-
-type _TestAllTypes_45_list struct {
-	list *[][]byte
-}
-
-func (x *_TestAllTypes_45_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_45_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfBytes((*x.list)[i])
-}
-
-func (x *_TestAllTypes_45_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_45_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_45_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedBytes as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_45_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_45_list) NewElement() protoreflect.Value {
-	var v []byte
-	return protoreflect.ValueOfBytes(v)
-}
-
-func (x *_TestAllTypes_45_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_48_list)(nil)
-
-type _TestAllTypes_48_list struct {
-	list *[]*TestAllTypes_NestedMessage
-}
-
-func (x *_TestAllTypes_48_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_48_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TestAllTypes_48_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_48_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_48_list) AppendMutable() protoreflect.Value {
-	v := new(TestAllTypes_NestedMessage)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_48_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_48_list) NewElement() protoreflect.Value {
-	v := new(TestAllTypes_NestedMessage)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_48_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_49_list)(nil)
-
-type _TestAllTypes_49_list struct {
-	list *[]*ForeignMessage
-}
-
-func (x *_TestAllTypes_49_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_49_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TestAllTypes_49_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ForeignMessage)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_49_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ForeignMessage)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_49_list) AppendMutable() protoreflect.Value {
-	v := new(ForeignMessage)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_49_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_49_list) NewElement() protoreflect.Value {
-	v := new(ForeignMessage)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_49_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_50_list)(nil)
-
-type _TestAllTypes_50_list struct {
-	list *[]*ImportMessage
-}
-
-func (x *_TestAllTypes_50_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_50_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TestAllTypes_50_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ImportMessage)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_50_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ImportMessage)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_50_list) AppendMutable() protoreflect.Value {
-	v := new(ImportMessage)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_50_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_50_list) NewElement() protoreflect.Value {
-	v := new(ImportMessage)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_50_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_51_list)(nil)
-
-type _TestAllTypes_51_list struct {
-	list *[]TestAllTypes_NestedEnum
-}
-
-func (x *_TestAllTypes_51_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_51_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
-}
-
-func (x *_TestAllTypes_51_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_51_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_51_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedNestedEnum as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_51_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_51_list) NewElement() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_TestAllTypes_51_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_52_list)(nil)
-
-type _TestAllTypes_52_list struct {
-	list *[]ForeignEnum
-}
-
-func (x *_TestAllTypes_52_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_52_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
-}
-
-func (x *_TestAllTypes_52_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (ForeignEnum)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_52_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (ForeignEnum)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_52_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedForeignEnum as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_52_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_52_list) NewElement() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_TestAllTypes_52_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_TestAllTypes_53_list)(nil)
-
-type _TestAllTypes_53_list struct {
-	list *[]ImportEnum
-}
-
-func (x *_TestAllTypes_53_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TestAllTypes_53_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
-}
-
-func (x *_TestAllTypes_53_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (ImportEnum)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TestAllTypes_53_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (ImportEnum)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TestAllTypes_53_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message TestAllTypes at list field RepeatedImportenum as it is not of Message kind"))
-}
-
-func (x *_TestAllTypes_53_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_TestAllTypes_53_list) NewElement() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_TestAllTypes_53_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_56_map)(nil)
-
-type _TestAllTypes_56_map struct {
-	m *map[int32]int32
-}
-
-func (x *_TestAllTypes_56_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_56_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
-		mapValue := protoreflect.ValueOfInt32(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_56_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := (int32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_56_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_56_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_56_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_56_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_56_map) NewValue() protoreflect.Value {
-	v := int32(0)
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_56_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_57_map)(nil)
-
-type _TestAllTypes_57_map struct {
-	m *map[int64]int64
-}
-
-func (x *_TestAllTypes_57_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_57_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt64(k))
-		mapValue := protoreflect.ValueOfInt64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_57_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_57_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_57_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_57_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_57_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_57_map) NewValue() protoreflect.Value {
-	v := int64(0)
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_57_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_58_map)(nil)
-
-type _TestAllTypes_58_map struct {
-	m *map[uint32]uint32
-}
-
-func (x *_TestAllTypes_58_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_58_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint32(k))
-		mapValue := protoreflect.ValueOfUint32(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_58_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Uint()
-	concreteValue := (uint32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_58_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := (uint32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_58_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := (uint32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfUint32(v)
-}
-
-func (x *_TestAllTypes_58_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := (uint32)(keyUnwrapped)
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_58_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_58_map) NewValue() protoreflect.Value {
-	v := uint32(0)
-	return protoreflect.ValueOfUint32(v)
-}
-
-func (x *_TestAllTypes_58_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_59_map)(nil)
-
-type _TestAllTypes_59_map struct {
-	m *map[uint64]uint64
-}
-
-func (x *_TestAllTypes_59_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_59_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
-		mapValue := protoreflect.ValueOfUint64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_59_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Uint()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_59_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_59_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TestAllTypes_59_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_59_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_59_map) NewValue() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TestAllTypes_59_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_60_map)(nil)
-
-type _TestAllTypes_60_map struct {
-	m *map[int32]int32
-}
-
-func (x *_TestAllTypes_60_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_60_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
-		mapValue := protoreflect.ValueOfInt32(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_60_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := (int32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_60_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_60_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_60_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_60_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_60_map) NewValue() protoreflect.Value {
-	v := int32(0)
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_60_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_61_map)(nil)
-
-type _TestAllTypes_61_map struct {
-	m *map[int64]int64
-}
-
-func (x *_TestAllTypes_61_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_61_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt64(k))
-		mapValue := protoreflect.ValueOfInt64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_61_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_61_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_61_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_61_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_61_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_61_map) NewValue() protoreflect.Value {
-	v := int64(0)
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_61_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_62_map)(nil)
-
-type _TestAllTypes_62_map struct {
-	m *map[uint32]uint32
-}
-
-func (x *_TestAllTypes_62_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_62_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint32(k))
-		mapValue := protoreflect.ValueOfUint32(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_62_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Uint()
-	concreteValue := (uint32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_62_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := (uint32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_62_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := (uint32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfUint32(v)
-}
-
-func (x *_TestAllTypes_62_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := (uint32)(keyUnwrapped)
-	valueUnwrapped := value.Uint()
-	concreteValue := (uint32)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_62_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_62_map) NewValue() protoreflect.Value {
-	v := uint32(0)
-	return protoreflect.ValueOfUint32(v)
-}
-
-func (x *_TestAllTypes_62_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_63_map)(nil)
-
-type _TestAllTypes_63_map struct {
-	m *map[uint64]uint64
-}
-
-func (x *_TestAllTypes_63_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_63_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
-		mapValue := protoreflect.ValueOfUint64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_63_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Uint()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_63_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_63_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TestAllTypes_63_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Uint()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Uint()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_63_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_63_map) NewValue() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
-}
-
-func (x *_TestAllTypes_63_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_64_map)(nil)
-
-type _TestAllTypes_64_map struct {
-	m *map[int32]int32
-}
-
-func (x *_TestAllTypes_64_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_64_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
-		mapValue := protoreflect.ValueOfInt32(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_64_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := (int32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_64_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_64_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_64_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	valueUnwrapped := value.Int()
-	concreteValue := (int32)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_64_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_64_map) NewValue() protoreflect.Value {
-	v := int32(0)
-	return protoreflect.ValueOfInt32(v)
-}
-
-func (x *_TestAllTypes_64_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_65_map)(nil)
-
-type _TestAllTypes_65_map struct {
-	m *map[int64]int64
-}
-
-func (x *_TestAllTypes_65_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_65_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt64(k))
-		mapValue := protoreflect.ValueOfInt64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_65_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_65_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_65_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_65_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Int()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_65_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_65_map) NewValue() protoreflect.Value {
-	v := int64(0)
-	return protoreflect.ValueOfInt64(v)
-}
-
-func (x *_TestAllTypes_65_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_66_map)(nil)
-
-type _TestAllTypes_66_map struct {
-	m *map[int32]float32
-}
-
-func (x *_TestAllTypes_66_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_66_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
-		mapValue := protoreflect.ValueOfFloat32(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_66_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := (int32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_66_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_66_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfFloat32(v)
-}
-
-func (x *_TestAllTypes_66_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	valueUnwrapped := value.Float()
-	concreteValue := (float32)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_66_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_66_map) NewValue() protoreflect.Value {
-	v := float32(0)
-	return protoreflect.ValueOfFloat32(v)
-}
-
-func (x *_TestAllTypes_66_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_67_map)(nil)
-
-type _TestAllTypes_67_map struct {
-	m *map[int32]float64
-}
-
-func (x *_TestAllTypes_67_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_67_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfInt32(k))
-		mapValue := protoreflect.ValueOfFloat64(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_67_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Int()
-	concreteValue := (int32)(keyUnwrapped)
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_67_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_67_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfFloat64(v)
-}
-
-func (x *_TestAllTypes_67_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Int()
-	concreteKey := (int32)(keyUnwrapped)
-	valueUnwrapped := value.Float()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_67_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_67_map) NewValue() protoreflect.Value {
-	v := float64(0)
-	return protoreflect.ValueOfFloat64(v)
-}
-
-func (x *_TestAllTypes_67_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_68_map)(nil)
-
-type _TestAllTypes_68_map struct {
-	m *map[bool]bool
-}
-
-func (x *_TestAllTypes_68_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_68_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfBool(k))
-		mapValue := protoreflect.ValueOfBool(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_68_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.Bool()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_68_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.Bool()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_68_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.Bool()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfBool(v)
-}
-
-func (x *_TestAllTypes_68_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.Bool()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Bool()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_68_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_68_map) NewValue() protoreflect.Value {
-	v := false
-	return protoreflect.ValueOfBool(v)
-}
-
-func (x *_TestAllTypes_68_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_69_map)(nil)
-
-type _TestAllTypes_69_map struct {
-	m *map[string]string
-}
-
-func (x *_TestAllTypes_69_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_69_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfString(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_69_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_69_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_69_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_TestAllTypes_69_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_69_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_69_map) NewValue() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_TestAllTypes_69_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_70_map)(nil)
-
-type _TestAllTypes_70_map struct {
-	m *map[string][]byte
-}
-
-func (x *_TestAllTypes_70_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_70_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfBytes(v)
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_70_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_70_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_70_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfBytes(v)
-}
-
-func (x *_TestAllTypes_70_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_70_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_70_map) NewValue() protoreflect.Value {
-	var v []byte
-	return protoreflect.ValueOfBytes(v)
-}
-
-func (x *_TestAllTypes_70_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_71_map)(nil)
-
-type _TestAllTypes_71_map struct {
-	m *map[string]*TestAllTypes_NestedMessage
-}
-
-func (x *_TestAllTypes_71_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_71_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_71_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_71_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_71_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_71_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*TestAllTypes_NestedMessage)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_71_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if ok {
-		return protoreflect.ValueOfMessage(v.ProtoReflect())
-	}
-	newValue := new(TestAllTypes_NestedMessage)
-	(*x.m)[concreteKey] = newValue
-	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
-}
-
-func (x *_TestAllTypes_71_map) NewValue() protoreflect.Value {
-	v := new(TestAllTypes_NestedMessage)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TestAllTypes_71_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.Map = (*_TestAllTypes_73_map)(nil)
-
-type _TestAllTypes_73_map struct {
-	m *map[string]TestAllTypes_NestedEnum
-}
-
-func (x *_TestAllTypes_73_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_TestAllTypes_73_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfEnum(v.Number())
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_TestAllTypes_73_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_TestAllTypes_73_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_TestAllTypes_73_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_TestAllTypes_73_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Enum()
-	concreteValue := (TestAllTypes_NestedEnum)(valueUnwrapped)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_TestAllTypes_73_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
-}
-
-func (x *_TestAllTypes_73_map) NewValue() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_TestAllTypes_73_map) IsValid() bool {
-	return x.m != nil
-}
-
 var (
 	md_TestAllTypes                           protoreflect.MessageDescriptor
 	fd_TestAllTypes_singular_int32            protoreflect.FieldDescriptor
@@ -2735,229 +352,229 @@ func (x *fastReflection_TestAllTypes) Range(f func(protoreflect.FieldDescriptor,
 		}
 	}
 	if len(x.RepeatedInt32) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &x.RepeatedInt32})
+		value := protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&x.RepeatedInt32))
 		if !f(fd_TestAllTypes_repeated_int32, value) {
 			return
 		}
 	}
 	if len(x.RepeatedInt64) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &x.RepeatedInt64})
+		value := protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&x.RepeatedInt64))
 		if !f(fd_TestAllTypes_repeated_int64, value) {
 			return
 		}
 	}
 	if len(x.RepeatedUint32) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &x.RepeatedUint32})
+		value := protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&x.RepeatedUint32))
 		if !f(fd_TestAllTypes_repeated_uint32, value) {
 			return
 		}
 	}
 	if len(x.RepeatedUint64) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &x.RepeatedUint64})
+		value := protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](&x.RepeatedUint64))
 		if !f(fd_TestAllTypes_repeated_uint64, value) {
 			return
 		}
 	}
 	if len(x.RepeatedSint32) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &x.RepeatedSint32})
+		value := protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&x.RepeatedSint32))
 		if !f(fd_TestAllTypes_repeated_sint32, value) {
 			return
 		}
 	}
 	if len(x.RepeatedSint64) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &x.RepeatedSint64})
+		value := protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&x.RepeatedSint64))
 		if !f(fd_TestAllTypes_repeated_sint64, value) {
 			return
 		}
 	}
 	if len(x.RepeatedFixed32) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &x.RepeatedFixed32})
+		value := protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&x.RepeatedFixed32))
 		if !f(fd_TestAllTypes_repeated_fixed32, value) {
 			return
 		}
 	}
 	if len(x.RepeatedFixed64) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &x.RepeatedFixed64})
+		value := protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](&x.RepeatedFixed64))
 		if !f(fd_TestAllTypes_repeated_fixed64, value) {
 			return
 		}
 	}
 	if len(x.RepeatedSfixed32) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &x.RepeatedSfixed32})
+		value := protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&x.RepeatedSfixed32))
 		if !f(fd_TestAllTypes_repeated_sfixed32, value) {
 			return
 		}
 	}
 	if len(x.RepeatedSfixed64) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &x.RepeatedSfixed64})
+		value := protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&x.RepeatedSfixed64))
 		if !f(fd_TestAllTypes_repeated_sfixed64, value) {
 			return
 		}
 	}
 	if len(x.RepeatedFloat) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &x.RepeatedFloat})
+		value := protoreflect.ValueOfList(runtime.NewList[float32, runtime.Float32Value](&x.RepeatedFloat))
 		if !f(fd_TestAllTypes_repeated_float, value) {
 			return
 		}
 	}
 	if len(x.RepeatedDouble) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &x.RepeatedDouble})
+		value := protoreflect.ValueOfList(runtime.NewList[float64, runtime.Float64Value](&x.RepeatedDouble))
 		if !f(fd_TestAllTypes_repeated_double, value) {
 			return
 		}
 	}
 	if len(x.RepeatedBool) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &x.RepeatedBool})
+		value := protoreflect.ValueOfList(runtime.NewList[bool, runtime.BoolValue](&x.RepeatedBool))
 		if !f(fd_TestAllTypes_repeated_bool, value) {
 			return
 		}
 	}
 	if len(x.RepeatedString) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &x.RepeatedString})
+		value := protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](&x.RepeatedString))
 		if !f(fd_TestAllTypes_repeated_string, value) {
 			return
 		}
 	}
 	if len(x.RepeatedBytes) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &x.RepeatedBytes})
+		value := protoreflect.ValueOfList(runtime.NewList[[]byte, runtime.BytesValue](&x.RepeatedBytes))
 		if !f(fd_TestAllTypes_repeated_bytes, value) {
 			return
 		}
 	}
 	if len(x.RepeatedNestedMessage) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &x.RepeatedNestedMessage})
+		value := protoreflect.ValueOfList(runtime.NewList[*TestAllTypes_NestedMessage, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&x.RepeatedNestedMessage))
 		if !f(fd_TestAllTypes_repeated_nested_message, value) {
 			return
 		}
 	}
 	if len(x.RepeatedForeignMessage) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_49_list{list: &x.RepeatedForeignMessage})
+		value := protoreflect.ValueOfList(runtime.NewList[*ForeignMessage, runtime.MessageValue[ForeignMessage, *ForeignMessage]](&x.RepeatedForeignMessage))
 		if !f(fd_TestAllTypes_repeated_foreign_message, value) {
 			return
 		}
 	}
 	if len(x.RepeatedImportmessage) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_50_list{list: &x.RepeatedImportmessage})
+		value := protoreflect.ValueOfList(runtime.NewList[*ImportMessage, runtime.MessageValue[ImportMessage, *ImportMessage]](&x.RepeatedImportmessage))
 		if !f(fd_TestAllTypes_repeated_importmessage, value) {
 			return
 		}
 	}
 	if len(x.RepeatedNestedEnum) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &x.RepeatedNestedEnum})
+		value := protoreflect.ValueOfList(runtime.NewList[TestAllTypes_NestedEnum, runtime.EnumValue[TestAllTypes_NestedEnum]](&x.RepeatedNestedEnum))
 		if !f(fd_TestAllTypes_repeated_nested_enum, value) {
 			return
 		}
 	}
 	if len(x.RepeatedForeignEnum) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &x.RepeatedForeignEnum})
+		value := protoreflect.ValueOfList(runtime.NewList[ForeignEnum, runtime.EnumValue[ForeignEnum]](&x.RepeatedForeignEnum))
 		if !f(fd_TestAllTypes_repeated_foreign_enum, value) {
 			return
 		}
 	}
 	if len(x.RepeatedImportenum) != 0 {
-		value := protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &x.RepeatedImportenum})
+		value := protoreflect.ValueOfList(runtime.NewList[ImportEnum, runtime.EnumValue[ImportEnum]](&x.RepeatedImportenum))
 		if !f(fd_TestAllTypes_repeated_importenum, value) {
 			return
 		}
 	}
 	if len(x.MapInt32Int32) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &x.MapInt32Int32})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapInt32Int32))
 		if !f(fd_TestAllTypes_map_int32_int32, value) {
 			return
 		}
 	}
 	if len(x.MapInt64Int64) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_57_map{m: &x.MapInt64Int64})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapInt64Int64))
 		if !f(fd_TestAllTypes_map_int64_int64, value) {
 			return
 		}
 	}
 	if len(x.MapUint32Uint32) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_58_map{m: &x.MapUint32Uint32})
+		value := protoreflect.ValueOfMap(runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&x.MapUint32Uint32))
 		if !f(fd_TestAllTypes_map_uint32_uint32, value) {
 			return
 		}
 	}
 	if len(x.MapUint64Uint64) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_59_map{m: &x.MapUint64Uint64})
+		value := protoreflect.ValueOfMap(runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&x.MapUint64Uint64))
 		if !f(fd_TestAllTypes_map_uint64_uint64, value) {
 			return
 		}
 	}
 	if len(x.MapSint32Sint32) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_60_map{m: &x.MapSint32Sint32})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapSint32Sint32))
 		if !f(fd_TestAllTypes_map_sint32_sint32, value) {
 			return
 		}
 	}
 	if len(x.MapSint64Sint64) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_61_map{m: &x.MapSint64Sint64})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapSint64Sint64))
 		if !f(fd_TestAllTypes_map_sint64_sint64, value) {
 			return
 		}
 	}
 	if len(x.MapFixed32Fixed32) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_62_map{m: &x.MapFixed32Fixed32})
+		value := protoreflect.ValueOfMap(runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&x.MapFixed32Fixed32))
 		if !f(fd_TestAllTypes_map_fixed32_fixed32, value) {
 			return
 		}
 	}
 	if len(x.MapFixed64Fixed64) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_63_map{m: &x.MapFixed64Fixed64})
+		value := protoreflect.ValueOfMap(runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&x.MapFixed64Fixed64))
 		if !f(fd_TestAllTypes_map_fixed64_fixed64, value) {
 			return
 		}
 	}
 	if len(x.MapSfixed32Sfixed32) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_64_map{m: &x.MapSfixed32Sfixed32})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapSfixed32Sfixed32))
 		if !f(fd_TestAllTypes_map_sfixed32_sfixed32, value) {
 			return
 		}
 	}
 	if len(x.MapSfixed64Sfixed64) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_65_map{m: &x.MapSfixed64Sfixed64})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapSfixed64Sfixed64))
 		if !f(fd_TestAllTypes_map_sfixed64_sfixed64, value) {
 			return
 		}
 	}
 	if len(x.MapInt32Float) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_66_map{m: &x.MapInt32Float})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, float32, runtime.Int32Value, runtime.Float32Value](&x.MapInt32Float))
 		if !f(fd_TestAllTypes_map_int32_float, value) {
 			return
 		}
 	}
 	if len(x.MapInt32Double) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_67_map{m: &x.MapInt32Double})
+		value := protoreflect.ValueOfMap(runtime.NewMap[int32, float64, runtime.Int32Value, runtime.Float64Value](&x.MapInt32Double))
 		if !f(fd_TestAllTypes_map_int32_double, value) {
 			return
 		}
 	}
 	if len(x.MapBoolBool) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_68_map{m: &x.MapBoolBool})
+		value := protoreflect.ValueOfMap(runtime.NewMap[bool, bool, runtime.BoolValue, runtime.BoolValue](&x.MapBoolBool))
 		if !f(fd_TestAllTypes_map_bool_bool, value) {
 			return
 		}
 	}
 	if len(x.MapStringString) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &x.MapStringString})
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&x.MapStringString))
 		if !f(fd_TestAllTypes_map_string_string, value) {
 			return
 		}
 	}
 	if len(x.MapStringBytes) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_70_map{m: &x.MapStringBytes})
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, []byte, runtime.StringValue, runtime.BytesValue](&x.MapStringBytes))
 		if !f(fd_TestAllTypes_map_string_bytes, value) {
 			return
 		}
 	}
 	if len(x.MapStringNestedMessage) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &x.MapStringNestedMessage})
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, *TestAllTypes_NestedMessage, runtime.StringValue, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&x.MapStringNestedMessage))
 		if !f(fd_TestAllTypes_map_string_nested_message, value) {
 			return
 		}
 	}
 	if len(x.MapStringNestedEnum) != 0 {
-		value := protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &x.MapStringNestedEnum})
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, TestAllTypes_NestedEnum, runtime.StringValue, runtime.EnumValue[TestAllTypes_NestedEnum]](&x.MapStringNestedEnum))
 		if !f(fd_TestAllTypes_map_string_nested_enum, value) {
 			return
 		}
@@ -3458,231 +1075,231 @@ func (x *fastReflection_TestAllTypes) Get(descriptor protoreflect.FieldDescripto
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	case "goproto.proto.test3.TestAllTypes.repeated_int32":
 		if len(x.RepeatedInt32) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_31_list{})
+			return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](nil))
 		}
-		listValue := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
+		listValue := runtime.NewList[int32, runtime.Int32Value](&x.RepeatedInt32)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_int64":
 		if len(x.RepeatedInt64) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_32_list{})
+			return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](nil))
 		}
-		listValue := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
+		listValue := runtime.NewList[int64, runtime.Int64Value](&x.RepeatedInt64)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
 		if len(x.RepeatedUint32) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_33_list{})
+			return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](nil))
 		}
-		listValue := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
+		listValue := runtime.NewList[uint32, runtime.Uint32Value](&x.RepeatedUint32)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
 		if len(x.RepeatedUint64) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_34_list{})
+			return protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](nil))
 		}
-		listValue := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
+		listValue := runtime.NewList[uint64, runtime.Uint64Value](&x.RepeatedUint64)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
 		if len(x.RepeatedSint32) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_35_list{})
+			return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](nil))
 		}
-		listValue := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
+		listValue := runtime.NewList[int32, runtime.Int32Value](&x.RepeatedSint32)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
 		if len(x.RepeatedSint64) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_36_list{})
+			return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](nil))
 		}
-		listValue := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
+		listValue := runtime.NewList[int64, runtime.Int64Value](&x.RepeatedSint64)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
 		if len(x.RepeatedFixed32) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_37_list{})
+			return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](nil))
 		}
-		listValue := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
+		listValue := runtime.NewList[uint32, runtime.Uint32Value](&x.RepeatedFixed32)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
 		if len(x.RepeatedFixed64) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_38_list{})
+			return protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](nil))
 		}
-		listValue := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
+		listValue := runtime.NewList[uint64, runtime.Uint64Value](&x.RepeatedFixed64)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
 		if len(x.RepeatedSfixed32) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_39_list{})
+			return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](nil))
 		}
-		listValue := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
+		listValue := runtime.NewList[int32, runtime.Int32Value](&x.RepeatedSfixed32)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
 		if len(x.RepeatedSfixed64) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_40_list{})
+			return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](nil))
 		}
-		listValue := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
+		listValue := runtime.NewList[int64, runtime.Int64Value](&x.RepeatedSfixed64)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_float":
 		if len(x.RepeatedFloat) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_41_list{})
+			return protoreflect.ValueOfList(runtime.NewList[float32, runtime.Float32Value](nil))
 		}
-		listValue := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
+		listValue := runtime.NewList[float32, runtime.Float32Value](&x.RepeatedFloat)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_double":
 		if len(x.RepeatedDouble) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_42_list{})
+			return protoreflect.ValueOfList(runtime.NewList[float64, runtime.Float64Value](nil))
 		}
-		listValue := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
+		listValue := runtime.NewList[float64, runtime.Float64Value](&x.RepeatedDouble)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_bool":
 		if len(x.RepeatedBool) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_43_list{})
+			return protoreflect.ValueOfList(runtime.NewList[bool, runtime.BoolValue](nil))
 		}
-		listValue := &_TestAllTypes_43_list{list: &x.RepeatedBool}
+		listValue := runtime.NewList[bool, runtime.BoolValue](&x.RepeatedBool)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_string":
 		if len(x.RepeatedString) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_44_list{})
+			return protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](nil))
 		}
-		listValue := &_TestAllTypes_44_list{list: &x.RepeatedString}
+		listValue := runtime.NewList[string, runtime.StringValue](&x.RepeatedString)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
 		if len(x.RepeatedBytes) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_45_list{})
+			return protoreflect.ValueOfList(runtime.NewList[[]byte, runtime.BytesValue](nil))
 		}
-		listValue := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
+		listValue := runtime.NewList[[]byte, runtime.BytesValue](&x.RepeatedBytes)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
 		if len(x.RepeatedNestedMessage) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_48_list{})
+			return protoreflect.ValueOfList(runtime.NewList[*TestAllTypes_NestedMessage, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](nil))
 		}
-		listValue := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
+		listValue := runtime.NewList[*TestAllTypes_NestedMessage, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&x.RepeatedNestedMessage)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
 		if len(x.RepeatedForeignMessage) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_49_list{})
+			return protoreflect.ValueOfList(runtime.NewList[*ForeignMessage, runtime.MessageValue[ForeignMessage, *ForeignMessage]](nil))
 		}
-		listValue := &_TestAllTypes_49_list{list: &x.RepeatedForeignMessage}
+		listValue := runtime.NewList[*ForeignMessage, runtime.MessageValue[ForeignMessage, *ForeignMessage]](&x.RepeatedForeignMessage)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
 		if len(x.RepeatedImportmessage) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_50_list{})
+			return protoreflect.ValueOfList(runtime.NewList[*ImportMessage, runtime.MessageValue[ImportMessage, *ImportMessage]](nil))
 		}
-		listValue := &_TestAllTypes_50_list{list: &x.RepeatedImportmessage}
+		listValue := runtime.NewList[*ImportMessage, runtime.MessageValue[ImportMessage, *ImportMessage]](&x.RepeatedImportmessage)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
 		if len(x.RepeatedNestedEnum) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_51_list{})
+			return protoreflect.ValueOfList(runtime.NewList[TestAllTypes_NestedEnum, runtime.EnumValue[TestAllTypes_NestedEnum]](nil))
 		}
-		listValue := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
+		listValue := runtime.NewList[TestAllTypes_NestedEnum, runtime.EnumValue[TestAllTypes_NestedEnum]](&x.RepeatedNestedEnum)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
 		if len(x.RepeatedForeignEnum) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_52_list{})
+			return protoreflect.ValueOfList(runtime.NewList[ForeignEnum, runtime.EnumValue[ForeignEnum]](nil))
 		}
-		listValue := &_TestAllTypes_52_list{list: &x.RepeatedForeignEnum}
+		listValue := runtime.NewList[ForeignEnum, runtime.EnumValue[ForeignEnum]](&x.RepeatedForeignEnum)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
 		if len(x.RepeatedImportenum) == 0 {
-			return protoreflect.ValueOfList(&_TestAllTypes_53_list{})
+			return protoreflect.ValueOfList(runtime.NewList[ImportEnum, runtime.EnumValue[ImportEnum]](nil))
 		}
-		listValue := &_TestAllTypes_53_list{list: &x.RepeatedImportenum}
+		listValue := runtime.NewList[ImportEnum, runtime.EnumValue[ImportEnum]](&x.RepeatedImportenum)
 		return protoreflect.ValueOfList(listValue)
 	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
 		if len(x.MapInt32Int32) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_56_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](nil))
 		}
-		mapValue := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
+		mapValue := runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapInt32Int32)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
 		if len(x.MapInt64Int64) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_57_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](nil))
 		}
-		mapValue := &_TestAllTypes_57_map{m: &x.MapInt64Int64}
+		mapValue := runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapInt64Int64)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
 		if len(x.MapUint32Uint32) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_58_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](nil))
 		}
-		mapValue := &_TestAllTypes_58_map{m: &x.MapUint32Uint32}
+		mapValue := runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&x.MapUint32Uint32)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
 		if len(x.MapUint64Uint64) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_59_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](nil))
 		}
-		mapValue := &_TestAllTypes_59_map{m: &x.MapUint64Uint64}
+		mapValue := runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&x.MapUint64Uint64)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
 		if len(x.MapSint32Sint32) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_60_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](nil))
 		}
-		mapValue := &_TestAllTypes_60_map{m: &x.MapSint32Sint32}
+		mapValue := runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapSint32Sint32)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
 		if len(x.MapSint64Sint64) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_61_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](nil))
 		}
-		mapValue := &_TestAllTypes_61_map{m: &x.MapSint64Sint64}
+		mapValue := runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapSint64Sint64)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
 		if len(x.MapFixed32Fixed32) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_62_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](nil))
 		}
-		mapValue := &_TestAllTypes_62_map{m: &x.MapFixed32Fixed32}
+		mapValue := runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&x.MapFixed32Fixed32)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
 		if len(x.MapFixed64Fixed64) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_63_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](nil))
 		}
-		mapValue := &_TestAllTypes_63_map{m: &x.MapFixed64Fixed64}
+		mapValue := runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&x.MapFixed64Fixed64)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
 		if len(x.MapSfixed32Sfixed32) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_64_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](nil))
 		}
-		mapValue := &_TestAllTypes_64_map{m: &x.MapSfixed32Sfixed32}
+		mapValue := runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapSfixed32Sfixed32)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
 		if len(x.MapSfixed64Sfixed64) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_65_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](nil))
 		}
-		mapValue := &_TestAllTypes_65_map{m: &x.MapSfixed64Sfixed64}
+		mapValue := runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapSfixed64Sfixed64)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_int32_float":
 		if len(x.MapInt32Float) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_66_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, float32, runtime.Int32Value, runtime.Float32Value](nil))
 		}
-		mapValue := &_TestAllTypes_66_map{m: &x.MapInt32Float}
+		mapValue := runtime.NewMap[int32, float32, runtime.Int32Value, runtime.Float32Value](&x.MapInt32Float)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_int32_double":
 		if len(x.MapInt32Double) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_67_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[int32, float64, runtime.Int32Value, runtime.Float64Value](nil))
 		}
-		mapValue := &_TestAllTypes_67_map{m: &x.MapInt32Double}
+		mapValue := runtime.NewMap[int32, float64, runtime.Int32Value, runtime.Float64Value](&x.MapInt32Double)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
 		if len(x.MapBoolBool) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_68_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[bool, bool, runtime.BoolValue, runtime.BoolValue](nil))
 		}
-		mapValue := &_TestAllTypes_68_map{m: &x.MapBoolBool}
+		mapValue := runtime.NewMap[bool, bool, runtime.BoolValue, runtime.BoolValue](&x.MapBoolBool)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_string_string":
 		if len(x.MapStringString) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_69_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](nil))
 		}
-		mapValue := &_TestAllTypes_69_map{m: &x.MapStringString}
+		mapValue := runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&x.MapStringString)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
 		if len(x.MapStringBytes) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_70_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[string, []byte, runtime.StringValue, runtime.BytesValue](nil))
 		}
-		mapValue := &_TestAllTypes_70_map{m: &x.MapStringBytes}
+		mapValue := runtime.NewMap[string, []byte, runtime.StringValue, runtime.BytesValue](&x.MapStringBytes)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
 		if len(x.MapStringNestedMessage) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_71_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[string, *TestAllTypes_NestedMessage, runtime.StringValue, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](nil))
 		}
-		mapValue := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
+		mapValue := runtime.NewMap[string, *TestAllTypes_NestedMessage, runtime.StringValue, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&x.MapStringNestedMessage)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
 		if len(x.MapStringNestedEnum) == 0 {
-			return protoreflect.ValueOfMap(&_TestAllTypes_73_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[string, TestAllTypes_NestedEnum, runtime.StringValue, runtime.EnumValue[TestAllTypes_NestedEnum]](nil))
 		}
-		mapValue := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
+		mapValue := runtime.NewMap[string, TestAllTypes_NestedEnum, runtime.StringValue, runtime.EnumValue[TestAllTypes_NestedEnum]](&x.MapStringNestedEnum)
 		return protoreflect.ValueOfMap(mapValue)
 	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
 		if x.OneofField == nil {
@@ -3820,156 +1437,156 @@ func (x *fastReflection_TestAllTypes) Set(fd protoreflect.FieldDescriptor, value
 		x.SingularImportEnum = (ImportEnum)(value.Enum())
 	case "goproto.proto.test3.TestAllTypes.repeated_int32":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_31_list)
-		x.RepeatedInt32 = *clv.list
+		clv := lv.(*runtime.List[int32, runtime.Int32Value])
+		x.RepeatedInt32 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_int64":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_32_list)
-		x.RepeatedInt64 = *clv.list
+		clv := lv.(*runtime.List[int64, runtime.Int64Value])
+		x.RepeatedInt64 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_33_list)
-		x.RepeatedUint32 = *clv.list
+		clv := lv.(*runtime.List[uint32, runtime.Uint32Value])
+		x.RepeatedUint32 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_34_list)
-		x.RepeatedUint64 = *clv.list
+		clv := lv.(*runtime.List[uint64, runtime.Uint64Value])
+		x.RepeatedUint64 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_35_list)
-		x.RepeatedSint32 = *clv.list
+		clv := lv.(*runtime.List[int32, runtime.Int32Value])
+		x.RepeatedSint32 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_36_list)
-		x.RepeatedSint64 = *clv.list
+		clv := lv.(*runtime.List[int64, runtime.Int64Value])
+		x.RepeatedSint64 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_37_list)
-		x.RepeatedFixed32 = *clv.list
+		clv := lv.(*runtime.List[uint32, runtime.Uint32Value])
+		x.RepeatedFixed32 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_38_list)
-		x.RepeatedFixed64 = *clv.list
+		clv := lv.(*runtime.List[uint64, runtime.Uint64Value])
+		x.RepeatedFixed64 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_39_list)
-		x.RepeatedSfixed32 = *clv.list
+		clv := lv.(*runtime.List[int32, runtime.Int32Value])
+		x.RepeatedSfixed32 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_40_list)
-		x.RepeatedSfixed64 = *clv.list
+		clv := lv.(*runtime.List[int64, runtime.Int64Value])
+		x.RepeatedSfixed64 = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_float":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_41_list)
-		x.RepeatedFloat = *clv.list
+		clv := lv.(*runtime.List[float32, runtime.Float32Value])
+		x.RepeatedFloat = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_double":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_42_list)
-		x.RepeatedDouble = *clv.list
+		clv := lv.(*runtime.List[float64, runtime.Float64Value])
+		x.RepeatedDouble = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_bool":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_43_list)
-		x.RepeatedBool = *clv.list
+		clv := lv.(*runtime.List[bool, runtime.BoolValue])
+		x.RepeatedBool = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_string":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_44_list)
-		x.RepeatedString = *clv.list
+		clv := lv.(*runtime.List[string, runtime.StringValue])
+		x.RepeatedString = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_45_list)
-		x.RepeatedBytes = *clv.list
+		clv := lv.(*runtime.List[[]byte, runtime.BytesValue])
+		x.RepeatedBytes = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_48_list)
-		x.RepeatedNestedMessage = *clv.list
+		clv := lv.(*runtime.List[*TestAllTypes_NestedMessage, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]])
+		x.RepeatedNestedMessage = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_49_list)
-		x.RepeatedForeignMessage = *clv.list
+		clv := lv.(*runtime.List[*ForeignMessage, runtime.MessageValue[ForeignMessage, *ForeignMessage]])
+		x.RepeatedForeignMessage = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_50_list)
-		x.RepeatedImportmessage = *clv.list
+		clv := lv.(*runtime.List[*ImportMessage, runtime.MessageValue[ImportMessage, *ImportMessage]])
+		x.RepeatedImportmessage = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_51_list)
-		x.RepeatedNestedEnum = *clv.list
+		clv := lv.(*runtime.List[TestAllTypes_NestedEnum, runtime.EnumValue[TestAllTypes_NestedEnum]])
+		x.RepeatedNestedEnum = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_52_list)
-		x.RepeatedForeignEnum = *clv.list
+		clv := lv.(*runtime.List[ForeignEnum, runtime.EnumValue[ForeignEnum]])
+		x.RepeatedForeignEnum = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
 		lv := value.List()
-		clv := lv.(*_TestAllTypes_53_list)
-		x.RepeatedImportenum = *clv.list
+		clv := lv.(*runtime.List[ImportEnum, runtime.EnumValue[ImportEnum]])
+		x.RepeatedImportenum = clv.Slice()
 	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_56_map)
-		x.MapInt32Int32 = *cmv.m
+		cmv := mv.(*runtime.Map[int32, int32, runtime.Int32Value, runtime.Int32Value])
+		x.MapInt32Int32 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_57_map)
-		x.MapInt64Int64 = *cmv.m
+		cmv := mv.(*runtime.Map[int64, int64, runtime.Int64Value, runtime.Int64Value])
+		x.MapInt64Int64 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_58_map)
-		x.MapUint32Uint32 = *cmv.m
+		cmv := mv.(*runtime.Map[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value])
+		x.MapUint32Uint32 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_59_map)
-		x.MapUint64Uint64 = *cmv.m
+		cmv := mv.(*runtime.Map[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value])
+		x.MapUint64Uint64 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_60_map)
-		x.MapSint32Sint32 = *cmv.m
+		cmv := mv.(*runtime.Map[int32, int32, runtime.Int32Value, runtime.Int32Value])
+		x.MapSint32Sint32 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_61_map)
-		x.MapSint64Sint64 = *cmv.m
+		cmv := mv.(*runtime.Map[int64, int64, runtime.Int64Value, runtime.Int64Value])
+		x.MapSint64Sint64 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_62_map)
-		x.MapFixed32Fixed32 = *cmv.m
+		cmv := mv.(*runtime.Map[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value])
+		x.MapFixed32Fixed32 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_63_map)
-		x.MapFixed64Fixed64 = *cmv.m
+		cmv := mv.(*runtime.Map[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value])
+		x.MapFixed64Fixed64 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_64_map)
-		x.MapSfixed32Sfixed32 = *cmv.m
+		cmv := mv.(*runtime.Map[int32, int32, runtime.Int32Value, runtime.Int32Value])
+		x.MapSfixed32Sfixed32 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_65_map)
-		x.MapSfixed64Sfixed64 = *cmv.m
+		cmv := mv.(*runtime.Map[int64, int64, runtime.Int64Value, runtime.Int64Value])
+		x.MapSfixed64Sfixed64 = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_int32_float":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_66_map)
-		x.MapInt32Float = *cmv.m
+		cmv := mv.(*runtime.Map[int32, float32, runtime.Int32Value, runtime.Float32Value])
+		x.MapInt32Float = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_int32_double":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_67_map)
-		x.MapInt32Double = *cmv.m
+		cmv := mv.(*runtime.Map[int32, float64, runtime.Int32Value, runtime.Float64Value])
+		x.MapInt32Double = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_68_map)
-		x.MapBoolBool = *cmv.m
+		cmv := mv.(*runtime.Map[bool, bool, runtime.BoolValue, runtime.BoolValue])
+		x.MapBoolBool = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_string_string":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_69_map)
-		x.MapStringString = *cmv.m
+		cmv := mv.(*runtime.Map[string, string, runtime.StringValue, runtime.StringValue])
+		x.MapStringString = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_70_map)
-		x.MapStringBytes = *cmv.m
+		cmv := mv.(*runtime.Map[string, []byte, runtime.StringValue, runtime.BytesValue])
+		x.MapStringBytes = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_71_map)
-		x.MapStringNestedMessage = *cmv.m
+		cmv := mv.(*runtime.Map[string, *TestAllTypes_NestedMessage, runtime.StringValue, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]])
+		x.MapStringNestedMessage = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
 		mv := value.Map()
-		cmv := mv.(*_TestAllTypes_73_map)
-		x.MapStringNestedEnum = *cmv.m
+		cmv := mv.(*runtime.Map[string, TestAllTypes_NestedEnum, runtime.StringValue, runtime.EnumValue[TestAllTypes_NestedEnum]])
+		x.MapStringNestedEnum = cmv.GoMap()
 	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
 		cv := uint32(value.Uint())
 		x.OneofField = &TestAllTypes_OneofUint32{OneofUint32: cv}
@@ -4036,229 +1653,229 @@ func (x *fastReflection_TestAllTypes) Mutable(fd protoreflect.FieldDescriptor) p
 		if x.RepeatedInt32 == nil {
 			x.RepeatedInt32 = []int32{}
 		}
-		value := &_TestAllTypes_31_list{list: &x.RepeatedInt32}
+		value := runtime.NewList[int32, runtime.Int32Value](&x.RepeatedInt32)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_int64":
 		if x.RepeatedInt64 == nil {
 			x.RepeatedInt64 = []int64{}
 		}
-		value := &_TestAllTypes_32_list{list: &x.RepeatedInt64}
+		value := runtime.NewList[int64, runtime.Int64Value](&x.RepeatedInt64)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
 		if x.RepeatedUint32 == nil {
 			x.RepeatedUint32 = []uint32{}
 		}
-		value := &_TestAllTypes_33_list{list: &x.RepeatedUint32}
+		value := runtime.NewList[uint32, runtime.Uint32Value](&x.RepeatedUint32)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
 		if x.RepeatedUint64 == nil {
 			x.RepeatedUint64 = []uint64{}
 		}
-		value := &_TestAllTypes_34_list{list: &x.RepeatedUint64}
+		value := runtime.NewList[uint64, runtime.Uint64Value](&x.RepeatedUint64)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
 		if x.RepeatedSint32 == nil {
 			x.RepeatedSint32 = []int32{}
 		}
-		value := &_TestAllTypes_35_list{list: &x.RepeatedSint32}
+		value := runtime.NewList[int32, runtime.Int32Value](&x.RepeatedSint32)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
 		if x.RepeatedSint64 == nil {
 			x.RepeatedSint64 = []int64{}
 		}
-		value := &_TestAllTypes_36_list{list: &x.RepeatedSint64}
+		value := runtime.NewList[int64, runtime.Int64Value](&x.RepeatedSint64)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
 		if x.RepeatedFixed32 == nil {
 			x.RepeatedFixed32 = []uint32{}
 		}
-		value := &_TestAllTypes_37_list{list: &x.RepeatedFixed32}
+		value := runtime.NewList[uint32, runtime.Uint32Value](&x.RepeatedFixed32)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
 		if x.RepeatedFixed64 == nil {
 			x.RepeatedFixed64 = []uint64{}
 		}
-		value := &_TestAllTypes_38_list{list: &x.RepeatedFixed64}
+		value := runtime.NewList[uint64, runtime.Uint64Value](&x.RepeatedFixed64)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
 		if x.RepeatedSfixed32 == nil {
 			x.RepeatedSfixed32 = []int32{}
 		}
-		value := &_TestAllTypes_39_list{list: &x.RepeatedSfixed32}
+		value := runtime.NewList[int32, runtime.Int32Value](&x.RepeatedSfixed32)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
 		if x.RepeatedSfixed64 == nil {
 			x.RepeatedSfixed64 = []int64{}
 		}
-		value := &_TestAllTypes_40_list{list: &x.RepeatedSfixed64}
+		value := runtime.NewList[int64, runtime.Int64Value](&x.RepeatedSfixed64)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_float":
 		if x.RepeatedFloat == nil {
 			x.RepeatedFloat = []float32{}
 		}
-		value := &_TestAllTypes_41_list{list: &x.RepeatedFloat}
+		value := runtime.NewList[float32, runtime.Float32Value](&x.RepeatedFloat)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_double":
 		if x.RepeatedDouble == nil {
 			x.RepeatedDouble = []float64{}
 		}
-		value := &_TestAllTypes_42_list{list: &x.RepeatedDouble}
+		value := runtime.NewList[float64, runtime.Float64Value](&x.RepeatedDouble)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_bool":
 		if x.RepeatedBool == nil {
 			x.RepeatedBool = []bool{}
 		}
-		value := &_TestAllTypes_43_list{list: &x.RepeatedBool}
+		value := runtime.NewList[bool, runtime.BoolValue](&x.RepeatedBool)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_string":
 		if x.RepeatedString == nil {
 			x.RepeatedString = []string{}
 		}
-		value := &_TestAllTypes_44_list{list: &x.RepeatedString}
+		value := runtime.NewList[string, runtime.StringValue](&x.RepeatedString)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
 		if x.RepeatedBytes == nil {
 			x.RepeatedBytes = [][]byte{}
 		}
-		value := &_TestAllTypes_45_list{list: &x.RepeatedBytes}
+		value := runtime.NewList[[]byte, runtime.BytesValue](&x.RepeatedBytes)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
 		if x.RepeatedNestedMessage == nil {
 			x.RepeatedNestedMessage = []*TestAllTypes_NestedMessage{}
 		}
-		value := &_TestAllTypes_48_list{list: &x.RepeatedNestedMessage}
+		value := runtime.NewList[*TestAllTypes_NestedMessage, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&x.RepeatedNestedMessage)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
 		if x.RepeatedForeignMessage == nil {
 			x.RepeatedForeignMessage = []*ForeignMessage{}
 		}
-		value := &_TestAllTypes_49_list{list: &x.RepeatedForeignMessage}
+		value := runtime.NewList[*ForeignMessage, runtime.MessageValue[ForeignMessage, *ForeignMessage]](&x.RepeatedForeignMessage)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
 		if x.RepeatedImportmessage == nil {
 			x.RepeatedImportmessage = []*ImportMessage{}
 		}
-		value := &_TestAllTypes_50_list{list: &x.RepeatedImportmessage}
+		value := runtime.NewList[*ImportMessage, runtime.MessageValue[ImportMessage, *ImportMessage]](&x.RepeatedImportmessage)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
 		if x.RepeatedNestedEnum == nil {
 			x.RepeatedNestedEnum = []TestAllTypes_NestedEnum{}
 		}
-		value := &_TestAllTypes_51_list{list: &x.RepeatedNestedEnum}
+		value := runtime.NewList[TestAllTypes_NestedEnum, runtime.EnumValue[TestAllTypes_NestedEnum]](&x.RepeatedNestedEnum)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
 		if x.RepeatedForeignEnum == nil {
 			x.RepeatedForeignEnum = []ForeignEnum{}
 		}
-		value := &_TestAllTypes_52_list{list: &x.RepeatedForeignEnum}
+		value := runtime.NewList[ForeignEnum, runtime.EnumValue[ForeignEnum]](&x.RepeatedForeignEnum)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
 		if x.RepeatedImportenum == nil {
 			x.RepeatedImportenum = []ImportEnum{}
 		}
-		value := &_TestAllTypes_53_list{list: &x.RepeatedImportenum}
+		value := runtime.NewList[ImportEnum, runtime.EnumValue[ImportEnum]](&x.RepeatedImportenum)
 		return protoreflect.ValueOfList(value)
 	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
 		if x.MapInt32Int32 == nil {
 			x.MapInt32Int32 = make(map[int32]int32)
 		}
-		value := &_TestAllTypes_56_map{m: &x.MapInt32Int32}
+		value := runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapInt32Int32)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
 		if x.MapInt64Int64 == nil {
 			x.MapInt64Int64 = make(map[int64]int64)
 		}
-		value := &_TestAllTypes_57_map{m: &x.MapInt64Int64}
+		value := runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapInt64Int64)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
 		if x.MapUint32Uint32 == nil {
 			x.MapUint32Uint32 = make(map[uint32]uint32)
 		}
-		value := &_TestAllTypes_58_map{m: &x.MapUint32Uint32}
+		value := runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&x.MapUint32Uint32)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
 		if x.MapUint64Uint64 == nil {
 			x.MapUint64Uint64 = make(map[uint64]uint64)
 		}
-		value := &_TestAllTypes_59_map{m: &x.MapUint64Uint64}
+		value := runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&x.MapUint64Uint64)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
 		if x.MapSint32Sint32 == nil {
 			x.MapSint32Sint32 = make(map[int32]int32)
 		}
-		value := &_TestAllTypes_60_map{m: &x.MapSint32Sint32}
+		value := runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapSint32Sint32)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
 		if x.MapSint64Sint64 == nil {
 			x.MapSint64Sint64 = make(map[int64]int64)
 		}
-		value := &_TestAllTypes_61_map{m: &x.MapSint64Sint64}
+		value := runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapSint64Sint64)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
 		if x.MapFixed32Fixed32 == nil {
 			x.MapFixed32Fixed32 = make(map[uint32]uint32)
 		}
-		value := &_TestAllTypes_62_map{m: &x.MapFixed32Fixed32}
+		value := runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&x.MapFixed32Fixed32)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
 		if x.MapFixed64Fixed64 == nil {
 			x.MapFixed64Fixed64 = make(map[uint64]uint64)
 		}
-		value := &_TestAllTypes_63_map{m: &x.MapFixed64Fixed64}
+		value := runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&x.MapFixed64Fixed64)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
 		if x.MapSfixed32Sfixed32 == nil {
 			x.MapSfixed32Sfixed32 = make(map[int32]int32)
 		}
-		value := &_TestAllTypes_64_map{m: &x.MapSfixed32Sfixed32}
+		value := runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&x.MapSfixed32Sfixed32)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
 		if x.MapSfixed64Sfixed64 == nil {
 			x.MapSfixed64Sfixed64 = make(map[int64]int64)
 		}
-		value := &_TestAllTypes_65_map{m: &x.MapSfixed64Sfixed64}
+		value := runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&x.MapSfixed64Sfixed64)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_int32_float":
 		if x.MapInt32Float == nil {
 			x.MapInt32Float = make(map[int32]float32)
 		}
-		value := &_TestAllTypes_66_map{m: &x.MapInt32Float}
+		value := runtime.NewMap[int32, float32, runtime.Int32Value, runtime.Float32Value](&x.MapInt32Float)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_int32_double":
 		if x.MapInt32Double == nil {
 			x.MapInt32Double = make(map[int32]float64)
 		}
-		value := &_TestAllTypes_67_map{m: &x.MapInt32Double}
+		value := runtime.NewMap[int32, float64, runtime.Int32Value, runtime.Float64Value](&x.MapInt32Double)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
 		if x.MapBoolBool == nil {
 			x.MapBoolBool = make(map[bool]bool)
 		}
-		value := &_TestAllTypes_68_map{m: &x.MapBoolBool}
+		value := runtime.NewMap[bool, bool, runtime.BoolValue, runtime.BoolValue](&x.MapBoolBool)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_string_string":
 		if x.MapStringString == nil {
 			x.MapStringString = make(map[string]string)
 		}
-		value := &_TestAllTypes_69_map{m: &x.MapStringString}
+		value := runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&x.MapStringString)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
 		if x.MapStringBytes == nil {
 			x.MapStringBytes = make(map[string][]byte)
 		}
-		value := &_TestAllTypes_70_map{m: &x.MapStringBytes}
+		value := runtime.NewMap[string, []byte, runtime.StringValue, runtime.BytesValue](&x.MapStringBytes)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
 		if x.MapStringNestedMessage == nil {
 			x.MapStringNestedMessage = make(map[string]*TestAllTypes_NestedMessage)
 		}
-		value := &_TestAllTypes_71_map{m: &x.MapStringNestedMessage}
+		value := runtime.NewMap[string, *TestAllTypes_NestedMessage, runtime.StringValue, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&x.MapStringNestedMessage)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
 		if x.MapStringNestedEnum == nil {
 			x.MapStringNestedEnum = make(map[string]TestAllTypes_NestedEnum)
 		}
-		value := &_TestAllTypes_73_map{m: &x.MapStringNestedEnum}
+		value := runtime.NewMap[string, TestAllTypes_NestedEnum, runtime.StringValue, runtime.EnumValue[TestAllTypes_NestedEnum]](&x.MapStringNestedEnum)
 		return protoreflect.ValueOfMap(value)
 	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
 		if x.OneofField == nil {
@@ -4388,118 +2005,118 @@ func (x *fastReflection_TestAllTypes) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfEnum(0)
 	case "goproto.proto.test3.TestAllTypes.repeated_int32":
 		list := []int32{}
-		return protoreflect.ValueOfList(&_TestAllTypes_31_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_int64":
 		list := []int64{}
-		return protoreflect.ValueOfList(&_TestAllTypes_32_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_uint32":
 		list := []uint32{}
-		return protoreflect.ValueOfList(&_TestAllTypes_33_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_uint64":
 		list := []uint64{}
-		return protoreflect.ValueOfList(&_TestAllTypes_34_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_sint32":
 		list := []int32{}
-		return protoreflect.ValueOfList(&_TestAllTypes_35_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_sint64":
 		list := []int64{}
-		return protoreflect.ValueOfList(&_TestAllTypes_36_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed32":
 		list := []uint32{}
-		return protoreflect.ValueOfList(&_TestAllTypes_37_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[uint32, runtime.Uint32Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_fixed64":
 		list := []uint64{}
-		return protoreflect.ValueOfList(&_TestAllTypes_38_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[uint64, runtime.Uint64Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed32":
 		list := []int32{}
-		return protoreflect.ValueOfList(&_TestAllTypes_39_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[int32, runtime.Int32Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_sfixed64":
 		list := []int64{}
-		return protoreflect.ValueOfList(&_TestAllTypes_40_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[int64, runtime.Int64Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_float":
 		list := []float32{}
-		return protoreflect.ValueOfList(&_TestAllTypes_41_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[float32, runtime.Float32Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_double":
 		list := []float64{}
-		return protoreflect.ValueOfList(&_TestAllTypes_42_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[float64, runtime.Float64Value](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_bool":
 		list := []bool{}
-		return protoreflect.ValueOfList(&_TestAllTypes_43_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[bool, runtime.BoolValue](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_string":
 		list := []string{}
-		return protoreflect.ValueOfList(&_TestAllTypes_44_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[string, runtime.StringValue](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_bytes":
 		list := [][]byte{}
-		return protoreflect.ValueOfList(&_TestAllTypes_45_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[[]byte, runtime.BytesValue](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_message":
 		list := []*TestAllTypes_NestedMessage{}
-		return protoreflect.ValueOfList(&_TestAllTypes_48_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[*TestAllTypes_NestedMessage, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_message":
 		list := []*ForeignMessage{}
-		return protoreflect.ValueOfList(&_TestAllTypes_49_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[*ForeignMessage, runtime.MessageValue[ForeignMessage, *ForeignMessage]](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_importmessage":
 		list := []*ImportMessage{}
-		return protoreflect.ValueOfList(&_TestAllTypes_50_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[*ImportMessage, runtime.MessageValue[ImportMessage, *ImportMessage]](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_nested_enum":
 		list := []TestAllTypes_NestedEnum{}
-		return protoreflect.ValueOfList(&_TestAllTypes_51_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[TestAllTypes_NestedEnum, runtime.EnumValue[TestAllTypes_NestedEnum]](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_foreign_enum":
 		list := []ForeignEnum{}
-		return protoreflect.ValueOfList(&_TestAllTypes_52_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[ForeignEnum, runtime.EnumValue[ForeignEnum]](&list))
 	case "goproto.proto.test3.TestAllTypes.repeated_importenum":
 		list := []ImportEnum{}
-		return protoreflect.ValueOfList(&_TestAllTypes_53_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[ImportEnum, runtime.EnumValue[ImportEnum]](&list))
 	case "goproto.proto.test3.TestAllTypes.map_int32_int32":
 		m := make(map[int32]int32)
-		return protoreflect.ValueOfMap(&_TestAllTypes_56_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_int64_int64":
 		m := make(map[int64]int64)
-		return protoreflect.ValueOfMap(&_TestAllTypes_57_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_uint32_uint32":
 		m := make(map[uint32]uint32)
-		return protoreflect.ValueOfMap(&_TestAllTypes_58_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_uint64_uint64":
 		m := make(map[uint64]uint64)
-		return protoreflect.ValueOfMap(&_TestAllTypes_59_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_sint32_sint32":
 		m := make(map[int32]int32)
-		return protoreflect.ValueOfMap(&_TestAllTypes_60_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_sint64_sint64":
 		m := make(map[int64]int64)
-		return protoreflect.ValueOfMap(&_TestAllTypes_61_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_fixed32_fixed32":
 		m := make(map[uint32]uint32)
-		return protoreflect.ValueOfMap(&_TestAllTypes_62_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[uint32, uint32, runtime.Uint32Value, runtime.Uint32Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_fixed64_fixed64":
 		m := make(map[uint64]uint64)
-		return protoreflect.ValueOfMap(&_TestAllTypes_63_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[uint64, uint64, runtime.Uint64Value, runtime.Uint64Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_sfixed32_sfixed32":
 		m := make(map[int32]int32)
-		return protoreflect.ValueOfMap(&_TestAllTypes_64_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, int32, runtime.Int32Value, runtime.Int32Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_sfixed64_sfixed64":
 		m := make(map[int64]int64)
-		return protoreflect.ValueOfMap(&_TestAllTypes_65_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int64, int64, runtime.Int64Value, runtime.Int64Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_int32_float":
 		m := make(map[int32]float32)
-		return protoreflect.ValueOfMap(&_TestAllTypes_66_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, float32, runtime.Int32Value, runtime.Float32Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_int32_double":
 		m := make(map[int32]float64)
-		return protoreflect.ValueOfMap(&_TestAllTypes_67_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[int32, float64, runtime.Int32Value, runtime.Float64Value](&m))
 	case "goproto.proto.test3.TestAllTypes.map_bool_bool":
 		m := make(map[bool]bool)
-		return protoreflect.ValueOfMap(&_TestAllTypes_68_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[bool, bool, runtime.BoolValue, runtime.BoolValue](&m))
 	case "goproto.proto.test3.TestAllTypes.map_string_string":
 		m := make(map[string]string)
-		return protoreflect.ValueOfMap(&_TestAllTypes_69_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[string, string, runtime.StringValue, runtime.StringValue](&m))
 	case "goproto.proto.test3.TestAllTypes.map_string_bytes":
 		m := make(map[string][]byte)
-		return protoreflect.ValueOfMap(&_TestAllTypes_70_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[string, []byte, runtime.StringValue, runtime.BytesValue](&m))
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_message":
 		m := make(map[string]*TestAllTypes_NestedMessage)
-		return protoreflect.ValueOfMap(&_TestAllTypes_71_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[string, *TestAllTypes_NestedMessage, runtime.StringValue, runtime.MessageValue[TestAllTypes_NestedMessage, *TestAllTypes_NestedMessage]](&m))
 	case "goproto.proto.test3.TestAllTypes.map_string_nested_enum":
 		m := make(map[string]TestAllTypes_NestedEnum)
-		return protoreflect.ValueOfMap(&_TestAllTypes_73_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[string, TestAllTypes_NestedEnum, runtime.StringValue, runtime.EnumValue[TestAllTypes_NestedEnum]](&m))
 	case "goproto.proto.test3.TestAllTypes.oneof_uint32":
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "goproto.proto.test3.TestAllTypes.oneof_nested_message":
@@ -4538,23 +2155,23 @@ func (x *fastReflection_TestAllTypes) WhichOneof(d protoreflect.OneofDescriptor)
 		}
 		switch x.OneofField.(type) {
 		case *TestAllTypes_OneofUint32:
-			return x.Descriptor().Fields().ByName("oneof_uint32")
+			return md_TestAllTypes.Fields().ByName("oneof_uint32")
 		case *TestAllTypes_OneofNestedMessage:
-			return x.Descriptor().Fields().ByName("oneof_nested_message")
+			return md_TestAllTypes.Fields().ByName("oneof_nested_message")
 		case *TestAllTypes_OneofString:
-			return x.Descriptor().Fields().ByName("oneof_string")
+			return md_TestAllTypes.Fields().ByName("oneof_string")
 		case *TestAllTypes_OneofBytes:
-			return x.Descriptor().Fields().ByName("oneof_bytes")
+			return md_TestAllTypes.Fields().ByName("oneof_bytes")
 		case *TestAllTypes_OneofBool:
-			return x.Descriptor().Fields().ByName("oneof_bool")
+			return md_TestAllTypes.Fields().ByName("oneof_bool")
 		case *TestAllTypes_OneofUint64:
-			return x.Descriptor().Fields().ByName("oneof_uint64")
+			return md_TestAllTypes.Fields().ByName("oneof_uint64")
 		case *TestAllTypes_OneofFloat:
-			return x.Descriptor().Fields().ByName("oneof_float")
+			return md_TestAllTypes.Fields().ByName("oneof_float")
 		case *TestAllTypes_OneofDouble:
-			return x.Descriptor().Fields().ByName("oneof_double")
+			return md_TestAllTypes.Fields().ByName("oneof_double")
 		case *TestAllTypes_OneofEnum:
-			return x.Descriptor().Fields().ByName("oneof_enum")
+			return md_TestAllTypes.Fields().ByName("oneof_enum")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.TestAllTypes", d.FullName()))
@@ -5259,7 +2876,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -6635,7 +4258,9 @@ func init() {
 			i--
 			dAtA[i] = 0xfa
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -10611,7 +8236,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -10639,7 +8270,9 @@ func init() {
 			i--
 			dAtA[i] = 0x8
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -11098,7 +8731,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -11117,7 +8756,9 @@ func init() {
 			i--
 			dAtA[i] = 0x8
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
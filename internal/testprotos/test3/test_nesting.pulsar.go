@@ -310,7 +310,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -333,7 +339,9 @@ func init() {
 			i--
 			dAtA[i] = 0xa
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -725,7 +733,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -734,7 +748,9 @@ func init() {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -1119,7 +1135,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -1142,7 +1164,9 @@ func init() {
 			i--
 			dAtA[i] = 0xa
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -1528,9 +1552,9 @@ func (x *fastReflection_MultiLayeredNesting_Nested1_Nested2_Nested3) WhichOneof(
 		}
 		switch x.Nested3Oneof.(type) {
 		case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3String:
-			return x.Descriptor().Fields().ByName("nested_3_string")
+			return md_MultiLayeredNesting_Nested1_Nested2_Nested3.Fields().ByName("nested_3_string")
 		case *MultiLayeredNesting_Nested1_Nested2_Nested3_Nested_3Int32:
-			return x.Descriptor().Fields().ByName("nested_3_int32")
+			return md_MultiLayeredNesting_Nested1_Nested2_Nested3.Fields().ByName("nested_3_int32")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in goproto.proto.test3.MultiLayeredNesting.Nested1.Nested2.Nested3", d.FullName()))
@@ -1627,7 +1651,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -1648,7 +1678,9 @@ func init() {
 			i--
 			dAtA[i] = 0x10
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -0,0 +1,294 @@
+// Package jsonschema generates JSON Schema and OpenAPI 3.1 schemas describing the
+// protobuf JSON mapping of messages.
+//
+// Fields annotated with a cosmos_proto scalar are described as strings whose
+// format is the scalar name and whose description comes from its ScalarDescriptor.
+// google.protobuf.Any fields annotated with accepts_interface are described as
+// a oneOf over the messages implementing the interface, discriminated by @type.
+package jsonschema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-proto/declaration"
+)
+
+// Format selects the kind of document generated.
+type Format int
+
+const (
+	// FormatJSONSchema generates a JSON Schema 2020-12 document holding the messages in $defs.
+	FormatJSONSchema Format = iota
+	// FormatOpenAPI generates an OpenAPI 3.1 document holding the messages in components.
+	FormatOpenAPI
+)
+
+// SchemaDialect is the $schema of JSON Schema documents.
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+const typeField = "@type"
+
+// Generator accumulates the schemas of messages and of the messages they depend on.
+// Definitions are keyed by the fully-qualified name of the message.
+type Generator struct {
+	format   Format
+	resolver *declaration.Resolver
+	defs     map[string]*Schema
+	// impls maps interfaces to the messages implementing them, sorted by name
+	impls map[string][]protoreflect.MessageDescriptor
+}
+
+// NewGenerator returns a Generator looking up interface implementations and
+// scalar declarations in files.
+func NewGenerator(files declaration.Files, format Format) *Generator {
+	g := &Generator{
+		format:   format,
+		resolver: declaration.NewResolver(files),
+		defs:     make(map[string]*Schema),
+		impls:    make(map[string][]protoreflect.MessageDescriptor),
+	}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		g.indexImplementations(fd.Messages())
+		return true
+	})
+	for _, impls := range g.impls {
+		sort.Slice(impls, func(i, j int) bool { return impls[i].FullName() < impls[j].FullName() })
+	}
+	return g
+}
+
+func (g *Generator) indexImplementations(msgs protoreflect.MessageDescriptors) {
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		for _, iface := range proto.GetExtension(md.Options(), cosmos_proto.E_ImplementsInterface).([]string) {
+			g.impls[iface] = append(g.impls[iface], md)
+		}
+		g.indexImplementations(md.Messages())
+	}
+}
+
+// Add adds the definition of md and of the messages it references.
+func (g *Generator) Add(md protoreflect.MessageDescriptor) {
+	name := string(md.FullName())
+	if _, ok := g.defs[name]; ok {
+		return
+	}
+	if s, ok := wellKnownSchema(md.FullName()); ok {
+		g.defs[name] = s
+		return
+	}
+
+	s := &Schema{
+		Title:       string(md.Name()),
+		Description: comments(md),
+		Type:        "object",
+		Properties:  make(map[string]*Schema),
+	}
+	// registered before the fields for recursive messages
+	g.defs[name] = s
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		s.Properties[fd.JSONName()] = g.fieldSchema(fd)
+	}
+}
+
+// Definitions returns the schemas added so far.
+func (g *Generator) Definitions() map[string]*Schema {
+	return g.defs
+}
+
+// JSONSchemaDocument returns a JSON Schema document holding the definitions.
+func (g *Generator) JSONSchemaDocument() *Schema {
+	return &Schema{Schema: SchemaDialect, Defs: g.defs}
+}
+
+// OpenAPIDocument returns an OpenAPI document holding the definitions as component schemas.
+func (g *Generator) OpenAPIDocument(title, version string) *OpenAPI {
+	return &OpenAPI{
+		OpenAPI:    "3.1.0",
+		Info:       Info{Title: title, Version: version},
+		Paths:      map[string]struct{}{},
+		Components: Components{Schemas: g.defs},
+	}
+}
+
+func (g *Generator) ref(md protoreflect.MessageDescriptor) string {
+	g.Add(md)
+	if g.format == FormatOpenAPI {
+		return "#/components/schemas/" + string(md.FullName())
+	}
+	return "#/$defs/" + string(md.FullName())
+}
+
+func (g *Generator) fieldSchema(fd protoreflect.FieldDescriptor) *Schema {
+	var s *Schema
+	switch {
+	case fd.IsMap():
+		s = &Schema{Type: "object", AdditionalProperties: g.singularSchema(fd.MapValue(), fd)}
+	case fd.IsList():
+		s = &Schema{Type: "array", Items: g.singularSchema(fd, fd)}
+	default:
+		s = g.singularSchema(fd, fd)
+	}
+	if c := comments(fd); c != "" {
+		s.Description = joinDescriptions(c, s.Description)
+	}
+	return s
+}
+
+// singularSchema returns the schema of a value of fd, which is the map value field
+// of annotated when annotated is a map field.
+func (g *Generator) singularSchema(fd, annotated protoreflect.FieldDescriptor) *Schema {
+	if name := proto.GetExtension(annotated.Options(), cosmos_proto.E_Scalar).(string); name != "" {
+		if fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind {
+			return g.scalarSchema(name)
+		}
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64 bit integers are encoded as strings
+		return &Schema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &Schema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &Schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		return enumSchema(fd.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.Message().FullName() == anyFullName {
+			if iface := proto.GetExtension(annotated.Options(), cosmos_proto.E_AcceptsInterface).(string); iface != "" {
+				return g.interfaceSchema(iface)
+			}
+		}
+		return &Schema{Ref: g.ref(fd.Message())}
+	default:
+		panic(fmt.Sprintf("unexpected kind %v", fd.Kind()))
+	}
+}
+
+func (g *Generator) scalarSchema(name string) *Schema {
+	s := &Schema{Type: "string", Format: name}
+	if decl, err := g.resolver.FindScalar(name); err == nil {
+		s.Description = decl.Description
+	}
+	return s
+}
+
+// interfaceSchema describes an Any holding one of the implementations of iface,
+// each one being identified by its @type.
+func (g *Generator) interfaceSchema(iface string) *Schema {
+	s := &Schema{Type: "object", Required: []string{typeField}}
+	if decl, err := g.resolver.FindInterface(iface); err == nil {
+		s.Description = decl.Description
+	}
+	if g.format == FormatOpenAPI {
+		s.Discriminator = &Discriminator{PropertyName: typeField, Mapping: make(map[string]string)}
+	}
+	for _, impl := range g.impls[iface] {
+		typeURL := "/" + string(impl.FullName())
+		ref := g.ref(impl)
+		s.OneOf = append(s.OneOf, &Schema{
+			AllOf: []*Schema{{Ref: ref}},
+			Properties: map[string]*Schema{
+				typeField: {Type: "string", Const: typeURL},
+			},
+			Required: []string{typeField},
+		})
+		if s.Discriminator != nil {
+			s.Discriminator.Mapping[typeURL] = ref
+		}
+	}
+	return s
+}
+
+func enumSchema(ed protoreflect.EnumDescriptor) *Schema {
+	s := &Schema{Type: "string", Description: comments(ed)}
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		s.Enum = append(s.Enum, string(values.Get(i).Name()))
+	}
+	return s
+}
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// wellKnownSchema returns the schemas of the well-known types having
+// a special JSON mapping.
+func wellKnownSchema(name protoreflect.FullName) (*Schema, bool) {
+	switch name {
+	case anyFullName:
+		return &Schema{
+			Type:                 "object",
+			Properties:           map[string]*Schema{typeField: {Type: "string"}},
+			Required:             []string{typeField},
+			AdditionalProperties: &Schema{},
+		}, true
+	case "google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}, true
+	case "google.protobuf.Duration":
+		return &Schema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]{1,9})?s$`}, true
+	case "google.protobuf.FieldMask":
+		return &Schema{Type: "string"}, true
+	case "google.protobuf.Struct":
+		return &Schema{Type: "object", AdditionalProperties: &Schema{}}, true
+	case "google.protobuf.Value":
+		return &Schema{}, true
+	case "google.protobuf.ListValue":
+		return &Schema{Type: "array", Items: &Schema{}}, true
+	case "google.protobuf.Empty":
+		return &Schema{Type: "object"}, true
+	case "google.protobuf.BoolValue":
+		return &Schema{Type: "boolean"}, true
+	case "google.protobuf.Int32Value":
+		return &Schema{Type: "integer", Format: "int32"}, true
+	case "google.protobuf.UInt32Value":
+		return &Schema{Type: "integer", Format: "uint32"}, true
+	case "google.protobuf.Int64Value":
+		return &Schema{Type: "string", Format: "int64"}, true
+	case "google.protobuf.UInt64Value":
+		return &Schema{Type: "string", Format: "uint64"}, true
+	case "google.protobuf.FloatValue":
+		return &Schema{Type: "number", Format: "float"}, true
+	case "google.protobuf.DoubleValue":
+		return &Schema{Type: "number", Format: "double"}, true
+	case "google.protobuf.StringValue":
+		return &Schema{Type: "string"}, true
+	case "google.protobuf.BytesValue":
+		return &Schema{Type: "string", Format: "byte"}, true
+	default:
+		return nil, false
+	}
+}
+
+// comments returns the leading comments of d, if its file has source code info.
+func comments(d protoreflect.Descriptor) string {
+	loc := d.ParentFile().SourceLocations().ByDescriptor(d)
+	return strings.TrimSpace(loc.LeadingComments)
+}
+
+func joinDescriptions(a, b string) string {
+	if b == "" {
+		return a
+	}
+	return a + "\n\n" + b
+}
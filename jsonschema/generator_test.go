@@ -0,0 +1,57 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gotest.tools/v3/golden"
+
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/jsonschema"
+)
+
+func TestJSONSchema(t *testing.T) {
+	g := jsonschema.NewGenerator(protoregistry.GlobalFiles, jsonschema.FormatJSONSchema)
+	g.Add((&cosmostest.Tx{}).ProtoReflect().Descriptor())
+	g.Add((&cosmostest.Genesis{}).ProtoReflect().Descriptor())
+
+	defs := g.Definitions()
+	// implementations of accepted interfaces are added along with the messages referencing them
+	require.Contains(t, defs, "cosmostest.MsgSend")
+	require.Contains(t, defs, "cosmostest.MsgExec")
+	require.NotContains(t, defs, "cosmostest.Memo")
+
+	messages := defs["cosmostest.Tx"].Properties["messages"]
+	require.Equal(t, "array", messages.Type)
+	require.Len(t, messages.Items.OneOf, 2)
+	require.Equal(t, "/cosmostest.MsgExec", messages.Items.OneOf[0].Properties["@type"].Const)
+	require.Equal(t, "#/$defs/cosmostest.MsgExec", messages.Items.OneOf[0].AllOf[0].Ref)
+
+	amount := defs["cosmostest.Coin"].Properties["amount"]
+	require.Equal(t, "cosmostest.Int", amount.Format)
+	require.Equal(t, "Int is an arbitrary-precision integer encoded as its base-10 representation.", amount.Description)
+
+	bz, err := json.MarshalIndent(g.JSONSchemaDocument(), "", "  ")
+	require.NoError(t, err)
+	golden.Assert(t, string(bz), "cosmostest.schema.json")
+}
+
+func TestOpenAPI(t *testing.T) {
+	g := jsonschema.NewGenerator(protoregistry.GlobalFiles, jsonschema.FormatOpenAPI)
+	g.Add((&cosmostest.Tx{}).ProtoReflect().Descriptor())
+
+	messages := g.Definitions()["cosmostest.Tx"].Properties["messages"]
+	require.Equal(t, &jsonschema.Discriminator{
+		PropertyName: "@type",
+		Mapping: map[string]string{
+			"/cosmostest.MsgExec": "#/components/schemas/cosmostest.MsgExec",
+			"/cosmostest.MsgSend": "#/components/schemas/cosmostest.MsgSend",
+		},
+	}, messages.Items.Discriminator)
+
+	bz, err := json.MarshalIndent(g.OpenAPIDocument("cosmostest", "v1"), "", "  ")
+	require.NoError(t, err)
+	golden.Assert(t, string(bz), "cosmostest.openapi.json")
+}
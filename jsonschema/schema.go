@@ -0,0 +1,49 @@
+package jsonschema
+
+// Schema is the subset of JSON Schema 2020-12, which OpenAPI 3.1 schemas
+// are based on, describing the protobuf JSON mapping of messages.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Const                string             `json:"const,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	// Discriminator is only set in OpenAPI documents.
+	Discriminator *Discriminator     `json:"discriminator,omitempty"`
+	Defs          map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Discriminator is an OpenAPI discriminator object.
+type Discriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
+}
+
+// OpenAPI is an OpenAPI 3.1 document only containing component schemas.
+type OpenAPI struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]struct{} `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info is the metadata of an OpenAPI document.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the reusable schemas of an OpenAPI document.
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
@@ -0,0 +1,20 @@
+/*
+Package lint checks that the cosmos_proto annotations of a set of protobuf files
+follow the conventions documented in cosmos.proto:
+
+  - interfaces are declared in a file named a/b/interfaces.proto for a package a.b,
+    and scalars in a/b/scalars.proto;
+  - declared names are short names, declared only once, and scalars are declared
+    with exactly one field_type;
+  - implements_interface, accepts_interface and scalar reference declared interfaces
+    and scalars;
+  - accepts_interface is only used on google.protobuf.Any fields;
+  - scalar is only used on string and bytes fields matching the scalar field_type.
+
+The fields of the messages and the extensions, declared at the top level of a file
+or in a message, are checked.
+
+Issues are reported with the source location of the offending declaration when the
+files include source code info.
+*/
+package lint
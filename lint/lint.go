@@ -0,0 +1,288 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/declaration"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Rule identifies the convention violated by an Issue.
+type Rule string
+
+const (
+	RuleInterfaceLocation   Rule = "INTERFACE_LOCATION"
+	RuleScalarLocation      Rule = "SCALAR_LOCATION"
+	RuleDeclarationName     Rule = "DECLARATION_NAME"
+	RuleDuplicateDecl       Rule = "DUPLICATE_DECLARATION"
+	RuleScalarFieldType     Rule = "SCALAR_FIELD_TYPE"
+	RuleUndeclaredInterface Rule = "UNDECLARED_INTERFACE"
+	RuleUndeclaredScalar    Rule = "UNDECLARED_SCALAR"
+	RuleAcceptsNonAny       Rule = "ACCEPTS_INTERFACE_NOT_ANY"
+	RuleScalarMismatch      Rule = "SCALAR_TYPE_MISMATCH"
+)
+
+// Issue is a violation of a cosmos_proto convention.
+type Issue struct {
+	// File is the path of the file containing the offending declaration.
+	File string
+	// Line and Column are 1-based, or zero when the file has no source code info.
+	Line, Column int
+	Rule         Rule
+	Message      string
+}
+
+func (i Issue) String() string {
+	if i.Line == 0 {
+		return fmt.Sprintf("%s: %s (%s)", i.File, i.Message, i.Rule)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", i.File, i.Line, i.Column, i.Message, i.Rule)
+}
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// field numbers used to build the source paths of options
+const (
+	fileOptionsNumber    = 8 // google.protobuf.FileDescriptorProto.options
+	messageOptionsNumber = 7 // google.protobuf.DescriptorProto.options
+	fieldOptionsNumber   = 8 // google.protobuf.FieldDescriptorProto.options
+)
+
+// LintFileDescriptorSet lints the files of set whose path is in paths,
+// or all of them if paths is empty.
+func LintFileDescriptorSet(set *descriptorpb.FileDescriptorSet, paths ...string) ([]Issue, error) {
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, err
+	}
+	return Lint(files, paths...), nil
+}
+
+// Lint lints the files of the registry whose path is in paths, or all of them if
+// paths is empty. Declarations are looked up in all the files of the registry.
+// The returned issues are sorted by file and position.
+func Lint(files *protoregistry.Files, paths ...string) []Issue {
+	l := &linter{
+		interfaces: make(map[string]protoreflect.FileDescriptor),
+		scalars:    make(map[string]*cosmos_proto.ScalarDescriptor),
+		lint:       make(map[string]bool),
+	}
+	for _, p := range paths {
+		l.lint[p] = true
+	}
+
+	var all []protoreflect.FileDescriptor
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		all = append(all, fd)
+		return true
+	})
+	sort.Slice(all, func(i, j int) bool { return all[i].Path() < all[j].Path() })
+
+	for _, fd := range all {
+		l.collectDeclarations(fd)
+	}
+	for _, fd := range all {
+		if l.shouldLint(fd) {
+			l.lintMessages(fd.Messages())
+			l.lintFields(fd.Extensions())
+		}
+	}
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		a, b := l.issues[i], l.issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.issues
+}
+
+type linter struct {
+	interfaces map[string]protoreflect.FileDescriptor
+	scalars    map[string]*cosmos_proto.ScalarDescriptor
+	lint       map[string]bool
+	issues     []Issue
+}
+
+func (l *linter) shouldLint(fd protoreflect.FileDescriptor) bool {
+	return len(l.lint) == 0 || l.lint[fd.Path()]
+}
+
+func (l *linter) report(fd protoreflect.FileDescriptor, path protoreflect.SourcePath, rule Rule, format string, args ...interface{}) {
+	issue := Issue{File: fd.Path(), Rule: rule, Message: fmt.Sprintf(format, args...)}
+	// fall back to the enclosing declarations when there is no location for the path
+	for ; ; path = path[:len(path)-1] {
+		loc := fd.SourceLocations().ByPath(path)
+		if loc.Path != nil || len(path) == 0 {
+			if loc.Path != nil {
+				issue.Line, issue.Column = loc.StartLine+1, loc.StartColumn+1
+			}
+			break
+		}
+	}
+	l.issues = append(l.issues, issue)
+}
+
+func (l *linter) collectDeclarations(fd protoreflect.FileDescriptor) {
+	check := l.shouldLint(fd)
+
+	for i, decl := range declaration.Interfaces(fd) {
+		loc := protoreflect.SourcePath{fileOptionsNumber, int32(cosmos_proto.E_DeclareInterface.Field), int32(i)}
+		fullName := l.declaredName(fd, loc, decl.Name, check)
+		if !check {
+			l.interfaces[fullName] = fd
+			continue
+		}
+		if want := declaration.ConventionalPath(fd.Package(), declaration.InterfacesFile); fd.Path() != want {
+			l.report(fd, loc, RuleInterfaceLocation, "interface %s must be declared in %s", fullName, want)
+		}
+		if _, exists := l.interfaces[fullName]; exists {
+			l.report(fd, loc, RuleDuplicateDecl, "interface %s is declared more than once", fullName)
+		}
+		l.interfaces[fullName] = fd
+	}
+
+	for i, decl := range declaration.Scalars(fd) {
+		loc := protoreflect.SourcePath{fileOptionsNumber, int32(cosmos_proto.E_DeclareScalar.Field), int32(i)}
+		fullName := l.declaredName(fd, loc, decl.Name, check)
+		if !check {
+			l.scalars[fullName] = decl
+			continue
+		}
+		if want := declaration.ConventionalPath(fd.Package(), declaration.ScalarsFile); fd.Path() != want {
+			l.report(fd, loc, RuleScalarLocation, "scalar %s must be declared in %s", fullName, want)
+		}
+		if _, exists := l.scalars[fullName]; exists {
+			l.report(fd, loc, RuleDuplicateDecl, "scalar %s is declared more than once", fullName)
+		}
+		if len(decl.FieldType) != 1 || decl.FieldType[0] == cosmos_proto.ScalarType_SCALAR_TYPE_UNSPECIFIED {
+			l.report(fd, loc, RuleScalarFieldType, "scalar %s must declare exactly one field_type", fullName)
+		}
+		l.scalars[fullName] = decl
+	}
+}
+
+func (l *linter) declaredName(fd protoreflect.FileDescriptor, loc protoreflect.SourcePath, name string, check bool) string {
+	if check && (name == "" || strings.Contains(name, ".")) {
+		l.report(fd, loc, RuleDeclarationName, "declared name %q must be a non-empty short name without periods", name)
+	}
+	return declaration.FullName(fd, name)
+}
+
+func (l *linter) lintMessages(msgs protoreflect.MessageDescriptors) {
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		l.lintMessage(md)
+		l.lintMessages(md.Messages())
+		l.lintFields(md.Extensions())
+	}
+}
+
+func (l *linter) lintMessage(md protoreflect.MessageDescriptor) {
+	fd := md.ParentFile()
+	mdPath := fd.SourceLocations().ByDescriptor(md).Path
+	for i, iface := range proto.GetExtension(md.Options(), cosmos_proto.E_ImplementsInterface).([]string) {
+		if _, ok := l.interfaces[iface]; !ok {
+			l.report(fd, appendPath(mdPath, messageOptionsNumber, int32(cosmos_proto.E_ImplementsInterface.Field), int32(i)),
+				RuleUndeclaredInterface, "%s implements undeclared interface %s", md.FullName(), iface)
+		}
+	}
+
+	l.lintFields(md.Fields())
+}
+
+// lintFields lints the fields of a message or the extensions declared in a file or message.
+func (l *linter) lintFields(fields interface {
+	Len() int
+	Get(i int) protoreflect.FieldDescriptor
+}) {
+	for i := 0; i < fields.Len(); i++ {
+		l.lintField(fields.Get(i))
+	}
+}
+
+func (l *linter) lintField(field protoreflect.FieldDescriptor) {
+	fd := field.ParentFile()
+	fieldPath := fd.SourceLocations().ByDescriptor(field).Path
+
+	if iface := proto.GetExtension(field.Options(), cosmos_proto.E_AcceptsInterface).(string); iface != "" {
+		loc := appendPath(fieldPath, fieldOptionsNumber, int32(cosmos_proto.E_AcceptsInterface.Field))
+		if field.IsMap() || field.Message() == nil || field.Message().FullName() != anyFullName {
+			l.report(fd, loc, RuleAcceptsNonAny, "accepts_interface is used on %s which is not a google.protobuf.Any field", field.FullName())
+		}
+		if _, ok := l.interfaces[iface]; !ok {
+			l.report(fd, loc, RuleUndeclaredInterface, "%s accepts undeclared interface %s", field.FullName(), iface)
+		}
+	}
+
+	name := proto.GetExtension(field.Options(), cosmos_proto.E_Scalar).(string)
+	if name == "" {
+		return
+	}
+	loc := appendPath(fieldPath, fieldOptionsNumber, int32(cosmos_proto.E_Scalar.Field))
+	decl, ok := l.scalars[name]
+	if !ok {
+		l.report(fd, loc, RuleUndeclaredScalar, "%s uses undeclared scalar %s", field.FullName(), name)
+		return
+	}
+
+	kind := field.Kind()
+	if field.IsMap() {
+		kind = field.MapValue().Kind()
+	}
+	var fieldType cosmos_proto.ScalarType
+	switch kind {
+	case protoreflect.StringKind:
+		fieldType = cosmos_proto.ScalarType_SCALAR_TYPE_STRING
+	case protoreflect.BytesKind:
+		fieldType = cosmos_proto.ScalarType_SCALAR_TYPE_BYTES
+	default:
+		l.report(fd, loc, RuleScalarMismatch, "scalar %s is used on %s of type %s, scalars can only be used on string and bytes fields", name, field.FullName(), kind)
+		return
+	}
+	for _, t := range decl.FieldType {
+		if t == fieldType {
+			return
+		}
+	}
+	l.report(fd, loc, RuleScalarMismatch, "scalar %s is used on %s of type %s, expected %s", name, field.FullName(), kind, scalarKinds(decl.FieldType))
+}
+
+func scalarKinds(types []cosmos_proto.ScalarType) string {
+	kinds := make([]string, 0, len(types))
+	for _, t := range types {
+		switch t {
+		case cosmos_proto.ScalarType_SCALAR_TYPE_STRING:
+			kinds = append(kinds, protoreflect.StringKind.String())
+		case cosmos_proto.ScalarType_SCALAR_TYPE_BYTES:
+			kinds = append(kinds, protoreflect.BytesKind.String())
+		}
+	}
+	if len(kinds) == 0 {
+		return "a declared field_type"
+	}
+	return strings.Join(kinds, " or ")
+}
+
+// appendPath returns the path of a child of the element at p, or nil if p is unknown.
+func appendPath(p protoreflect.SourcePath, elems ...int32) protoreflect.SourcePath {
+	if p == nil {
+		return nil
+	}
+	out := make(protoreflect.SourcePath, 0, len(p)+len(elems))
+	return append(append(out, p...), elems...)
+}
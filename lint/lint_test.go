@@ -0,0 +1,75 @@
+package lint
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testdata/lint.binpb is generated from the files in testdata with:
+//
+//	protoc -I lint/testdata -I proto --include_imports --include_source_info \
+//	  -o lint/testdata/lint.binpb a/b/interfaces.proto a/b/scalars.proto a/b/types.proto c/types.proto
+func loadSet(t *testing.T) *descriptorpb.FileDescriptorSet {
+	bz, err := os.ReadFile("testdata/lint.binpb")
+	require.NoError(t, err)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(bz, set))
+	return set
+}
+
+func issueStrings(issues []Issue) []string {
+	out := make([]string, len(issues))
+	for i, issue := range issues {
+		out[i] = issue.String()
+	}
+	return out
+}
+
+func TestLint(t *testing.T) {
+	issues, err := LintFileDescriptorSet(loadSet(t), "a/b/interfaces.proto", "a/b/scalars.proto", "a/b/types.proto", "c/types.proto")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`a/b/interfaces.proto:12:1: declared name "Bad.Name" must be a non-empty short name without periods (DECLARATION_NAME)`,
+		`a/b/scalars.proto:17:1: scalar a.b.Untyped must declare exactly one field_type (SCALAR_FIELD_TYPE)`,
+		`a/b/scalars.proto:21:1: scalar a.b.Int is declared more than once (DUPLICATE_DECLARATION)`,
+		`a/b/types.proto:9:1: interface a.b.Misplaced must be declared in a/b/interfaces.proto (INTERFACE_LOCATION)`,
+		`a/b/types.proto:26:3: a.b.MsgInvalid implements undeclared interface a.b.Unknown (UNDECLARED_INTERFACE)`,
+		`a/b/types.proto:28:20: scalar a.b.Hash is used on a.b.MsgInvalid.hash of type string, expected bytes (SCALAR_TYPE_MISMATCH)`,
+		`a/b/types.proto:29:20: scalar a.b.Int is used on a.b.MsgInvalid.count of type int64, scalars can only be used on string and bytes fields (SCALAR_TYPE_MISMATCH)`,
+		`a/b/types.proto:30:23: a.b.MsgInvalid.missing uses undeclared scalar a.b.Missing (UNDECLARED_SCALAR)`,
+		`a/b/types.proto:31:23: accepts_interface is used on a.b.MsgInvalid.not_any which is not a google.protobuf.Any field (ACCEPTS_INTERFACE_NOT_ANY)`,
+		`a/b/types.proto:32:36: a.b.MsgInvalid.unknown accepts undeclared interface a.b.Unknown (UNDECLARED_INTERFACE)`,
+		`a/b/types.proto:33:35: scalar a.b.Hash is used on a.b.MsgInvalid.hashes of type string, expected bytes (SCALAR_TYPE_MISMATCH)`,
+		`a/b/types.proto:34:38: scalar a.b.Hash is used on a.b.MsgInvalid.optional_hash of type string, expected bytes (SCALAR_TYPE_MISMATCH)`,
+		`a/b/types.proto:38:26: scalar a.b.Hash is used on a.b.signer of type string, expected bytes (SCALAR_TYPE_MISMATCH)`,
+		`a/b/types.proto:39:44: a.b.default_msg accepts undeclared interface a.b.Unknown (UNDECLARED_INTERFACE)`,
+		`a/b/types.proto:44:35: a.b.Options.amount_scalar uses undeclared scalar a.b.Missing (UNDECLARED_SCALAR)`,
+	}, issueStrings(issues))
+}
+
+func TestLintSelectedFiles(t *testing.T) {
+	// declarations are looked up in all files, only the selected ones are checked
+	issues, err := LintFileDescriptorSet(loadSet(t), "c/types.proto")
+	require.NoError(t, err)
+	require.Empty(t, issues)
+}
+
+func TestLintWithoutSourceInfo(t *testing.T) {
+	set := loadSet(t)
+	for _, f := range set.File {
+		f.SourceCodeInfo = nil
+	}
+	issues, err := LintFileDescriptorSet(set, "a/b/types.proto")
+	require.NoError(t, err)
+	require.Len(t, issues, 12)
+	require.Equal(t, Issue{
+		File:    "a/b/types.proto",
+		Rule:    RuleInterfaceLocation,
+		Message: "interface a.b.Misplaced must be declared in a/b/interfaces.proto",
+	}, issues[0])
+	require.Equal(t, "a/b/types.proto: interface a.b.Misplaced must be declared in a/b/interfaces.proto (INTERFACE_LOCATION)", issues[0].String())
+}
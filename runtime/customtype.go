@@ -0,0 +1,123 @@
+package runtime
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CustomType is implemented by the Go types that fields annotated with a
+// cosmos_proto scalar are mapped to through the plugin's scalar option.
+// The field is encoded on the wire as a string or bytes field whose value
+// is the output of Marshal. A Size of zero marks the field as unpopulated.
+type CustomType interface {
+	Marshal() ([]byte, error)
+	MarshalTo(data []byte) (n int, err error)
+	Unmarshal(data []byte) error
+	Size() int
+}
+
+// MarshalCustomType returns the encoding of v, or nil if it cannot be marshaled.
+// It is used by the generated fast reflection methods, which cannot return errors:
+// the error is returned when the message itself is marshaled.
+func MarshalCustomType(v CustomType) []byte {
+	bz, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+	return bz
+}
+
+// SetCustomType decodes bz, the value of the field num, into v, and reports
+// whether bz is a valid encoding. It is used by the generated fast reflection
+// methods, which cannot return errors: an invalid bz is kept as field num of
+// the unknown fields, so that CheckCustomType reports it when the message is
+// checked or marshaled, and the caller resets v. The invalid values previously
+// kept for num are discarded.
+func SetCustomType(v CustomType, unknown *[]byte, num protowire.Number, bz []byte) bool {
+	ClearCustomType(unknown, num)
+	if err := v.Unmarshal(bz); err != nil {
+		*unknown = protowire.AppendTag(*unknown, num, protowire.BytesType)
+		*unknown = protowire.AppendBytes(*unknown, bz)
+		return false
+	}
+	return true
+}
+
+// ClearCustomType discards the invalid values of the field num kept in the
+// unknown fields by SetCustomType.
+func ClearCustomType(unknown *[]byte, num protowire.Number) {
+	b := *unknown
+	var kept []byte
+	for i := 0; i < len(b); {
+		n := fieldLen(b[i:])
+		if n < 0 {
+			// the rest is malformed, and not ours to discard
+			kept = append(kept, b[i:]...)
+			break
+		}
+		if fieldNum, typ, _ := protowire.ConsumeTag(b[i:]); fieldNum != num || typ != protowire.BytesType {
+			kept = append(kept, b[i:i+n]...)
+		}
+		i += n
+	}
+	if len(kept) != len(b) {
+		*unknown = kept
+	}
+}
+
+// CheckCustomType returns an error if the unknown fields keep a value of the
+// field fd which is not a valid encoding of the custom type of v, set by
+// SetCustomType. v is overwritten.
+func CheckCustomType(unknown []byte, fd protoreflect.FieldDescriptor, v CustomType) error {
+	for len(unknown) > 0 {
+		n := fieldLen(unknown)
+		if n < 0 {
+			return nil
+		}
+		num, typ, tagLen := protowire.ConsumeTag(unknown)
+		if num == fd.Number() && typ == protowire.BytesType {
+			bz, _ := protowire.ConsumeBytes(unknown[tagLen:])
+			if err := v.Unmarshal(bz); err != nil {
+				return invalidCustomTypeError{name: fd.FullName(), err: err}
+			}
+		}
+		unknown = unknown[n:]
+	}
+	return nil
+}
+
+// fieldLen returns the length of the field at the start of b, or a negative
+// number if it is malformed.
+func fieldLen(b []byte) int {
+	num, typ, n := protowire.ConsumeTag(b)
+	if n < 0 {
+		return n
+	}
+	m := protowire.ConsumeFieldValue(num, typ, b[n:])
+	if m < 0 {
+		return m
+	}
+	return n + m
+}
+
+// invalidCustomTypeError is the error of a field set to an invalid encoding of
+// its custom type, which matches proto.Error like the errors of the protobuf runtime.
+type invalidCustomTypeError struct {
+	name protoreflect.FullName
+	err  error
+}
+
+func (e invalidCustomTypeError) Error() string {
+	return fmt.Sprintf("proto: invalid value for field %s: %v", e.name, e.err)
+}
+
+func (e invalidCustomTypeError) Is(target error) bool {
+	return target == proto.Error
+}
+
+func (e invalidCustomTypeError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,320 @@
+// Package diff computes the field-level differences between two versions of a
+// message through reflection, which is fast reflection for the messages
+// generated with the "fast" feature, and works with dynamicpb messages as well.
+//
+// The differences are reported per field, and per element of the list and map
+// fields, the messages being compared field by field. The messages packed in
+// google.protobuf.Any are unpacked with anyutil and compared field by field as
+// well when both versions pack the same type. Unknown fields are ignored.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// Kind is the kind of a change.
+type Kind int
+
+const (
+	// Added is the population of a field, or the addition of an element.
+	Added Kind = iota + 1
+	// Removed is the clearing of a field, or the removal of an element.
+	Removed
+	// Modified is the modification of the value of a field or an element.
+	Modified
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Modified:
+		return "modified"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Change is a change of a field, or of an element of a list or map field.
+type Change struct {
+	// Path is the path of the changed field or element, e.g. "header.height",
+	// "msgs[1].amount" or "balances[atom]", in the format of anyutil.Unpacked:
+	// the fields of the messages packed in an Any follow the path of the Any,
+	// and extension fields are named by their full name in brackets.
+	Path string
+	// Field is the changed field, or the list or map field of the changed element.
+	Field protoreflect.FieldDescriptor
+	Kind  Kind
+	// Old and New are the values of the field or element before and after the
+	// change, Old being invalid for additions and New for removals.
+	Old, New protoreflect.Value
+
+	// mask is the field mask path covering the change, empty if none does.
+	mask string
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("%s: added %s", c.Path, formatValue(c.New))
+	case Removed:
+		return fmt.Sprintf("%s: removed %s", c.Path, formatValue(c.Old))
+	default:
+		return fmt.Sprintf("%s: %s -> %s", c.Path, formatValue(c.Old), formatValue(c.New))
+	}
+}
+
+func formatValue(v protoreflect.Value) string {
+	if m, ok := v.Interface().(protoreflect.Message); ok {
+		return "{" + prototext.MarshalOptions{}.Format(m.Interface()) + "}"
+	}
+	return fmt.Sprintf("%q", v.String())
+}
+
+// Diff is the list of the changes between two messages, in field number order.
+type Diff []Change
+
+func (d Diff) String() string {
+	lines := make([]string, len(d))
+	for i, c := range d {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FieldMask returns the field mask covering the changes, whose paths stop at the
+// list, map and Any fields, which cannot be traversed by field mask paths. The
+// changes of the extension fields of the compared messages are not covered.
+func (d Diff) FieldMask() *fieldmaskpb.FieldMask {
+	var paths []string
+	for _, c := range d {
+		if c.mask != "" {
+			paths = append(paths, c.mask)
+		}
+	}
+	sort.Strings(paths)
+
+	mask := &fieldmaskpb.FieldMask{}
+	for _, path := range paths {
+		// a path is covered by the shorter ones, which sort before it
+		if n := len(mask.Paths); n > 0 {
+			last := mask.Paths[n-1]
+			if path == last || strings.HasPrefix(path, last+".") {
+				continue
+			}
+		}
+		mask.Paths = append(mask.Paths, path)
+	}
+	return mask
+}
+
+// Options configures Compare.
+type Options struct {
+	// FileResolver and TypeResolver are passed to anyutil.Unpack for every Any.
+	FileResolver protodesc.Resolver
+	TypeResolver protoregistry.MessageTypeResolver
+}
+
+// Compare returns the changes from old to new, which must be messages of the same
+// type. The Anys packing different types, or which cannot be unpacked, are
+// compared field by field like the other messages.
+func Compare(old, new proto.Message, opts Options) (Diff, error) {
+	o, n := old.ProtoReflect(), new.ProtoReflect()
+	if o.Descriptor().FullName() != n.Descriptor().FullName() {
+		return nil, fmt.Errorf("cannot compare %s with %s", o.Descriptor().FullName(), n.Descriptor().FullName())
+	}
+	d := &differ{opts: opts}
+	d.compareMessages(o, n, "", "", false)
+	return d.diff, nil
+}
+
+type differ struct {
+	opts Options
+	diff Diff
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// compareMessages compares the fields of old and new at path. mask is the field
+// mask path of the messages, which is not extended with their fields if fixed.
+func (d *differ) compareMessages(old, new protoreflect.Message, path, mask string, fixed bool) {
+	if old.Descriptor().FullName() == anyFullName {
+		if d.compareAny(old, new, path, mask) {
+			return
+		}
+		// field masks do not traverse Anys
+		fixed = true
+	}
+
+	// the fields populated in either message, in field number order
+	fields := map[protoreflect.FieldNumber]protoreflect.FieldDescriptor{}
+	collect := func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields[fd.Number()] = fd
+		return true
+	}
+	old.Range(collect)
+	new.Range(collect)
+	numbers := make([]protoreflect.FieldNumber, 0, len(fields))
+	for number := range fields {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	for _, number := range numbers {
+		fd := fields[number]
+		name, fieldMask, fieldFixed := string(fd.Name()), mask, fixed
+		if fd.IsExtension() {
+			// field masks cannot name extensions, which are covered by their message
+			name, fieldFixed = "["+string(fd.FullName())+"]", true
+		}
+		if !fieldFixed {
+			fieldMask = join(mask, name)
+		}
+		d.compareField(old, new, fd, join(path, name), fieldMask, fieldFixed)
+	}
+}
+
+func (d *differ) compareField(old, new protoreflect.Message, fd protoreflect.FieldDescriptor, path, mask string, fixed bool) {
+	switch {
+	case fd.IsList():
+		d.compareLists(fd, old.Get(fd).List(), new.Get(fd).List(), path, mask)
+	case fd.IsMap():
+		d.compareMaps(fd, old.Get(fd).Map(), new.Get(fd).Map(), path, mask)
+	case fd.HasPresence() && !old.Has(fd):
+		d.add(Change{Path: path, Field: fd, Kind: Added, New: new.Get(fd), mask: mask})
+	case fd.HasPresence() && !new.Has(fd):
+		d.add(Change{Path: path, Field: fd, Kind: Removed, Old: old.Get(fd), mask: mask})
+	default:
+		d.compareValues(fd, fd, old.Get(fd), new.Get(fd), path, mask, fixed)
+	}
+}
+
+// compareValues compares the singular values of the field, list element or map
+// value vd of field.
+func (d *differ) compareValues(field, vd protoreflect.FieldDescriptor, old, new protoreflect.Value, path, mask string, fixed bool) {
+	if vd.Message() != nil {
+		d.compareMessages(old.Message(), new.Message(), path, mask, fixed)
+		return
+	}
+	if !equalScalars(vd.Kind(), old, new) {
+		d.add(Change{Path: path, Field: field, Kind: Modified, Old: old, New: new, mask: mask})
+	}
+}
+
+func (d *differ) compareLists(fd protoreflect.FieldDescriptor, old, new protoreflect.List, path, mask string) {
+	for i := 0; i < old.Len() || i < new.Len(); i++ {
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= old.Len():
+			d.add(Change{Path: elemPath, Field: fd, Kind: Added, New: new.Get(i), mask: mask})
+		case i >= new.Len():
+			d.add(Change{Path: elemPath, Field: fd, Kind: Removed, Old: old.Get(i), mask: mask})
+		default:
+			d.compareValues(fd, fd, old.Get(i), new.Get(i), elemPath, mask, true)
+		}
+	}
+}
+
+func (d *differ) compareMaps(fd protoreflect.FieldDescriptor, old, new protoreflect.Map, path, mask string) {
+	keys := map[interface{}]protoreflect.MapKey{}
+	collect := func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[k.Interface()] = k
+		return true
+	}
+	old.Range(collect)
+	new.Range(collect)
+	sorted := make([]protoreflect.MapKey, 0, len(keys))
+	for _, k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return runtime.LessMapKey(sorted[i].Interface(), sorted[j].Interface())
+	})
+
+	for _, k := range sorted {
+		entryPath := fmt.Sprintf("%s[%v]", path, k.Interface())
+		switch {
+		case !old.Has(k):
+			d.add(Change{Path: entryPath, Field: fd, Kind: Added, New: new.Get(k), mask: mask})
+		case !new.Has(k):
+			d.add(Change{Path: entryPath, Field: fd, Kind: Removed, Old: old.Get(k), mask: mask})
+		default:
+			d.compareValues(fd, fd.MapValue(), old.Get(k), new.Get(k), entryPath, mask, true)
+		}
+	}
+}
+
+// compareAny compares the messages packed in the Anys old and new, reporting
+// whether it did, which is when they pack the same type and can be unpacked.
+func (d *differ) compareAny(old, new protoreflect.Message, path, mask string) bool {
+	o, n := toAny(old), toAny(new)
+	switch {
+	case o.TypeUrl == n.TypeUrl && bytes.Equal(o.Value, n.Value):
+		return true
+	case o.TypeUrl != n.TypeUrl:
+		return false
+	}
+	oldPacked, err := anyutil.Unpack(o, d.opts.FileResolver, d.opts.TypeResolver)
+	if err != nil {
+		return false
+	}
+	newPacked, err := anyutil.Unpack(n, d.opts.FileResolver, d.opts.TypeResolver)
+	if err != nil {
+		return false
+	}
+	d.compareMessages(oldPacked.ProtoReflect(), newPacked.ProtoReflect(), path, mask, true)
+	return true
+}
+
+// toAny returns the Any m, which may be a dynamicpb message.
+func toAny(m protoreflect.Message) *anypb.Any {
+	if any, ok := m.Interface().(*anypb.Any); ok {
+		return any
+	}
+	fields := m.Descriptor().Fields()
+	return &anypb.Any{
+		TypeUrl: m.Get(fields.ByName("type_url")).String(),
+		Value:   m.Get(fields.ByName("value")).Bytes(),
+	}
+}
+
+// equalScalars reports whether the scalar values a and b of the given kind are
+// equal. Floating point values are compared bit by bit, so that NaNs are equal
+// and negative zeros are not equal to zeros, like their encoding.
+func equalScalars(kind protoreflect.Kind, a, b protoreflect.Value) bool {
+	switch kind {
+	case protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return math.Float64bits(a.Float()) == math.Float64bits(b.Float())
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
+
+func (d *differ) add(c Change) {
+	d.diff = append(d.diff, c)
+}
@@ -0,0 +1,166 @@
+package diff_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/internal/testprotos/extensiontest"
+	"github.com/cosmos/cosmos-proto/runtime/diff"
+	"github.com/cosmos/cosmos-proto/runtime/fieldmask"
+	"github.com/cosmos/cosmos-proto/testpb"
+)
+
+// summary returns the paths and kinds of the changes of d.
+func summary(d diff.Diff) []string {
+	var changes []string
+	for _, c := range d {
+		changes = append(changes, c.Path+" "+c.Kind.String())
+	}
+	return changes
+}
+
+func compare(t *testing.T, old, new proto.Message) diff.Diff {
+	t.Helper()
+	d, err := diff.Compare(old, new, diff.Options{})
+	require.NoError(t, err)
+	return d
+}
+
+func TestCompare(t *testing.T) {
+	old := &testpb.A{
+		INT32:   1,
+		STRING:  "same",
+		BYTES:   []byte("old"),
+		DOUBLE:  math.NaN(),
+		MESSAGE: &testpb.B{X: "old"},
+		MAP:     map[string]*testpb.B{"removed": {}, "modified": {X: "old"}, "same": {X: "same"}},
+		LIST:    []*testpb.B{{X: "same"}, {X: "old"}},
+		ONEOF:   &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{}},
+		Type_:   "removed",
+	}
+	new := &testpb.A{
+		INT32:     2,
+		STRING:    "same",
+		BYTES:     []byte("new"),
+		DOUBLE:    math.NaN(),
+		MESSAGE:   &testpb.B{X: "new"},
+		MAP:       map[string]*testpb.B{"added": {}, "modified": {X: "new"}, "same": {X: "same"}},
+		LIST:      []*testpb.B{{X: "same"}, {X: "new"}, {X: "added"}},
+		ONEOF:     &testpb.A_ONEOF_STRING{ONEOF_STRING: "oneof"},
+		LIST_ENUM: []testpb.Enumeration{testpb.Enumeration_Two},
+	}
+	d := compare(t, old, new)
+	require.Equal(t, []string{
+		"INT32 modified",
+		"BYTES modified",
+		"MESSAGE.x modified",
+		"MAP[added] added",
+		"MAP[modified].x modified",
+		"MAP[removed] removed",
+		"LIST[1].x modified",
+		"LIST[2] added",
+		"ONEOF_B removed",
+		"ONEOF_STRING added",
+		"LIST_ENUM[0] added",
+		"type modified",
+	}, summary(d))
+
+	require.Equal(t, `INT32: "1" -> "2"`, d[0].String())
+	require.Equal(t, "INT32", string(d[0].Field.Name()))
+	require.Equal(t, int64(2), d[0].New.Int())
+	require.False(t, d[7].Old.IsValid())
+	require.Equal(t, "added", d[7].New.Message().Interface().(*testpb.B).X)
+	require.Equal(t, `ONEOF_STRING: added "oneof"`, d[9].String())
+
+	require.Equal(t, []string{"BYTES", "INT32", "LIST", "LIST_ENUM", "MAP", "MESSAGE.x", "ONEOF_B", "ONEOF_STRING", "type"}, d.FieldMask().Paths)
+
+	// applying the mask of the diff to the old message gives the new one
+	require.NoError(t, fieldmask.Merge(old, new, d.FieldMask()))
+	require.Empty(t, compare(t, old, new))
+	require.Empty(t, compare(t, &testpb.A{}, &testpb.A{}))
+}
+
+func TestCompareMismatchingTypes(t *testing.T) {
+	_, err := diff.Compare(&testpb.A{}, &testpb.B{}, diff.Options{})
+	require.EqualError(t, err, "cannot compare A with B")
+}
+
+func TestCompareDynamic(t *testing.T) {
+	old, new := &testpb.A{MESSAGE: &testpb.B{X: "old"}, UINT64: 1}, &testpb.A{MESSAGE: &testpb.B{X: "new"}}
+	dynamic := func(msg proto.Message) proto.Message {
+		dyn := dynamicpb.NewMessage(msg.ProtoReflect().Descriptor())
+		bz, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(bz, dyn))
+		return dyn
+	}
+	want := []string{"UINT64 modified", "MESSAGE.x modified"}
+	require.Equal(t, want, summary(compare(t, dynamic(old), dynamic(new))))
+	require.Equal(t, want, summary(compare(t, old, new)))
+}
+
+func TestCompareAny(t *testing.T) {
+	pack := func(msg proto.Message) *anypb.Any {
+		any, err := anyutil.New(msg)
+		require.NoError(t, err)
+		return any
+	}
+	oldTx := &cosmostest.Tx{
+		Messages: []*anypb.Any{
+			pack(&cosmostest.MsgSend{Amount: "1"}),
+			pack(&cosmostest.MsgExec{Msgs: []*anypb.Any{pack(&cosmostest.MsgSend{ToAddress: "old"})}}),
+			pack(&cosmostest.MsgSend{}),
+		},
+		Extensions: map[string]*anypb.Any{"memo": pack(&cosmostest.Memo{Text: "old"})},
+	}
+	newTx := &cosmostest.Tx{
+		Messages: []*anypb.Any{
+			pack(&cosmostest.MsgSend{Amount: "2"}),
+			pack(&cosmostest.MsgExec{Msgs: []*anypb.Any{pack(&cosmostest.MsgSend{ToAddress: "new"})}}),
+			pack(&cosmostest.Memo{}),
+		},
+		Extensions: map[string]*anypb.Any{"memo": pack(&cosmostest.Memo{Text: "new"})},
+	}
+	d := compare(t, oldTx, newTx)
+	require.Equal(t, []string{
+		"messages[0].amount modified",
+		"messages[1].msgs[0].to_address modified",
+		// Anys packing different types are compared field by field
+		"messages[2].type_url modified",
+		"extensions[memo].text modified",
+	}, summary(d))
+	require.Equal(t, []string{"extensions", "messages"}, d.FieldMask().Paths)
+
+	// Anys which cannot be unpacked are compared field by field
+	d, err := diff.Compare(oldTx, newTx, diff.Options{TypeResolver: new(protoregistry.Types), FileResolver: new(protoregistry.Files)})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"messages[0].value modified",
+		"messages[1].value modified",
+		"messages[2].type_url modified",
+		"extensions[memo].value modified",
+	}, summary(d))
+}
+
+func TestCompareExtensions(t *testing.T) {
+	old, new := &extensiontest.Extendable{}, &extensiontest.Extendable{Names: []string{"name"}}
+	proto.SetExtension(old, extensiontest.E_Int32Ext, int32(1))
+	proto.SetExtension(new, extensiontest.E_Int32Ext, int32(2))
+	proto.SetExtension(new, extensiontest.E_RepeatedExt, []string{"added"})
+	d := compare(t, old, new)
+	// extensions are named by their full name, and are not covered by field masks
+	require.Equal(t, []string{
+		"names[0] added",
+		"[extensiontest.int32_ext] modified",
+		"[extensiontest.repeated_ext][0] added",
+	}, summary(d))
+	require.Equal(t, []string{"names"}, d.FieldMask().Paths)
+}
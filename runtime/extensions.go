@@ -0,0 +1,443 @@
+package runtime
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoimpl"
+)
+
+// Extensions implements the fast reflection and the encoding of the extension
+// fields of a generated message declaring extension ranges, stored in its
+// protoimpl.ExtensionFields, which the protobuf runtime shares. It follows the
+// semantics of the extension fields of the messages implemented by protoimpl.
+type Extensions map[int32]protoimpl.ExtensionFieldV1
+
+// NewExtensions returns the Extensions stored in ext.
+func NewExtensions(ext *protoimpl.ExtensionFields) *Extensions {
+	return (*Extensions)(ext)
+}
+
+// descriptor returns the extension type descriptor of fd, panicking if fd is
+// not an extension of md.
+func descriptor(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) protoreflect.ExtensionTypeDescriptor {
+	xd, ok := fd.(protoreflect.ExtensionTypeDescriptor)
+	if !ok {
+		panic(fmt.Sprintf("extension %v does not implement protoreflect.ExtensionTypeDescriptor", fd.FullName()))
+	}
+	if xd.ContainingMessage().FullName() != md.FullName() {
+		panic(fmt.Sprintf("extension %v has mismatching containing message: got %v, want %v", fd.FullName(), xd.ContainingMessage().FullName(), md.FullName()))
+	}
+	if !md.ExtensionRanges().Has(xd.Number()) {
+		panic(fmt.Sprintf("extension %v extends %v outside the extension range", fd.FullName(), md.FullName()))
+	}
+	return xd
+}
+
+// numbers returns the numbers of the extension fields in ascending order.
+func (x *Extensions) numbers() []int32 {
+	numbers := make([]int32, 0, len(*x))
+	for num := range *x {
+		numbers = append(numbers, num)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// Range calls f for every populated extension field, in an undefined order
+// unless stable, and reports whether f returned true for all of them.
+func (x *Extensions) Range(stable bool, f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) bool {
+	if x == nil || len(*x) == 0 {
+		return true
+	}
+	visit := func(field protoimpl.ExtensionFieldV1) bool {
+		xd := field.Type().TypeDescriptor()
+		v := field.Value()
+		if xd.IsList() && v.List().Len() == 0 {
+			return true
+		}
+		return f(xd, v)
+	}
+	if stable {
+		for _, num := range x.numbers() {
+			if !visit((*x)[num]) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, field := range *x {
+		if !visit(field) {
+			return false
+		}
+	}
+	return true
+}
+
+// Has reports whether the extension fd of md is populated.
+func (x *Extensions) Has(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) bool {
+	xd := descriptor(md, fd)
+	if x == nil {
+		return false
+	}
+	field, ok := (*x)[int32(xd.Number())]
+	if !ok {
+		return false
+	}
+	if xd.IsList() {
+		return field.Value().List().Len() > 0
+	}
+	return true
+}
+
+// Clear clears the extension fd of md.
+func (x *Extensions) Clear(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) {
+	xd := descriptor(md, fd)
+	delete(*x, int32(xd.Number()))
+}
+
+// Get returns the value of the extension fd of md, or its default value if it
+// is not populated.
+func (x *Extensions) Get(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) protoreflect.Value {
+	xd := descriptor(md, fd)
+	if x != nil {
+		if field, ok := (*x)[int32(xd.Number())]; ok {
+			return field.Value()
+		}
+	}
+	return xd.Type().Zero()
+}
+
+// Set sets the value of the extension fd of md, panicking if it is not a valid
+// value of the extension.
+func (x *Extensions) Set(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	xd := descriptor(md, fd)
+	xt := xd.Type()
+	isValid := xt.IsValidValue(value)
+	switch {
+	case !isValid:
+	case xd.IsList():
+		isValid = value.List().IsValid()
+	case xd.Message() != nil:
+		isValid = value.Message().IsValid()
+	}
+	if !isValid {
+		panic(fmt.Sprintf("%v: assigning invalid value", xd.FullName()))
+	}
+	x.set(xt, value)
+}
+
+func (x *Extensions) set(xt protoreflect.ExtensionType, value protoreflect.Value) {
+	if *x == nil {
+		*x = make(Extensions)
+	}
+	var field protoimpl.ExtensionFieldV1
+	field.Set(xt, value)
+	(*x)[int32(xt.TypeDescriptor().Number())] = field
+}
+
+// Mutable returns the mutable value of the composite extension fd of md,
+// populating it if needed.
+func (x *Extensions) Mutable(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) protoreflect.Value {
+	xd := descriptor(md, fd)
+	if xd.Message() == nil && !xd.IsList() {
+		panic("invalid Mutable on field with non-composite type")
+	}
+	if field, ok := (*x)[int32(xd.Number())]; ok {
+		return field.Value()
+	}
+	v := xd.Type().New()
+	x.set(xd.Type(), v)
+	return v
+}
+
+// NewExtensionField returns a new value of the extension fd of md.
+func NewExtensionField(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor) protoreflect.Value {
+	return descriptor(md, fd).Type().New()
+}
+
+// Size returns the size of the encoding of the extension fields.
+func (x *Extensions) Size(opts proto.MarshalOptions) int {
+	if x == nil {
+		return 0
+	}
+	n := 0
+	for _, field := range *x {
+		n += sizeField(field.Type().TypeDescriptor(), field.Value(), opts)
+	}
+	return n
+}
+
+// MarshalToSizedBuffer encodes the extension fields, by ascending number, at
+// the end of dAtA, and returns the size of their encoding.
+func (x *Extensions) MarshalToSizedBuffer(dAtA []byte, opts proto.MarshalOptions) (int, error) {
+	n := x.Size(opts)
+	if n == 0 {
+		return 0, nil
+	}
+	i := len(dAtA) - n
+	// the capacity is capped for a mismatching size to never overwrite the
+	// fields encoded after the extension fields
+	b := dAtA[i:i:len(dAtA)]
+	var err error
+	for _, num := range x.numbers() {
+		field := (*x)[num]
+		if b, err = appendField(b, field.Type().TypeDescriptor(), field.Value(), opts); err != nil {
+			return 0, err
+		}
+	}
+	if len(b) != n {
+		return 0, fmt.Errorf("proto: extension fields size changed while marshaling: %d != %d", len(b), n)
+	}
+	return n, nil
+}
+
+// CheckInitialized returns an error if the required fields of a message of the
+// extension fields are not set.
+func (x *Extensions) CheckInitialized() error {
+	if x == nil {
+		return nil
+	}
+	for _, field := range *x {
+		xd := field.Type().TypeDescriptor()
+		if xd.Message() == nil {
+			continue
+		}
+		v := field.Value()
+		if xd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if err := proto.CheckInitialized(list.Get(i).Message().Interface()); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := proto.CheckInitialized(v.Message().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalField decodes field, the encoding of a field of md in an extension
+// range, including its tag. It reports false if the extension is not found by
+// the resolver of opts, if its wire type does not match the extension, or if it
+// holds a value which is not declared by the closed enum of the extension, in
+// which case field is an unknown field. Packed fields are unknown fields as a
+// whole if any of their values is not declared.
+func (x *Extensions) UnmarshalField(md protoreflect.MessageDescriptor, field []byte, opts proto.UnmarshalOptions) (bool, error) {
+	num, wtyp, n := protowire.ConsumeTag(field)
+	if n < 0 {
+		return false, protowire.ParseError(n)
+	}
+	resolver := opts.Resolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalTypes
+	}
+	xt, err := resolver.FindExtensionByNumber(md.FullName(), num)
+	if err == protoregistry.NotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	xd := xt.TypeDescriptor()
+	b := field[n:]
+
+	var value protoreflect.Value
+	if existing, ok := (*x)[int32(num)]; ok {
+		value = existing.Value()
+	}
+	switch {
+	case xd.IsList():
+		if !value.IsValid() {
+			value = xt.New()
+		}
+		list := value.List()
+		if wtyp == protowire.BytesType && IsPackable(xd.Kind()) {
+			packed, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return false, protowire.ParseError(n)
+			}
+			var values []protoreflect.Value
+			for len(packed) > 0 {
+				v, n := ConsumeScalar(xd.Kind(), packed)
+				if n < 0 {
+					return false, protowire.ParseError(n)
+				}
+				if unknownEnumValue(xd, v) {
+					return false, nil
+				}
+				values = append(values, v)
+				packed = packed[n:]
+			}
+			for _, v := range values {
+				list.Append(v)
+			}
+			break
+		}
+		if wtyp != WireType(xd.Kind()) {
+			return false, nil
+		}
+		v, err := consumeValue(xd, list.NewElement(), b, opts)
+		if err != nil {
+			return false, err
+		}
+		if unknownEnumValue(xd, v) {
+			return false, nil
+		}
+		list.Append(v)
+	default:
+		if wtyp != WireType(xd.Kind()) {
+			return false, nil
+		}
+		if !value.IsValid() || xd.Message() == nil {
+			value = xt.New()
+		}
+		if value, err = consumeValue(xd, value, b, opts); err != nil {
+			return false, err
+		}
+		if unknownEnumValue(xd, value) {
+			return false, nil
+		}
+	}
+	x.set(xt, value)
+	return true, nil
+}
+
+// unknownEnumValue reports whether v, a value of the extension xd, is not a value
+// of the closed enum of xd, in which case the field is an unknown field as
+// specified for proto2.
+func unknownEnumValue(xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value) bool {
+	ed := xd.Enum()
+	return ed != nil && ed.ParentFile().Syntax() == protoreflect.Proto2 && ed.Values().ByNumber(v.Enum()) == nil
+}
+
+// consumeValue decodes the value of a singular extension, or an element of a
+// repeated one, merging messages into value.
+func consumeValue(xd protoreflect.ExtensionTypeDescriptor, value protoreflect.Value, b []byte, opts proto.UnmarshalOptions) (protoreflect.Value, error) {
+	var (
+		bz []byte
+		n  int
+	)
+	switch xd.Kind() {
+	case protoreflect.MessageKind:
+		bz, n = protowire.ConsumeBytes(b)
+	case protoreflect.GroupKind:
+		bz, n = protowire.ConsumeGroup(xd.Number(), b)
+	default:
+		v, n := ConsumeScalar(xd.Kind(), b)
+		if n < 0 {
+			return v, protowire.ParseError(n)
+		}
+		return v, nil
+	}
+	if n < 0 {
+		return value, protowire.ParseError(n)
+	}
+	opts.Merge = true
+	if err := opts.Unmarshal(bz, value.Message().Interface()); err != nil {
+		return value, err
+	}
+	return value, nil
+}
+
+func sizeScalar(kind protoreflect.Kind, v protoreflect.Value) int {
+	switch WireType(kind) {
+	case protowire.Fixed32Type:
+		return 4
+	case protowire.Fixed64Type:
+		return 8
+	case protowire.BytesType:
+		if kind == protoreflect.StringKind {
+			return protowire.SizeBytes(len(v.String()))
+		}
+		return protowire.SizeBytes(len(v.Bytes()))
+	default:
+		return protowire.SizeVarint(scalarBits(kind, v))
+	}
+}
+
+func sizeValue(xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, opts proto.MarshalOptions) int {
+	switch xd.Kind() {
+	case protoreflect.MessageKind:
+		return protowire.SizeBytes(opts.Size(v.Message().Interface()))
+	case protoreflect.GroupKind:
+		return opts.Size(v.Message().Interface()) + protowire.SizeTag(xd.Number())
+	default:
+		return sizeScalar(xd.Kind(), v)
+	}
+}
+
+func appendValue(b []byte, xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, opts proto.MarshalOptions) ([]byte, error) {
+	var err error
+	switch xd.Kind() {
+	case protoreflect.MessageKind:
+		b = protowire.AppendVarint(b, uint64(opts.Size(v.Message().Interface())))
+		return opts.MarshalAppend(b, v.Message().Interface())
+	case protoreflect.GroupKind:
+		if b, err = opts.MarshalAppend(b, v.Message().Interface()); err != nil {
+			return b, err
+		}
+		return protowire.AppendTag(b, xd.Number(), protowire.EndGroupType), nil
+	default:
+		return AppendScalar(b, xd.Kind(), v), nil
+	}
+}
+
+func sizeField(xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, opts proto.MarshalOptions) int {
+	tagSize := protowire.SizeTag(xd.Number())
+	if !xd.IsList() {
+		return tagSize + sizeValue(xd, v, opts)
+	}
+	list := v.List()
+	if list.Len() == 0 {
+		return 0
+	}
+	n := 0
+	if xd.IsPacked() {
+		for i := 0; i < list.Len(); i++ {
+			n += sizeScalar(xd.Kind(), list.Get(i))
+		}
+		return tagSize + protowire.SizeBytes(n)
+	}
+	for i := 0; i < list.Len(); i++ {
+		n += tagSize + sizeValue(xd, list.Get(i), opts)
+	}
+	return n
+}
+
+func appendField(b []byte, xd protoreflect.ExtensionTypeDescriptor, v protoreflect.Value, opts proto.MarshalOptions) ([]byte, error) {
+	if !xd.IsList() {
+		b = protowire.AppendTag(b, xd.Number(), WireType(xd.Kind()))
+		return appendValue(b, xd, v, opts)
+	}
+	list := v.List()
+	if list.Len() == 0 {
+		return b, nil
+	}
+	if xd.IsPacked() {
+		n := 0
+		for i := 0; i < list.Len(); i++ {
+			n += sizeScalar(xd.Kind(), list.Get(i))
+		}
+		b = protowire.AppendTag(b, xd.Number(), protowire.BytesType)
+		b = protowire.AppendVarint(b, uint64(n))
+		for i := 0; i < list.Len(); i++ {
+			b = AppendScalar(b, xd.Kind(), list.Get(i))
+		}
+		return b, nil
+	}
+	var err error
+	for i := 0; i < list.Len(); i++ {
+		b = protowire.AppendTag(b, xd.Number(), WireType(xd.Kind()))
+		if b, err = appendValue(b, xd, list.Get(i), opts); err != nil {
+			return b, err
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,224 @@
+// Package fieldmask validates the paths of google.protobuf.FieldMask against
+// message descriptors and applies field masks to messages through reflection,
+// which is fast reflection for the messages generated with the "fast" feature.
+//
+// A path is a dot separated list of field names, e.g. "header.height", in which
+// every field but the last is a singular message field. A path covers the fields
+// of the message it names, so "header" covers "header.height".
+//
+// The Path and MessagePath types are the types of the path constants generated
+// with the "fieldmask" feature, which check paths at compile time.
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Path is a path of the messages of type M.
+type Path[M proto.Message] string
+
+// MessagePath is a path of the messages of type M naming a singular message
+// field of type N.
+type MessagePath[M, N proto.Message] string
+
+// Path returns the path naming the message field itself.
+func (p MessagePath[M, N]) Path() Path[M] {
+	return Path[M](p)
+}
+
+// Sub returns the path of the message field sub of p.
+func (p MessagePath[M, N]) Sub(sub Path[N]) Path[M] {
+	return Path[M](string(p) + "." + string(sub))
+}
+
+// New returns a field mask of the given paths.
+func New[M proto.Message](paths ...Path[M]) *fieldmaskpb.FieldMask {
+	mask := &fieldmaskpb.FieldMask{Paths: make([]string, len(paths))}
+	for i, path := range paths {
+		mask.Paths[i] = string(path)
+	}
+	return mask
+}
+
+// ValidatePath checks that path is a path of the messages described by md.
+func ValidatePath(md protoreflect.MessageDescriptor, path string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return fmt.Errorf("invalid field mask path %q: message %s has no field %q", path, md.FullName(), name)
+		}
+		if i == len(names)-1 {
+			break
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("invalid field mask path %q: field %s is not a singular message field", path, fd.FullName())
+		}
+		md = fd.Message()
+	}
+	return nil
+}
+
+// Validate checks that the paths of mask are paths of the messages described
+// by md.
+func Validate(md protoreflect.MessageDescriptor, mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.GetPaths() {
+		if err := ValidatePath(md, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tree is a set of paths, indexed by their first field name. The tree of a
+// field is nil when the field itself is in the set.
+type tree map[protoreflect.Name]tree
+
+// newTree returns the tree of the paths of mask after validating them.
+func newTree(md protoreflect.MessageDescriptor, mask *fieldmaskpb.FieldMask) (tree, error) {
+	if err := Validate(md, mask); err != nil {
+		return nil, err
+	}
+	root := tree{}
+	for _, path := range mask.GetPaths() {
+		t := root
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			sub, ok := t[protoreflect.Name(name)]
+			if ok && sub == nil {
+				// the path is covered by a shorter one
+				break
+			}
+			if i == len(names)-1 {
+				t[protoreflect.Name(name)] = nil
+				break
+			}
+			if !ok {
+				sub = tree{}
+				t[protoreflect.Name(name)] = sub
+			}
+			t = sub
+		}
+	}
+	return root, nil
+}
+
+// Project returns a copy of m with only the fields covered by mask, the unknown
+// fields being dropped like by ClearExcept.
+func Project(m proto.Message, mask *fieldmaskpb.FieldMask) (proto.Message, error) {
+	projected := proto.Clone(m)
+	if err := ClearExcept(projected, mask); err != nil {
+		return nil, err
+	}
+	return projected, nil
+}
+
+// ClearExcept clears the fields of m which are not covered by mask. The unknown
+// fields of m, and of the messages of which only some fields are covered, are
+// cleared too, as they cannot be covered by any path.
+func ClearExcept(m proto.Message, mask *fieldmaskpb.FieldMask) error {
+	msg := m.ProtoReflect()
+	t, err := newTree(msg.Descriptor(), mask)
+	if err != nil {
+		return err
+	}
+	clearExcept(msg, t)
+	return nil
+}
+
+func clearExcept(msg protoreflect.Message, t tree) {
+	msg.SetUnknown(nil)
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := t[fd.Name()]
+		switch {
+		case !ok || fd.IsExtension():
+			msg.Clear(fd)
+		case sub != nil:
+			clearExcept(v.Message(), sub)
+		}
+		return true
+	})
+}
+
+// Merge sets the fields of dst covered by mask to the values of the fields of
+// src, clearing the fields which are not populated in src, following the update
+// semantics of google.protobuf.FieldMask. The values are copied, so that dst
+// does not share any list, map or message with src.
+func Merge(dst, src proto.Message, mask *fieldmaskpb.FieldMask) error {
+	d, s := dst.ProtoReflect(), src.ProtoReflect()
+	if d.Descriptor().FullName() != s.Descriptor().FullName() {
+		return fmt.Errorf("cannot merge %s into %s", s.Descriptor().FullName(), d.Descriptor().FullName())
+	}
+	t, err := newTree(d.Descriptor(), mask)
+	if err != nil {
+		return err
+	}
+	merge(d, s, t)
+	return nil
+}
+
+// merge merges the fields of src covered by t into dst, src being nil when
+// the fields are cleared.
+func merge(dst, src protoreflect.Message, t tree) {
+	fields := dst.Descriptor().Fields()
+	for name, sub := range t {
+		fd := fields.ByName(name)
+		populated := src != nil && src.Has(fd)
+		if sub == nil {
+			// the field is only cleared if populated, not to clear another
+			// member of its oneof
+			if dst.Has(fd) {
+				dst.Clear(fd)
+			}
+			if populated {
+				dst.Set(fd, copyValue(dst, fd, src.Get(fd)))
+			}
+			continue
+		}
+		switch {
+		case populated:
+			merge(dst.Mutable(fd).Message(), src.Get(fd).Message(), sub)
+		case dst.Has(fd):
+			merge(dst.Mutable(fd).Message(), nil, sub)
+		}
+	}
+}
+
+// copyValue returns a deep copy of v, the value of the field fd of messages
+// of the same type as msg.
+func copyValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	switch {
+	case fd.IsList():
+		list := msg.NewField(fd).List()
+		src := v.List()
+		for i := 0; i < src.Len(); i++ {
+			list.Append(copySingular(src.Get(i)))
+		}
+		return protoreflect.ValueOfList(list)
+	case fd.IsMap():
+		m := msg.NewField(fd).Map()
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			m.Set(k, copySingular(v))
+			return true
+		})
+		return protoreflect.ValueOfMap(m)
+	default:
+		return copySingular(v)
+	}
+}
+
+// copySingular returns a deep copy of the singular value v.
+func copySingular(v protoreflect.Value) protoreflect.Value {
+	switch x := v.Interface().(type) {
+	case protoreflect.Message:
+		return protoreflect.ValueOfMessage(proto.Clone(x.Interface()).ProtoReflect())
+	case []byte:
+		return protoreflect.ValueOfBytes(append([]byte(nil), x...))
+	}
+	return v
+}
@@ -0,0 +1,135 @@
+package fieldmask_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/cosmos/cosmos-proto/runtime/fieldmask"
+	"github.com/cosmos/cosmos-proto/testpb"
+)
+
+func newA() *testpb.A {
+	return &testpb.A{
+		SomeBoolean: true,
+		INT32:       1,
+		STRING:      "string",
+		BYTES:       []byte("bytes"),
+		MESSAGE:     &testpb.B{X: "message"},
+		MAP:         map[string]*testpb.B{"k": {X: "map"}},
+		LIST:        []*testpb.B{{X: "list"}},
+		ONEOF:       &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "oneof"}},
+		Imported:    &testpb.ImportedMessage{},
+	}
+}
+
+func TestPaths(t *testing.T) {
+	mask := fieldmask.New(testpb.A_INT32_path, testpb.A_MESSAGE_path.Sub(testpb.B_X_path), testpb.A_Imported_path.Path())
+	require.Equal(t, []string{"INT32", "MESSAGE.x", "imported"}, mask.Paths)
+	require.NoError(t, fieldmask.Validate((&testpb.A{}).ProtoReflect().Descriptor(), mask))
+}
+
+func TestValidate(t *testing.T) {
+	md := (&testpb.A{}).ProtoReflect().Descriptor()
+	require.NoError(t, fieldmask.Validate(md, nil))
+	require.NoError(t, fieldmask.ValidatePath(md, "ONEOF_B.x"))
+	require.EqualError(t, fieldmask.ValidatePath(md, "unknown"), `invalid field mask path "unknown": message A has no field "unknown"`)
+	require.EqualError(t, fieldmask.ValidatePath(md, "MESSAGE.unknown"), `invalid field mask path "MESSAGE.unknown": message B has no field "unknown"`)
+	require.EqualError(t, fieldmask.ValidatePath(md, "LIST.x"), `invalid field mask path "LIST.x": field A.LIST is not a singular message field`)
+	require.EqualError(t, fieldmask.ValidatePath(md, "STRING.x"), `invalid field mask path "STRING.x": field A.STRING is not a singular message field`)
+	require.EqualError(t, fieldmask.ValidatePath(md, ""), `invalid field mask path "": message A has no field ""`)
+}
+
+func TestProject(t *testing.T) {
+	msg := newA()
+	projected, err := fieldmask.Project(msg, fieldmask.New(testpb.A_INT32_path, testpb.A_MAP_path, testpb.A_ONEOF_B_path.Sub(testpb.B_X_path)))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&testpb.A{
+		INT32: 1,
+		MAP:   map[string]*testpb.B{"k": {X: "map"}},
+		ONEOF: &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "oneof"}},
+	}, projected), "%v", projected)
+	// the message is not modified
+	require.True(t, proto.Equal(newA(), msg))
+
+	_, err = fieldmask.Project(msg, &fieldmaskpb.FieldMask{Paths: []string{"unknown"}})
+	require.Error(t, err)
+}
+
+func TestClearExcept(t *testing.T) {
+	msg := newA()
+	// the shorter path covers the longer one, in any order
+	require.NoError(t, fieldmask.ClearExcept(msg, &fieldmaskpb.FieldMask{Paths: []string{"MESSAGE.x", "MESSAGE", "LIST", "imported"}}))
+	require.True(t, proto.Equal(&testpb.A{
+		MESSAGE:  &testpb.B{X: "message"},
+		LIST:     []*testpb.B{{X: "list"}},
+		Imported: &testpb.ImportedMessage{},
+	}, msg), "%v", msg)
+
+	msg = newA()
+	require.NoError(t, fieldmask.ClearExcept(msg, nil))
+	require.True(t, proto.Equal(&testpb.A{}, msg))
+}
+
+func TestProjectUnknownFields(t *testing.T) {
+	unknown := protowire.AppendString(protowire.AppendTag(nil, 100, protowire.BytesType), "unknown")
+	msg := newA()
+	msg.ProtoReflect().SetUnknown(unknown)
+	msg.MESSAGE.ProtoReflect().SetUnknown(unknown)
+	msg.LIST[0].ProtoReflect().SetUnknown(unknown)
+
+	// the unknown fields are cleared at every projected level, fully covered
+	// messages being kept as they are
+	projected, err := fieldmask.Project(msg, fieldmask.New(testpb.A_MESSAGE_path.Sub(testpb.B_X_path), testpb.A_LIST_path))
+	require.NoError(t, err)
+	got := projected.(*testpb.A)
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+	require.Empty(t, got.MESSAGE.ProtoReflect().GetUnknown())
+	require.Equal(t, unknown, []byte(got.LIST[0].ProtoReflect().GetUnknown()))
+	require.Equal(t, "message", got.MESSAGE.X)
+}
+
+func TestMerge(t *testing.T) {
+	dst := &testpb.A{
+		INT32:   2,
+		UINT32:  3,
+		STRING:  "dst",
+		MESSAGE: &testpb.B{X: "dst"},
+		LIST:    []*testpb.B{{X: "dst"}},
+	}
+	src := newA()
+	mask := fieldmask.New(testpb.A_INT32_path, testpb.A_UINT32_path, testpb.A_MESSAGE_path.Sub(testpb.B_X_path), testpb.A_LIST_path, testpb.A_MAP_path, testpb.A_BYTES_path)
+	require.NoError(t, fieldmask.Merge(dst, src, mask))
+	require.True(t, proto.Equal(&testpb.A{
+		INT32:   1,
+		STRING:  "dst",
+		BYTES:   []byte("bytes"),
+		MESSAGE: &testpb.B{X: "message"},
+		MAP:     map[string]*testpb.B{"k": {X: "map"}},
+		LIST:    []*testpb.B{{X: "list"}},
+	}, dst), "%v", dst)
+
+	// the values are copied
+	src.LIST[0].X = "changed"
+	src.MAP["k"].X = "changed"
+	src.BYTES[0] = 'B'
+	require.Equal(t, "list", dst.LIST[0].X)
+	require.Equal(t, "map", dst.MAP["k"].X)
+	require.Equal(t, []byte("bytes"), dst.BYTES)
+
+	// the sub-fields of the fields unpopulated in src are cleared
+	require.NoError(t, fieldmask.Merge(dst, &testpb.A{}, fieldmask.New(testpb.A_MESSAGE_path.Sub(testpb.B_X_path), testpb.A_ONEOF_B_path.Sub(testpb.B_X_path))))
+	require.True(t, proto.Equal(&testpb.B{}, dst.MESSAGE))
+	require.Nil(t, dst.ONEOF)
+
+	// a mask naming a oneof member does not clear the other members
+	dst = &testpb.A{ONEOF: &testpb.A_ONEOF_STRING{ONEOF_STRING: "keep"}}
+	require.NoError(t, fieldmask.Merge(dst, &testpb.A{}, fieldmask.New(testpb.A_ONEOF_B_path.Path())))
+	require.Equal(t, "keep", dst.GetONEOF_STRING())
+
+	require.Error(t, fieldmask.Merge(dst, &testpb.B{}, mask))
+	require.Error(t, fieldmask.Merge(dst, src, &fieldmaskpb.FieldMask{Paths: []string{"MAP.x"}}))
+}
@@ -0,0 +1,116 @@
+// Package grpccodec implements a gRPC codec which marshals and unmarshals messages
+// by calling their ProtoMethods directly, i.e. the fast reflection code generated
+// by protoc-gen-go-pulsar, and which marshals into buffers of the gRPC buffer pool.
+//
+// The codec is registered in place of the default one with:
+//
+//	encoding.RegisterCodecV2(grpccodec.Codec{})
+//
+// or used for a single connection or server with grpc.ForceCodecV2 and
+// grpc.ForceServerCodecV2.
+package grpccodec
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Name is the name of the codec. It is the name of the default gRPC
+// codec so that the codec can replace it transparently.
+const Name = "proto"
+
+var _ encoding.CodecV2 = Codec{}
+
+// Codec is a gRPC codec for protobuf messages using their ProtoMethods.
+// Messages without ProtoMethods are handled by the proto package.
+type Codec struct{}
+
+// Name implements encoding.CodecV2.
+func (Codec) Name() string {
+	return Name
+}
+
+// Marshal implements encoding.CodecV2. The returned buffers come from
+// mem.DefaultBufferPool and are released by gRPC once written.
+func (Codec) Marshal(v any) (mem.BufferSlice, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("grpccodec: failed to marshal, message is %T, want proto.Message", v)
+	}
+
+	msg := m.ProtoReflect()
+	methods := msg.ProtoMethods()
+	if methods == nil || methods.Size == nil || methods.Marshal == nil {
+		return marshalProto(m)
+	}
+	// the required fields are checked, as proto.Marshal does
+	if err := proto.CheckInitialized(m); err != nil {
+		return nil, err
+	}
+
+	size := methods.Size(protoiface.SizeInput{Message: msg}).Size
+	if mem.IsBelowBufferPoolingThreshold(size) {
+		out, err := methods.Marshal(protoiface.MarshalInput{Message: msg, Buf: make([]byte, 0, size)})
+		if err != nil {
+			return nil, err
+		}
+		return mem.BufferSlice{mem.SliceBuffer(out.Buf)}, nil
+	}
+
+	pool := mem.DefaultBufferPool()
+	buf := pool.Get(size)
+	// the generated marshal code writes in place when the buffer is large enough
+	out, err := methods.Marshal(protoiface.MarshalInput{Message: msg, Buf: (*buf)[:0]})
+	if err != nil {
+		pool.Put(buf)
+		return nil, err
+	}
+	*buf = out.Buf
+	return mem.BufferSlice{mem.NewBuffer(buf, pool)}, nil
+}
+
+func marshalProto(m proto.Message) (mem.BufferSlice, error) {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return mem.BufferSlice{mem.SliceBuffer(b)}, nil
+}
+
+// Unmarshal implements encoding.CodecV2. The data is released once
+// decoded, which is safe since the decoded message does not alias it.
+func (Codec) Unmarshal(data mem.BufferSlice, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("grpccodec: failed to unmarshal, message is %T, want proto.Message", v)
+	}
+
+	buf := data.MaterializeToBuffer(mem.DefaultBufferPool())
+	defer buf.Free()
+
+	msg := m.ProtoReflect()
+	methods := msg.ProtoMethods()
+	if methods == nil || methods.Unmarshal == nil {
+		return proto.Unmarshal(buf.ReadOnlyData(), m)
+	}
+
+	// the message is reset first, as proto.Unmarshal does
+	proto.Reset(m)
+	out, err := methods.Unmarshal(protoiface.UnmarshalInput{
+		Message:  msg,
+		Buf:      buf.ReadOnlyData(),
+		Resolver: protoregistry.GlobalTypes,
+		Depth:    protowire.DefaultRecursionLimit,
+	})
+	if err != nil || out.Flags&protoiface.UnmarshalInitialized != 0 {
+		return err
+	}
+	// the required fields are checked, as proto.Unmarshal does
+	return proto.CheckInitialized(m)
+}
@@ -0,0 +1,243 @@
+package grpccodec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/runtime/grpccodec"
+	"github.com/cosmos/cosmos-proto/runtime/grpccodec/internal/testprotos/querytest"
+)
+
+// countingCodec counts the messages going through the codec.
+type countingCodec struct {
+	grpccodec.Codec
+	marshaled, unmarshaled *atomic.Int64
+}
+
+func newCountingCodec() countingCodec {
+	return countingCodec{marshaled: new(atomic.Int64), unmarshaled: new(atomic.Int64)}
+}
+
+func (c countingCodec) Marshal(v any) (mem.BufferSlice, error) {
+	c.marshaled.Add(1)
+	return c.Codec.Marshal(v)
+}
+
+func (c countingCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	c.unmarshaled.Add(1)
+	return c.Codec.Unmarshal(data, v)
+}
+
+type queryServer struct {
+	querytest.UnimplementedQueryServer
+}
+
+func (queryServer) GetSupply(_ context.Context, req *querytest.QuerySupplyRequest) (*cosmostest.Supply, error) {
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	return supply(req.Denom, 3), nil
+}
+
+func (queryServer) WatchSupply(req *querytest.QuerySupplyRequest, stream grpc.ServerStreamingServer[cosmostest.Supply]) error {
+	for i := int64(1); i <= 3; i++ {
+		if err := stream.Send(supply(req.Denom, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (queryServer) SumCoins(stream grpc.ClientStreamingServer[cosmostest.Coin, cosmostest.Coin]) error {
+	var sum int64
+	var denom string
+	for {
+		coin, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&cosmostest.Coin{Denom: denom, Amount: cosmostest.NewInt(sum)})
+		}
+		if err != nil {
+			return err
+		}
+		denom = coin.Denom
+		sum += coin.Amount.BigInt().Int64()
+	}
+}
+
+// supply returns a Supply with a history of n coins, large enough to
+// exercise the pooled buffers.
+func supply(denom string, n int64) *cosmostest.Supply {
+	s := &cosmostest.Supply{
+		Total:    &cosmostest.Coin{Denom: denom, Amount: cosmostest.NewInt(n * 1000)},
+		Checksum: cosmostest.Checksum{1, 2, 3},
+	}
+	for i := int64(0); i < n*100; i++ {
+		s.History = append(s.History, &cosmostest.Coin{Denom: denom, Amount: cosmostest.NewInt(i)})
+	}
+	return s
+}
+
+func dial(t *testing.T, codec countingCodec) querytest.QueryClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ForceServerCodecV2(codec))
+	querytest.RegisterQueryServer(srv, queryServer{})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodecV2(codec)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return querytest.NewQueryClient(conn)
+}
+
+func TestUnary(t *testing.T) {
+	codec := newCountingCodec()
+	client := dial(t, codec)
+
+	resp, err := client.GetSupply(context.Background(), &querytest.QuerySupplyRequest{Denom: "atom"})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(supply("atom", 3), resp))
+	// the request and the response, on both sides
+	require.Equal(t, int64(2), codec.marshaled.Load())
+	require.Equal(t, int64(2), codec.unmarshaled.Load())
+
+	_, err = client.GetSupply(context.Background(), &querytest.QuerySupplyRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServerStream(t *testing.T) {
+	client := dial(t, newCountingCodec())
+
+	stream, err := client.WatchSupply(context.Background(), &querytest.QuerySupplyRequest{Denom: "atom"})
+	require.NoError(t, err)
+	for i := int64(1); i <= 3; i++ {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(supply("atom", i), resp))
+	}
+	_, err = stream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestClientStream(t *testing.T) {
+	client := dial(t, newCountingCodec())
+
+	stream, err := client.SumCoins(context.Background())
+	require.NoError(t, err)
+	for i := int64(1); i <= 4; i++ {
+		require.NoError(t, stream.Send(&cosmostest.Coin{Denom: "atom", Amount: cosmostest.NewInt(i)}))
+	}
+	resp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, "atom", resp.Denom)
+	require.Equal(t, "10", resp.Amount.String())
+}
+
+func TestUnimplemented(t *testing.T) {
+	client := dial(t, newCountingCodec())
+
+	stream, err := client.EchoCoins(context.Background())
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestCodec(t *testing.T) {
+	codec := grpccodec.Codec{}
+	require.Equal(t, "proto", codec.Name())
+
+	t.Run("pooled", func(t *testing.T) {
+		msg := supply("atom", 10)
+		data, err := codec.Marshal(msg)
+		require.NoError(t, err)
+		want, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, want, data.Materialize())
+
+		got := new(cosmostest.Supply)
+		require.NoError(t, codec.Unmarshal(data, got))
+		require.True(t, proto.Equal(msg, got))
+	})
+
+	t.Run("unmarshal resets", func(t *testing.T) {
+		data, err := codec.Marshal(&cosmostest.Coin{Denom: "atom"})
+		require.NoError(t, err)
+		got := &cosmostest.Coin{Denom: "osmo", Amount: cosmostest.NewInt(1)}
+		require.NoError(t, codec.Unmarshal(data, got))
+		require.True(t, proto.Equal(&cosmostest.Coin{Denom: "atom"}, got))
+	})
+
+	t.Run("proto message", func(t *testing.T) {
+		data, err := codec.Marshal(wrapperspb.String("hello"))
+		require.NoError(t, err)
+		got := new(wrapperspb.StringValue)
+		require.NoError(t, codec.Unmarshal(data, got))
+		require.Equal(t, "hello", got.Value)
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		// uninitialized messages are rejected like by the proto package
+		partial := &descriptorpb.UninterpretedOption_NamePart{NamePart: proto.String("name")}
+		_, err := codec.Marshal(partial)
+		require.Error(t, err)
+		_, err = proto.Marshal(partial)
+		require.Error(t, err)
+
+		bz, err := proto.MarshalOptions{AllowPartial: true}.Marshal(partial)
+		require.NoError(t, err)
+		require.Error(t, codec.Unmarshal(mem.BufferSlice{mem.SliceBuffer(bz)}, new(descriptorpb.UninterpretedOption_NamePart)))
+
+		initialized := &descriptorpb.UninterpretedOption_NamePart{NamePart: proto.String("name"), IsExtension: proto.Bool(false)}
+		data, err := codec.Marshal(initialized)
+		require.NoError(t, err)
+		got := new(descriptorpb.UninterpretedOption_NamePart)
+		require.NoError(t, codec.Unmarshal(data, got))
+		require.True(t, proto.Equal(initialized, got))
+	})
+
+	t.Run("not a proto message", func(t *testing.T) {
+		_, err := codec.Marshal("hello")
+		require.Error(t, err)
+		require.Error(t, codec.Unmarshal(nil, new(string)))
+	})
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	msg := &cosmostest.Genesis{Aliases: map[string]string{"alice": "cosmos1alice"}}
+	for i := 0; i < 1000; i++ {
+		msg.Admins = append(msg.Admins, "cosmos1admin")
+	}
+	codec := grpccodec.Codec{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data, err := codec.Marshal(msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		data.Free()
+	}
+}
@@ -0,0 +1,23 @@
+module github.com/cosmos/cosmos-proto/runtime/grpccodec
+
+go 1.21
+
+require (
+	github.com/cosmos/cosmos-proto v0.0.0-00010101000000-000000000000
+	github.com/google/go-cmp v0.6.0
+	github.com/stretchr/testify v1.8.2
+	google.golang.org/grpc v1.66.0
+	google.golang.org/protobuf v1.34.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/cosmos/cosmos-proto => ../..
@@ -0,0 +1,803 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package querytest
+
+import (
+	context "context"
+	fmt "fmt"
+	cosmostest "github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_QuerySupplyRequest       protoreflect.MessageDescriptor
+	fd_QuerySupplyRequest_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_init()
+	md_QuerySupplyRequest = File_runtime_grpccodec_internal_testprotos_querytest_query_proto.Messages().ByName("QuerySupplyRequest")
+	fd_QuerySupplyRequest_denom = md_QuerySupplyRequest.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySupplyRequest)(nil)
+
+type fastReflection_QuerySupplyRequest QuerySupplyRequest
+
+func (x *QuerySupplyRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySupplyRequest)(x)
+}
+
+func (x *QuerySupplyRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_runtime_grpccodec_internal_testprotos_querytest_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySupplyRequest_messageType fastReflection_QuerySupplyRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySupplyRequest_messageType{}
+
+type fastReflection_QuerySupplyRequest_messageType struct{}
+
+func (x fastReflection_QuerySupplyRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySupplyRequest)(nil)
+}
+func (x fastReflection_QuerySupplyRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySupplyRequest)
+}
+func (x fastReflection_QuerySupplyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySupplyRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySupplyRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySupplyRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySupplyRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySupplyRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySupplyRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySupplyRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySupplyRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySupplyRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySupplyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QuerySupplyRequest_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySupplyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "querytest.QuerySupplyRequest.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: querytest.QuerySupplyRequest"))
+		}
+		panic(fmt.Errorf("message querytest.QuerySupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "querytest.QuerySupplyRequest.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: querytest.QuerySupplyRequest"))
+		}
+		panic(fmt.Errorf("message querytest.QuerySupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySupplyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "querytest.QuerySupplyRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: querytest.QuerySupplyRequest"))
+		}
+		panic(fmt.Errorf("message querytest.QuerySupplyRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "querytest.QuerySupplyRequest.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: querytest.QuerySupplyRequest"))
+		}
+		panic(fmt.Errorf("message querytest.QuerySupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "querytest.QuerySupplyRequest.denom":
+		panic(fmt.Errorf("field denom of message querytest.QuerySupplyRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: querytest.QuerySupplyRequest"))
+		}
+		panic(fmt.Errorf("message querytest.QuerySupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySupplyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "querytest.QuerySupplyRequest.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: querytest.QuerySupplyRequest"))
+		}
+		panic(fmt.Errorf("message querytest.QuerySupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySupplyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in querytest.QuerySupplyRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySupplyRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySupplyRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (*fastReflection_QuerySupplyRequest) ProtoMethods() *protoiface.Methods {
+	return fastReflection_QuerySupplyRequestProtoMethods
+}
+
+var fastReflection_QuerySupplyRequestProtoMethods *protoiface.Methods
+
+func init() {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySupplyRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySupplyRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySupplyRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	checkInitialized := func(input protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		return protoiface.CheckInitializedOutput{}, nil
+	}
+	fastReflection_QuerySupplyRequestProtoMethods = &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		CheckInitialized:  checkInitialized,
+		Merge:             nil,
+	}
+}
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_GetSupply_FullMethodName   = "/querytest.Query/GetSupply"
+	Query_WatchSupply_FullMethodName = "/querytest.Query/WatchSupply"
+	Query_SumCoins_FullMethodName    = "/querytest.Query/SumCoins"
+	Query_EchoCoins_FullMethodName   = "/querytest.Query/EchoCoins"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetSupply returns the supply of a denomination.
+	GetSupply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*cosmostest.Supply, error)
+	// WatchSupply streams the supply of a denomination each time it changes.
+	WatchSupply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[cosmostest.Supply], error)
+	// SumCoins returns the sum of the streamed coins.
+	SumCoins(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[cosmostest.Coin, cosmostest.Coin], error)
+	// EchoCoins streams back the streamed coins.
+	EchoCoins(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[cosmostest.Coin, cosmostest.Coin], error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetSupply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*cosmostest.Supply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(cosmostest.Supply)
+	err := c.cc.Invoke(ctx, Query_GetSupply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WatchSupply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[cosmostest.Supply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_WatchSupply_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QuerySupplyRequest, cosmostest.Supply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (c *queryClient) SumCoins(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[cosmostest.Coin, cosmostest.Coin], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], Query_SumCoins_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[cosmostest.Coin, cosmostest.Coin]{ClientStream: stream}
+	return x, nil
+}
+
+func (c *queryClient) EchoCoins(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[cosmostest.Coin, cosmostest.Coin], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[2], Query_EchoCoins_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[cosmostest.Coin, cosmostest.Coin]{ClientStream: stream}
+	return x, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+type QueryServer interface {
+	// GetSupply returns the supply of a denomination.
+	GetSupply(context.Context, *QuerySupplyRequest) (*cosmostest.Supply, error)
+	// WatchSupply streams the supply of a denomination each time it changes.
+	WatchSupply(*QuerySupplyRequest, grpc.ServerStreamingServer[cosmostest.Supply]) error
+	// SumCoins returns the sum of the streamed coins.
+	SumCoins(grpc.ClientStreamingServer[cosmostest.Coin, cosmostest.Coin]) error
+	// EchoCoins streams back the streamed coins.
+	EchoCoins(grpc.BidiStreamingServer[cosmostest.Coin, cosmostest.Coin]) error
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) GetSupply(context.Context, *QuerySupplyRequest) (*cosmostest.Supply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupply not implemented")
+}
+func (UnimplementedQueryServer) WatchSupply(*QuerySupplyRequest, grpc.ServerStreamingServer[cosmostest.Supply]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSupply not implemented")
+}
+func (UnimplementedQueryServer) SumCoins(grpc.ClientStreamingServer[cosmostest.Coin, cosmostest.Coin]) error {
+	return status.Errorf(codes.Unimplemented, "method SumCoins not implemented")
+}
+func (UnimplementedQueryServer) EchoCoins(grpc.BidiStreamingServer[cosmostest.Coin, cosmostest.Coin]) error {
+	return status.Errorf(codes.Unimplemented, "method EchoCoins not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_GetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSupply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchSupply_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuerySupplyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).WatchSupply(m, &grpc.GenericServerStream[QuerySupplyRequest, cosmostest.Supply]{ServerStream: stream})
+}
+
+func _Query_SumCoins_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueryServer).SumCoins(&grpc.GenericServerStream[cosmostest.Coin, cosmostest.Coin]{ServerStream: stream})
+}
+
+func _Query_EchoCoins_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueryServer).EchoCoins(&grpc.GenericServerStream[cosmostest.Coin, cosmostest.Coin]{ServerStream: stream})
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "querytest.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSupply",
+			Handler:    _Query_GetSupply_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSupply",
+			Handler:       _Query_WatchSupply_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SumCoins",
+			Handler:       _Query_SumCoins_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "EchoCoins",
+			Handler:       _Query_EchoCoins_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "runtime/grpccodec/internal/testprotos/querytest/query.proto",
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: runtime/grpccodec/internal/testprotos/querytest/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuerySupplyRequest is the request of the Query/GetSupply and Query/WatchSupply methods.
+type QuerySupplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *QuerySupplyRequest) Reset() {
+	*x = QuerySupplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_grpccodec_internal_testprotos_querytest_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySupplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySupplyRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySupplyRequest.ProtoReflect.Descriptor instead.
+func (*QuerySupplyRequest) Descriptor() ([]byte, []int) {
+	return file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuerySupplyRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+var File_runtime_grpccodec_internal_testprotos_querytest_query_proto protoreflect.FileDescriptor
+
+var file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDesc = []byte{
+	0x0a, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x74, 0x65, 0x73,
+	0x74, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x32,
+	0xf2, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x0b, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x30, 0x0a,
+	0x08, 0x53, 0x75, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x1a, 0x10, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x28, 0x01, 0x12,
+	0x33, 0x0a, 0x09, 0x45, 0x63, 0x68, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x1a, 0x10,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x28, 0x01, 0x30, 0x01, 0x42, 0x50, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescOnce sync.Once
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescData = file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDesc
+)
+
+func file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescGZIP() []byte {
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescOnce.Do(func() {
+		file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescData)
+	})
+	return file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDescData
+}
+
+var file_runtime_grpccodec_internal_testprotos_querytest_query_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_runtime_grpccodec_internal_testprotos_querytest_query_proto_goTypes = []interface{}{
+	(*QuerySupplyRequest)(nil), // 0: querytest.QuerySupplyRequest
+	(*cosmostest.Coin)(nil),    // 1: cosmostest.Coin
+	(*cosmostest.Supply)(nil),  // 2: cosmostest.Supply
+}
+var file_runtime_grpccodec_internal_testprotos_querytest_query_proto_depIdxs = []int32{
+	0, // 0: querytest.Query.GetSupply:input_type -> querytest.QuerySupplyRequest
+	0, // 1: querytest.Query.WatchSupply:input_type -> querytest.QuerySupplyRequest
+	1, // 2: querytest.Query.SumCoins:input_type -> cosmostest.Coin
+	1, // 3: querytest.Query.EchoCoins:input_type -> cosmostest.Coin
+	2, // 4: querytest.Query.GetSupply:output_type -> cosmostest.Supply
+	2, // 5: querytest.Query.WatchSupply:output_type -> cosmostest.Supply
+	1, // 6: querytest.Query.SumCoins:output_type -> cosmostest.Coin
+	1, // 7: querytest.Query.EchoCoins:output_type -> cosmostest.Coin
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_runtime_grpccodec_internal_testprotos_querytest_query_proto_init() }
+func file_runtime_grpccodec_internal_testprotos_querytest_query_proto_init() {
+	if File_runtime_grpccodec_internal_testprotos_querytest_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_runtime_grpccodec_internal_testprotos_querytest_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySupplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_runtime_grpccodec_internal_testprotos_querytest_query_proto_goTypes,
+		DependencyIndexes: file_runtime_grpccodec_internal_testprotos_querytest_query_proto_depIdxs,
+		MessageInfos:      file_runtime_grpccodec_internal_testprotos_querytest_query_proto_msgTypes,
+	}.Build()
+	File_runtime_grpccodec_internal_testprotos_querytest_query_proto = out.File
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_rawDesc = nil
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_goTypes = nil
+	file_runtime_grpccodec_internal_testprotos_querytest_query_proto_depIdxs = nil
+}
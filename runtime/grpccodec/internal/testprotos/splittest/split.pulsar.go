@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package splittest
+
+import (
+	_ "github.com/cosmos/cosmos-proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.9
+// source: runtime/grpccodec/internal/testprotos/splittest/split.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Incremented is emitted when a counter is incremented.
+type Incremented struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	By   uint64 `protobuf:"varint,2,opt,name=by,proto3" json:"by,omitempty"`
+}
+
+func (x *Incremented) Reset() {
+	*x = Incremented{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Incremented) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incremented) ProtoMessage() {}
+
+// Deprecated: Use Incremented.ProtoReflect.Descriptor instead.
+func (*Incremented) Descriptor() ([]byte, []int) {
+	return file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Incremented) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Incremented) GetBy() uint64 {
+	if x != nil {
+		return x.By
+	}
+	return 0
+}
+
+// Count is the value of a set of counters.
+type Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values  map[string]uint64 `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	History []*Incremented    `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	Nested  *Count_Nested     `protobuf:"bytes,3,opt,name=nested,proto3" json:"nested,omitempty"`
+}
+
+func (x *Count) Reset() {
+	*x = Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Count) ProtoMessage() {}
+
+// Deprecated: Use Count.ProtoReflect.Descriptor instead.
+func (*Count) Descriptor() ([]byte, []int) {
+	return file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Count) GetValues() map[string]uint64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Count) GetHistory() []*Incremented {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Count) GetNested() *Count_Nested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+type Count_Nested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Sum:
+	//	*Count_Nested_Text
+	//	*Count_Nested_Number
+	Sum isCount_Nested_Sum `protobuf_oneof:"sum"`
+}
+
+func (x *Count_Nested) Reset() {
+	*x = Count_Nested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Count_Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Count_Nested) ProtoMessage() {}
+
+// Deprecated: Use Count_Nested.ProtoReflect.Descriptor instead.
+func (*Count_Nested) Descriptor() ([]byte, []int) {
+	return file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Count_Nested) GetSum() isCount_Nested_Sum {
+	if x != nil {
+		return x.Sum
+	}
+	return nil
+}
+
+func (x *Count_Nested) GetText() string {
+	if x, ok := x.GetSum().(*Count_Nested_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Count_Nested) GetNumber() int64 {
+	if x, ok := x.GetSum().(*Count_Nested_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+type isCount_Nested_Sum interface {
+	isCount_Nested_Sum()
+}
+
+type Count_Nested_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type Count_Nested_Number struct {
+	Number int64 `protobuf:"varint,2,opt,name=number,proto3,oneof"`
+}
+
+func (*Count_Nested_Text) isCount_Nested_Sum() {}
+
+func (*Count_Nested_Number) isCount_Nested_Sum() {}
+
+var File_runtime_grpccodec_internal_testprotos_splittest_split_proto protoreflect.FileDescriptor
+
+var file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDesc = []byte{
+	0x0a, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x46, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x62, 0x79, 0x3a, 0x13, 0xca, 0xb4, 0x2d, 0x0f, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x9c, 0x02, 0x0a, 0x05,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x65, 0x64, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2f, 0x0a,
+	0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x1a, 0x39,
+	0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x06, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x32, 0x40, 0x0a, 0x07, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x1a, 0x10, 0x2e, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x96, 0x01, 0xea,
+	0x9b, 0x83, 0x03, 0x41, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x20, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescOnce sync.Once
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescData = file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDesc
+)
+
+func file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescGZIP() []byte {
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescOnce.Do(func() {
+		file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescData = protoimpl.X.CompressGZIP(file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescData)
+	})
+	return file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDescData
+}
+
+var file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_runtime_grpccodec_internal_testprotos_splittest_split_proto_goTypes = []interface{}{
+	(*Incremented)(nil),  // 0: splittest.Incremented
+	(*Count)(nil),        // 1: splittest.Count
+	nil,                  // 2: splittest.Count.ValuesEntry
+	(*Count_Nested)(nil), // 3: splittest.Count.Nested
+}
+var file_runtime_grpccodec_internal_testprotos_splittest_split_proto_depIdxs = []int32{
+	2, // 0: splittest.Count.values:type_name -> splittest.Count.ValuesEntry
+	0, // 1: splittest.Count.history:type_name -> splittest.Incremented
+	3, // 2: splittest.Count.nested:type_name -> splittest.Count.Nested
+	0, // 3: splittest.Counter.Increment:input_type -> splittest.Incremented
+	1, // 4: splittest.Counter.Increment:output_type -> splittest.Count
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_runtime_grpccodec_internal_testprotos_splittest_split_proto_init() }
+func file_runtime_grpccodec_internal_testprotos_splittest_split_proto_init() {
+	if File_runtime_grpccodec_internal_testprotos_splittest_split_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Incremented); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Count_Nested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Count_Nested_Text)(nil),
+		(*Count_Nested_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_runtime_grpccodec_internal_testprotos_splittest_split_proto_goTypes,
+		DependencyIndexes: file_runtime_grpccodec_internal_testprotos_splittest_split_proto_depIdxs,
+		MessageInfos:      file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes,
+	}.Build()
+	File_runtime_grpccodec_internal_testprotos_splittest_split_proto = out.File
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_rawDesc = nil
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_goTypes = nil
+	file_runtime_grpccodec_internal_testprotos_splittest_split_proto_depIdxs = nil
+}
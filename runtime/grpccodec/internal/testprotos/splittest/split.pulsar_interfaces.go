@@ -0,0 +1,31 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+
+//go:build !pulsar_no_interfaces
+
+package splittest
+
+import (
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	proto "google.golang.org/protobuf/proto"
+)
+
+// Event is implemented by the messages emitted by Counter.
+//
+// Messages implement it by listing splittest.Event in their cosmos_proto.implements_interface option.
+type Event interface {
+	proto.Message
+	IsSplittestEvent()
+}
+
+// IsSplittestEvent marks Incremented as an implementation of splittest.Event.
+func (*Incremented) IsSplittestEvent() {}
+
+var _ Event = (*Incremented)(nil)
+
+// RegisterInterfaceImplementations registers the messages of this package
+// implementing interfaces declared with cosmos_proto.declare_interface.
+func RegisterInterfaceImplementations(registry runtime.InterfaceRegistry) {
+	registry.RegisterImplementations("splittest.Event",
+		(*Incremented)(nil),
+	)
+}
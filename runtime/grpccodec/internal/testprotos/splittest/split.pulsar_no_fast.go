@@ -0,0 +1,46 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+
+//go:build pulsar_no_fast
+
+package splittest
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+func (x *Incremented) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Count) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Count_Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_runtime_grpccodec_internal_testprotos_splittest_split_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
@@ -0,0 +1,17 @@
+//go:build !pulsar_no_fast
+
+package splittest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFastReflection(t *testing.T) {
+	for _, msg := range []proto.Message{&Incremented{}, newCount(), &Count_Nested{}} {
+		require.Contains(t, fmt.Sprintf("%T", msg.ProtoReflect()), "fastReflection_")
+	}
+}
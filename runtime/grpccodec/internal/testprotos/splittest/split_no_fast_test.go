@@ -0,0 +1,21 @@
+//go:build pulsar_no_fast
+
+package splittest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestSlowReflection checks that the messages are reflected by the protobuf runtime
+// when the fast feature is excluded with the pulsar_no_fast build tag.
+func TestSlowReflection(t *testing.T) {
+	for _, msg := range []proto.Message{&Incremented{}, newCount(), &Count_Nested{}} {
+		m := msg.ProtoReflect()
+		require.NotContains(t, fmt.Sprintf("%T", m), "fastReflection_")
+		require.Equal(t, m.Descriptor(), m.Type().Descriptor())
+	}
+}
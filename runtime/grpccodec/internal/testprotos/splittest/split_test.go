@@ -0,0 +1,36 @@
+package splittest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func newCount() *Count {
+	return &Count{
+		Values:  map[string]uint64{"a": 1, "b": 2},
+		History: []*Incremented{{Name: "a", By: 1}, {Name: "b", By: 2}},
+		Nested:  &Count_Nested{Sum: &Count_Nested_Number{Number: 3}},
+	}
+}
+
+// TestRoundTrip runs with and without the pulsar_no_* build tags.
+func TestRoundTrip(t *testing.T) {
+	msg := newCount()
+
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &Count{}
+	require.NoError(t, proto.Unmarshal(bz, got))
+	require.Empty(t, cmp.Diff(msg, got, protocmp.Transform()))
+
+	js, err := protojson.Marshal(msg)
+	require.NoError(t, err)
+	got = &Count{}
+	require.NoError(t, protojson.Unmarshal(js, got))
+	require.Empty(t, cmp.Diff(msg, got, protocmp.Transform()))
+}
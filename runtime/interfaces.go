@@ -0,0 +1,12 @@
+package runtime
+
+import "google.golang.org/protobuf/proto"
+
+// InterfaceRegistry is implemented by the registries of the messages implementing
+// interfaces declared with cosmos_proto declare_interface. It is the argument of the
+// RegisterInterfaceImplementations functions generated by the "interfaces" feature.
+type InterfaceRegistry interface {
+	// RegisterImplementations registers messages implementing the interface
+	// with the given fully-qualified name.
+	RegisterImplementations(iface string, impls ...proto.Message)
+}
@@ -0,0 +1,74 @@
+package runtime
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ protoreflect.List = (*List[int32, Int32Value])(nil)
+
+// List implements protoreflect.List on a repeated field of type []T of a
+// generated message, converting its values with C. A *List is the pointer to
+// the field itself, so that getting the list of a field does not allocate.
+// The nil *List is invalid: it is returned by Get for empty fields and cannot
+// be mutated.
+type List[T any, C ValueConverter[T]] []T
+
+// NewList returns the List of the repeated field pointed to by list.
+func NewList[T any, C ValueConverter[T]](list *[]T) *List[T, C] {
+	return (*List[T, C])(list)
+}
+
+// Slice returns the values of the list, panicking if the list is invalid.
+func (x *List[T, C]) Slice() []T {
+	return *x
+}
+
+func (x *List[T, C]) Len() int {
+	if x == nil {
+		return 0
+	}
+	return len(*x)
+}
+
+func (x *List[T, C]) Get(i int) protoreflect.Value {
+	var c C
+	return c.PBValueOf((*x)[i])
+}
+
+func (x *List[T, C]) Set(i int, value protoreflect.Value) {
+	var c C
+	(*x)[i] = c.GoValueOf(value)
+}
+
+func (x *List[T, C]) Append(value protoreflect.Value) {
+	var c C
+	*x = append(*x, c.GoValueOf(value))
+}
+
+func (x *List[T, C]) AppendMutable() protoreflect.Value {
+	var c C
+	if !c.Mutable() {
+		panic("AppendMutable can not be called on a list whose values are not of Message kind")
+	}
+	v := c.New()
+	*x = append(*x, v)
+	return c.PBValueOf(v)
+}
+
+func (x *List[T, C]) Truncate(n int) {
+	// zero the truncated values to avoid keeping them alive
+	var zero T
+	for i := n; i < len(*x); i++ {
+		(*x)[i] = zero
+	}
+	*x = (*x)[:n]
+}
+
+func (x *List[T, C]) NewElement() protoreflect.Value {
+	var c C
+	return c.PBValueOf(c.New())
+}
+
+func (x *List[T, C]) IsValid() bool {
+	return x != nil
+}
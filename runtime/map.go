@@ -0,0 +1,115 @@
+package runtime
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ protoreflect.Map = (*Map[string, int32, StringValue, Int32Value])(nil)
+
+// Map implements protoreflect.Map on a map field of type map[K]V of a
+// generated message, converting its keys with KC and its values with VC. A
+// *Map is the pointer to the field itself, so that getting the map of a field
+// does not allocate. The nil *Map is invalid: it is returned by Get for empty
+// fields and cannot be mutated.
+type Map[K comparable, V any, KC ValueConverter[K], VC ValueConverter[V]] map[K]V
+
+// NewMap returns the Map of the map field pointed to by m.
+func NewMap[K comparable, V any, KC ValueConverter[K], VC ValueConverter[V]](m *map[K]V) *Map[K, V, KC, VC] {
+	return (*Map[K, V, KC, VC])(m)
+}
+
+// GoMap returns the entries of the map, panicking if the map is invalid.
+func (x *Map[K, V, KC, VC]) GoMap() map[K]V {
+	return *x
+}
+
+func (x *Map[K, V, KC, VC]) Len() int {
+	if x == nil {
+		return 0
+	}
+	return len(*x)
+}
+
+func (x *Map[K, V, KC, VC]) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x == nil {
+		return
+	}
+	var (
+		kc KC
+		vc VC
+	)
+	for k, v := range *x {
+		if !f(protoreflect.MapKey(kc.PBValueOf(k)), vc.PBValueOf(v)) {
+			break
+		}
+	}
+}
+
+func (x *Map[K, V, KC, VC]) Has(key protoreflect.MapKey) bool {
+	if x == nil {
+		return false
+	}
+	var kc KC
+	_, ok := (*x)[kc.GoValueOf(key.Value())]
+	return ok
+}
+
+func (x *Map[K, V, KC, VC]) Clear(key protoreflect.MapKey) {
+	if x == nil {
+		return
+	}
+	var kc KC
+	delete(*x, kc.GoValueOf(key.Value()))
+}
+
+func (x *Map[K, V, KC, VC]) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x == nil {
+		return protoreflect.Value{}
+	}
+	var (
+		kc KC
+		vc VC
+	)
+	v, ok := (*x)[kc.GoValueOf(key.Value())]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return vc.PBValueOf(v)
+}
+
+func (x *Map[K, V, KC, VC]) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	var (
+		kc KC
+		vc VC
+	)
+	(*x)[kc.GoValueOf(key.Value())] = vc.GoValueOf(value)
+}
+
+func (x *Map[K, V, KC, VC]) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	var (
+		kc KC
+		vc VC
+	)
+	if !vc.Mutable() {
+		panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+	}
+	k := kc.GoValueOf(key.Value())
+	v, ok := (*x)[k]
+	if !ok {
+		v = vc.New()
+		(*x)[k] = v
+	}
+	return vc.PBValueOf(v)
+}
+
+func (x *Map[K, V, KC, VC]) NewValue() protoreflect.Value {
+	var vc VC
+	return vc.PBValueOf(vc.New())
+}
+
+func (x *Map[K, V, KC, VC]) IsValid() bool {
+	return x != nil
+}
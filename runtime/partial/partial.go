@@ -0,0 +1,428 @@
+// Package partial decodes selected fields from the encoding of a message without
+// unmarshaling it, e.g. for indexers only needing a few fields of large
+// transactions. The encoding is scanned field by field with runtime.Skip, and
+// only the values of the selected fields are decoded, including the fields of
+// nested messages and of the messages packed in google.protobuf.Any.
+//
+// A path is a dot separated list of field names, e.g. "header.height", in which
+// every field but the last is a singular or repeated message field. The names
+// following an Any field are the names of the fields of the packed message, e.g.
+// "messages.amount", and only select the fields of the packed messages having
+// them. A path selects all the fields of the message it names.
+package partial
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// errStop stops decoding when the callback of Decode returns false.
+var errStop = errors.New("stop")
+
+// Options configures a Decoder.
+type Options struct {
+	// FileResolver and TypeResolver resolve the messages packed in Anys like
+	// anyutil.Unpack does. TypeResolver also finds the types of the selected
+	// message fields, which are decoded as dynamicpb messages if not found.
+	FileResolver protodesc.Resolver
+	TypeResolver protoregistry.MessageTypeResolver
+	// Unresolved is called with the path and the type URL of the Anys whose
+	// packed message type cannot be resolved, and the error of the resolvers.
+	// These Anys are skipped, unless Unresolved returns an error, which Decode
+	// then fails with.
+	Unresolved func(path, url string, err error) error
+}
+
+// Field is a decoded value of a selected field.
+type Field struct {
+	// Path is the path of the value, e.g. "header.height", "msgs[1].amount" or
+	// "balances[atom]", in the format of anyutil.Unpacked.
+	Path string
+	// Desc is the selected field, or the list or map field of the element.
+	Desc protoreflect.FieldDescriptor
+	// Key is the key of a map entry, and is invalid for the other values.
+	Key protoreflect.MapKey
+	// Value is the value of the field, list element or map entry.
+	Value protoreflect.Value
+}
+
+// Decoder decodes the selected fields of the encodings of a message type. It is
+// safe for concurrent use.
+type Decoder struct {
+	root selection
+	opts Options
+
+	// packed caches the selections of the messages packed in Anys, by packedKey.
+	packed sync.Map
+}
+
+type packedKey struct {
+	field *field
+	name  protoreflect.FullName
+}
+
+// selection is a set of selected fields of a message, by number.
+type selection map[protoreflect.FieldNumber]*field
+
+type field struct {
+	desc protoreflect.FieldDescriptor
+	// leaf is set if the field itself is selected, and not only some of its
+	// sub-fields.
+	leaf bool
+	// sub is the selection of the sub-fields of a message field.
+	sub selection
+	// packed are the paths of the fields of the messages packed in an Any
+	// field, which are resolved for every packed type.
+	packed []string
+}
+
+// NewDecoder returns a decoder of the fields selected by paths of the messages
+// described by md, failing if a path is invalid.
+func NewDecoder(md protoreflect.MessageDescriptor, paths []string, opts Options) (*Decoder, error) {
+	root, err := newSelection(md, paths, true)
+	if err != nil {
+		return nil, err
+	}
+	return &Decoder{root: root, opts: opts}, nil
+}
+
+// newSelection returns the selection of paths in the messages described by md.
+// If strict is false, the paths which are not paths of md are ignored instead.
+func newSelection(md protoreflect.MessageDescriptor, paths []string, strict bool) (selection, error) {
+	s := selection{}
+	for _, path := range paths {
+		if err := s.add(md, path, strings.Split(path, "."), strict); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s selection) add(md protoreflect.MessageDescriptor, path string, names []string, strict bool) error {
+	invalid := func(format string, args ...interface{}) error {
+		if !strict {
+			return nil
+		}
+		return fmt.Errorf("invalid path %q: %s", path, fmt.Sprintf(format, args...))
+	}
+	fd := md.Fields().ByName(protoreflect.Name(names[0]))
+	switch {
+	case fd == nil:
+		return invalid("message %s has no field %q", md.FullName(), names[0])
+	case fd.Kind() == protoreflect.GroupKind:
+		return invalid("field %s is a group", fd.FullName())
+	case len(names) == 1:
+		s[fd.Number()] = &field{desc: fd, leaf: true}
+		return nil
+	case fd.Message() == nil || fd.IsMap():
+		return invalid("field %s is not a message field", fd.FullName())
+	}
+
+	f, ok := s[fd.Number()]
+	if ok && f.leaf {
+		// the path is covered by a shorter one
+		return nil
+	}
+	if !ok {
+		f = &field{desc: fd}
+	}
+	if fd.Message().FullName() == anyFullName {
+		f.packed = append(f.packed, strings.Join(names[1:], "."))
+	} else {
+		if f.sub == nil {
+			f.sub = selection{}
+		}
+		if err := f.sub.add(fd.Message(), path, names[1:], strict); err != nil {
+			return err
+		}
+	}
+	s[fd.Number()] = f
+	return nil
+}
+
+// Decode scans b, the encoding of a message, and calls f with the values of the
+// selected fields in the order of the encoding, until f returns false. Lists are
+// reported element by element and maps entry by entry. A singular field encoded
+// several times is reported for every occurrence, the last one being its value
+// when unmarshaled, and so are the fields of a singular message encoded several
+// times. The fields whose wire type does not match their kind are skipped, like
+// the protobuf runtime keeps them as unknown fields, and so are the Anys whose
+// packed message type cannot be resolved, see Options.Unresolved.
+func (d *Decoder) Decode(b []byte, f func(Field) bool) error {
+	if err := d.decode(b, d.root, "", f); err != nil && err != errStop {
+		return err
+	}
+	return nil
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// decode decodes the fields of s in b, the encoding of the message at path.
+func (d *Decoder) decode(b []byte, s selection, path string, f func(Field) bool) error {
+	// the numbers of elements of the list fields, which index their elements
+	var counts map[protoreflect.FieldNumber]int
+	for len(b) > 0 {
+		num, wtyp, value, n, err := consumeField(b)
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+
+		sf, ok := s[num]
+		if !ok {
+			continue
+		}
+		fieldPath := join(path, string(sf.desc.Name()))
+		switch {
+		case sf.desc.IsMap():
+			if wtyp == protowire.BytesType {
+				err = d.decodeEntry(sf, value, fieldPath, f)
+			}
+		case sf.desc.IsList():
+			if counts == nil {
+				counts = map[protoreflect.FieldNumber]int{}
+			}
+			counts[num], err = d.decodeList(sf, wtyp, value, fieldPath, counts[num], f)
+		case wtyp == runtime.WireType(sf.desc.Kind()):
+			err = d.decodeValue(sf, value, fieldPath, f)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consumeField returns the number, wire type and encoded value of the field at
+// the start of b, and the length of the field.
+func consumeField(b []byte) (protowire.Number, protowire.Type, []byte, int, error) {
+	num, wtyp, n := protowire.ConsumeTag(b)
+	if n < 0 {
+		return 0, 0, nil, 0, protowire.ParseError(n)
+	}
+	m, err := runtime.Skip(b)
+	if err != nil {
+		return 0, 0, nil, 0, err
+	}
+	if m > len(b) {
+		return 0, 0, nil, 0, io.ErrUnexpectedEOF
+	}
+	return num, wtyp, b[n:m], m, nil
+}
+
+// decodeList decodes the elements of the list field sf in value, whose first
+// element has index i, and returns the index following the last one.
+func (d *Decoder) decodeList(sf *field, wtyp protowire.Type, value []byte, path string, i int, f func(Field) bool) (int, error) {
+	kind := sf.desc.Kind()
+	if wtyp == protowire.BytesType && runtime.IsPackable(kind) {
+		packed, n := protowire.ConsumeBytes(value)
+		if n < 0 {
+			return i, protowire.ParseError(n)
+		}
+		for ; len(packed) > 0; i++ {
+			v, n := runtime.ConsumeScalar(kind, packed)
+			if n < 0 {
+				return i, protowire.ParseError(n)
+			}
+			packed = packed[n:]
+			if !f(Field{Path: fmt.Sprintf("%s[%d]", path, i), Desc: sf.desc, Value: v}) {
+				return i, errStop
+			}
+		}
+		return i, nil
+	}
+	if wtyp != runtime.WireType(kind) {
+		return i, nil
+	}
+	return i + 1, d.decodeValue(sf, value, fmt.Sprintf("%s[%d]", path, i), f)
+}
+
+// decodeValue decodes value, the encoding of a singular field or of an element
+// of a list field sf without its tag.
+func (d *Decoder) decodeValue(sf *field, value []byte, path string, f func(Field) bool) error {
+	if sf.leaf {
+		v, err := d.consumeValue(sf.desc, value)
+		if err != nil {
+			return err
+		}
+		if !f(Field{Path: path, Desc: sf.desc, Value: v}) {
+			return errStop
+		}
+		return nil
+	}
+	bz, n := protowire.ConsumeBytes(value)
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+	if sf.packed != nil {
+		return d.decodeAny(sf, bz, path, f)
+	}
+	return d.decode(bz, sf.sub, path, f)
+}
+
+// decodeEntry decodes value, the encoding of an entry of the map field sf.
+func (d *Decoder) decodeEntry(sf *field, value []byte, path string, f func(Field) bool) error {
+	entry, n := protowire.ConsumeBytes(value)
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+	kd, vd := sf.desc.MapKey(), sf.desc.MapValue()
+	// the key and value default to their zero values when missing
+	key, v := kd.Default(), protoreflect.Value{}
+	for len(entry) > 0 {
+		num, wtyp, b, n, err := consumeField(entry)
+		if err != nil {
+			return err
+		}
+		entry = entry[n:]
+		switch {
+		case num == kd.Number() && wtyp == runtime.WireType(kd.Kind()):
+			key, err = d.consumeValue(kd, b)
+		case num == vd.Number() && wtyp == runtime.WireType(vd.Kind()):
+			v, err = d.consumeValue(vd, b)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !v.IsValid() {
+		if vd.Message() != nil {
+			v = protoreflect.ValueOfMessage(d.newMessage(vd.Message()))
+		} else {
+			v = vd.Default()
+		}
+	}
+	k := key.MapKey()
+	if !f(Field{Path: fmt.Sprintf("%s[%v]", path, k.Interface()), Desc: sf.desc, Key: k, Value: v}) {
+		return errStop
+	}
+	return nil
+}
+
+// decodeAny decodes the selected fields of the message packed in b, the
+// encoding of an Any of the field sf.
+func (d *Decoder) decodeAny(sf *field, b []byte, path string, f func(Field) bool) error {
+	var (
+		url   string
+		value []byte
+	)
+	for len(b) > 0 {
+		num, wtyp, v, n, err := consumeField(b)
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+		if wtyp == protowire.BytesType && (num == 1 || num == 2) {
+			bz, _ := protowire.ConsumeBytes(v)
+			if num == 1 {
+				url = string(bz)
+			} else {
+				value = bz
+			}
+		}
+	}
+	if url == "" && len(value) == 0 {
+		return nil
+	}
+
+	md, err := d.resolve(url)
+	if err != nil {
+		if d.opts.Unresolved != nil {
+			return d.opts.Unresolved(path, url, err)
+		}
+		return nil
+	}
+	key := packedKey{field: sf, name: md.FullName()}
+	s, ok := d.packed.Load(key)
+	if !ok {
+		sel, _ := newSelection(md, sf.packed, false)
+		s, _ = d.packed.LoadOrStore(key, sel)
+	}
+	return d.decode(value, s.(selection), path, f)
+}
+
+// resolve returns the descriptor of the message type of url, found like
+// anyutil.Unpack does.
+func (d *Decoder) resolve(url string) (protoreflect.MessageDescriptor, error) {
+	typeResolver := d.opts.TypeResolver
+	if typeResolver == nil {
+		typeResolver = protoregistry.GlobalTypes
+	}
+	mt, err := typeResolver.FindMessageByURL(url)
+	if err == nil {
+		return mt.Descriptor(), nil
+	} else if err != protoregistry.NotFound {
+		return nil, err
+	}
+
+	fileResolver := d.opts.FileResolver
+	if fileResolver == nil {
+		fileResolver = protoregistry.GlobalFiles
+	}
+	desc, err := fileResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(url, "/")))
+	if err != nil {
+		return nil, fmt.Errorf("protoFiles does not have descriptor %s: %w", url, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+	return md, nil
+}
+
+// consumeValue decodes b, the encoding of a value of fd without its tag.
+func (d *Decoder) consumeValue(fd protoreflect.FieldDescriptor, b []byte) (protoreflect.Value, error) {
+	if fd.Message() == nil {
+		v, n := runtime.ConsumeScalar(fd.Kind(), b)
+		if n < 0 {
+			return v, protowire.ParseError(n)
+		}
+		// the strings of the files which are not proto2 must be valid UTF-8, as
+		// checked by proto.Unmarshal
+		if fd.Kind() == protoreflect.StringKind && fd.ParentFile().Syntax() != protoreflect.Proto2 && !utf8.ValidString(v.String()) {
+			return v, fmt.Errorf("proto: field %s contains invalid UTF-8", fd.FullName())
+		}
+		return v, nil
+	}
+	bz, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return protoreflect.Value{}, protowire.ParseError(n)
+	}
+	msg := d.newMessage(fd.Message())
+	if err := proto.Unmarshal(bz, msg.Interface()); err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// newMessage returns a new message described by md, which is a dynamicpb
+// message if its type is not found.
+func (d *Decoder) newMessage(md protoreflect.MessageDescriptor) protoreflect.Message {
+	typeResolver := d.opts.TypeResolver
+	if typeResolver == nil {
+		typeResolver = protoregistry.GlobalTypes
+	}
+	if mt, err := typeResolver.FindMessageByName(md.FullName()); err == nil {
+		return mt.New()
+	}
+	return dynamicpb.NewMessage(md)
+}
@@ -0,0 +1,247 @@
+package partial_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/runtime/partial"
+	"github.com/cosmos/cosmos-proto/testpb"
+)
+
+func pack(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+	any, err := anyutil.New(msg)
+	require.NoError(t, err)
+	return any
+}
+
+func marshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return bz
+}
+
+// decode returns the decoded fields of msg selected by paths.
+func decode(t *testing.T, msg proto.Message, paths ...string) []partial.Field {
+	t.Helper()
+	d, err := partial.NewDecoder(msg.ProtoReflect().Descriptor(), paths, partial.Options{})
+	require.NoError(t, err)
+	var fields []partial.Field
+	require.NoError(t, d.Decode(marshal(t, msg), func(f partial.Field) bool {
+		fields = append(fields, f)
+		return true
+	}))
+	return fields
+}
+
+// values returns the paths and the values of fields, messages being marshaled.
+func values(t *testing.T, fields []partial.Field) map[string]interface{} {
+	t.Helper()
+	values := map[string]interface{}{}
+	for _, f := range fields {
+		if m, ok := f.Value.Interface().(protoreflect.Message); ok {
+			values[f.Path] = marshal(t, m.Interface())
+			continue
+		}
+		values[f.Path] = f.Value.Interface()
+	}
+	return values
+}
+
+func TestDecode(t *testing.T) {
+	msg := &testpb.A{
+		INT32:     -1,
+		SING64:    -2,
+		DOUBLE:    1.5,
+		STRING:    "string",
+		BYTES:     []byte("bytes"),
+		MESSAGE:   &testpb.B{X: "message"},
+		MAP:       map[string]*testpb.B{"k": {X: "map"}},
+		LIST:      []*testpb.B{{X: "a"}, {X: "b"}},
+		LIST_ENUM: []testpb.Enumeration{testpb.Enumeration_Two, testpb.Enumeration_One},
+		ONEOF:     &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "oneof"}},
+	}
+	fields := decode(t, msg, "INT32", "SING64", "DOUBLE", "STRING", "BYTES", "MESSAGE.x", "MAP", "LIST.x", "LIST_ENUM", "ONEOF_B", "UINT32")
+	require.Equal(t, map[string]interface{}{
+		"INT32":        int32(-1),
+		"SING64":       int64(-2),
+		"DOUBLE":       1.5,
+		"STRING":       "string",
+		"BYTES":        []byte("bytes"),
+		"MESSAGE.x":    "message",
+		"MAP[k]":       marshal(t, &testpb.B{X: "map"}),
+		"LIST[0].x":    "a",
+		"LIST[1].x":    "b",
+		"LIST_ENUM[0]": protoreflect.EnumNumber(testpb.Enumeration_Two),
+		"LIST_ENUM[1]": protoreflect.EnumNumber(testpb.Enumeration_One),
+		"ONEOF_B":      marshal(t, &testpb.B{X: "oneof"}),
+	}, values(t, fields))
+
+	for _, f := range fields {
+		if f.Path == "MAP[k]" {
+			require.Equal(t, "k", f.Key.String())
+			require.Equal(t, "MAP", string(f.Desc.Name()))
+		}
+	}
+
+	// a shorter path covers the longer ones
+	require.Equal(t, map[string]interface{}{"MESSAGE": marshal(t, &testpb.B{X: "message"})}, values(t, decode(t, msg, "MESSAGE.x", "MESSAGE")))
+}
+
+func TestDecodeUnpacked(t *testing.T) {
+	// repeated scalars are decoded whether packed or not
+	var b []byte
+	for _, v := range []testpb.Enumeration{testpb.Enumeration_One, testpb.Enumeration_Two} {
+		b = protowire.AppendTag(b, 22, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	// the values with the wrong wire type are skipped
+	b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 1)
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 2)
+
+	d, err := partial.NewDecoder((&testpb.A{}).ProtoReflect().Descriptor(), []string{"LIST_ENUM", "enum"}, partial.Options{})
+	require.NoError(t, err)
+	var fields []partial.Field
+	require.NoError(t, d.Decode(b, func(f partial.Field) bool {
+		fields = append(fields, f)
+		return true
+	}))
+	require.Equal(t, map[string]interface{}{
+		"LIST_ENUM[0]": protoreflect.EnumNumber(testpb.Enumeration_One),
+		"LIST_ENUM[1]": protoreflect.EnumNumber(testpb.Enumeration_Two),
+		"enum":         protoreflect.EnumNumber(2),
+	}, values(t, fields))
+}
+
+func TestDecodeAny(t *testing.T) {
+	tx := &cosmostest.Tx{
+		Messages: []*anypb.Any{
+			pack(t, &cosmostest.MsgSend{FromAddress: "from", Amount: "1"}),
+			pack(t, &cosmostest.MsgExec{Grantee: "grantee", Msgs: []*anypb.Any{pack(t, &cosmostest.MsgSend{Amount: "2"})}}),
+		},
+		Extensions: map[string]*anypb.Any{"memo": pack(t, &cosmostest.Memo{Text: "memo"})},
+		Memo:       "memo",
+	}
+	// the names following an Any only select the fields of the packed messages having them
+	require.Equal(t, map[string]interface{}{
+		"messages[0].from_address":   "from",
+		"messages[0].amount":         "1",
+		"messages[1].msgs[0].amount": "2",
+		"extensions[memo]":           marshal(t, tx.Extensions["memo"]),
+	}, values(t, decode(t, tx, "messages.from_address", "messages.amount", "messages.msgs.amount", "extensions")))
+
+	// the Anys are resolved by the resolvers, the unresolved ones being skipped
+	types := new(protoregistry.Types)
+	require.NoError(t, types.RegisterMessage((&cosmostest.MsgSend{}).ProtoReflect().Type()))
+	opts := partial.Options{TypeResolver: types, FileResolver: new(protoregistry.Files)}
+	d, err := partial.NewDecoder(tx.ProtoReflect().Descriptor(), []string{"messages.amount", "memo"}, opts)
+	require.NoError(t, err)
+	var fields []partial.Field
+	require.NoError(t, d.Decode(marshal(t, tx), func(f partial.Field) bool {
+		fields = append(fields, f)
+		return true
+	}))
+	require.Equal(t, map[string]interface{}{"messages[0].amount": "1", "memo": "memo"}, values(t, fields))
+
+	// or reported
+	var unresolved []string
+	opts.Unresolved = func(path, url string, err error) error {
+		require.ErrorIs(t, err, protoregistry.NotFound)
+		unresolved = append(unresolved, path+" "+url)
+		return nil
+	}
+	d, err = partial.NewDecoder(tx.ProtoReflect().Descriptor(), []string{"messages.amount"}, opts)
+	require.NoError(t, err)
+	require.NoError(t, d.Decode(marshal(t, tx), func(partial.Field) bool { return true }))
+	require.Equal(t, []string{"messages[1] /cosmostest.MsgExec"}, unresolved)
+
+	opts.Unresolved = func(path, url string, err error) error {
+		return fmt.Errorf("cannot decode %s: %w", path, err)
+	}
+	d, err = partial.NewDecoder(tx.ProtoReflect().Descriptor(), []string{"messages.amount"}, opts)
+	require.NoError(t, err)
+	err = d.Decode(marshal(t, tx), func(partial.Field) bool { return true })
+	require.ErrorContains(t, err, "cannot decode messages[1]")
+}
+
+func TestDecodeStop(t *testing.T) {
+	msg := &testpb.A{LIST: []*testpb.B{{X: "a"}, {X: "b"}}, STRING: "string"}
+	d, err := partial.NewDecoder(msg.ProtoReflect().Descriptor(), []string{"LIST.x", "STRING"}, partial.Options{})
+	require.NoError(t, err)
+	var paths []string
+	require.NoError(t, d.Decode(marshal(t, msg), func(f partial.Field) bool {
+		paths = append(paths, f.Path)
+		return false
+	}))
+	require.Equal(t, []string{"STRING"}, paths)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	md := (&testpb.A{}).ProtoReflect().Descriptor()
+	for path, err := range map[string]string{
+		"unknown":         `invalid path "unknown": message A has no field "unknown"`,
+		"MESSAGE.unknown": `invalid path "MESSAGE.unknown": message B has no field "unknown"`,
+		"STRING.x":        `invalid path "STRING.x": field A.STRING is not a message field`,
+		"MAP.x":           `invalid path "MAP.x": field A.MAP is not a message field`,
+		"":                `invalid path "": message A has no field ""`,
+	} {
+		_, actual := partial.NewDecoder(md, []string{path}, partial.Options{})
+		require.EqualError(t, actual, err)
+	}
+
+	d, err := partial.NewDecoder(md, []string{"STRING", "MAP"}, partial.Options{})
+	require.NoError(t, err)
+	bz := marshal(t, &testpb.A{STRING: "string"})
+	require.Error(t, d.Decode(bz[:len(bz)-1], func(partial.Field) bool { return true }))
+
+	// strings must be valid UTF-8, like when unmarshaled by the protobuf runtime
+	invalid := protowire.AppendString(protowire.AppendTag(nil, 15, protowire.BytesType), "\xff")
+	require.Error(t, proto.Unmarshal(invalid, dynamicpb.NewMessage(md)))
+	err = d.Decode(invalid, func(partial.Field) bool { return true })
+	require.EqualError(t, err, "proto: field A.STRING contains invalid UTF-8")
+
+	entry := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "\xff")
+	invalid = protowire.AppendBytes(protowire.AppendTag(nil, 18, protowire.BytesType), entry)
+	require.Error(t, proto.Unmarshal(invalid, dynamicpb.NewMessage(md)))
+	err = d.Decode(invalid, func(partial.Field) bool { return true })
+	require.EqualError(t, err, "proto: field A.MAPEntry.key contains invalid UTF-8")
+}
+
+func BenchmarkDecode(b *testing.B) {
+	tx := &cosmostest.Tx{Memo: "memo"}
+	for i := 0; i < 100; i++ {
+		any, err := anyutil.New(&cosmostest.MsgSend{FromAddress: "from", ToAddress: "to", Amount: "1"})
+		require.NoError(b, err)
+		tx.Messages = append(tx.Messages, any)
+	}
+	bz, err := proto.Marshal(tx)
+	require.NoError(b, err)
+
+	b.Run("partial", func(b *testing.B) {
+		d, err := partial.NewDecoder(tx.ProtoReflect().Descriptor(), []string{"memo"}, partial.Options{})
+		require.NoError(b, err)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, d.Decode(bz, func(partial.Field) bool { return true }))
+		}
+	})
+	b.Run("unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, proto.Unmarshal(bz, &cosmostest.Tx{}))
+		}
+	})
+}
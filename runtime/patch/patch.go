@@ -0,0 +1,259 @@
+// Package patch modifies fields in the encoding of a message without unmarshaling
+// it, e.g. to update the sequence number or a signature of an encoded
+// transaction. The encoding of the other fields is preserved byte for byte, only
+// the length prefixes of the messages enclosing the patched field being updated.
+//
+// A path is a dot separated list of field names, e.g. "auth_info.fee.gas_limit",
+// in which every field but the last is a message field, the elements of list
+// fields being selected by their index, e.g. "signer_infos[0].sequence". Map
+// fields and the messages packed in google.protobuf.Any cannot be traversed.
+package patch
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// step is a field of a path, or an element of a list field.
+type step struct {
+	fd protoreflect.FieldDescriptor
+	// index is the index of the element, or -1 for the field itself.
+	index int
+}
+
+// parsePath returns the steps of path in the messages described by md.
+func parsePath(md protoreflect.MessageDescriptor, path string) ([]step, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("invalid path %q: %s", path, fmt.Sprintf(format, args...))
+	}
+	names := strings.Split(path, ".")
+	steps := make([]step, len(names))
+	for i, name := range names {
+		index := -1
+		if j := strings.IndexByte(name, '['); j >= 0 && strings.HasSuffix(name, "]") {
+			n, err := strconv.Atoi(name[j+1 : len(name)-1])
+			if err != nil || n < 0 {
+				return nil, invalid("invalid index in %q", name)
+			}
+			name, index = name[:j], n
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		last := i == len(names)-1
+		switch {
+		case fd == nil:
+			return nil, invalid("message %s has no field %q", md.FullName(), name)
+		case fd.IsMap():
+			return nil, invalid("field %s is a map", fd.FullName())
+		case fd.Kind() == protoreflect.GroupKind:
+			return nil, invalid("field %s is a group", fd.FullName())
+		case index >= 0 && !fd.IsList():
+			return nil, invalid("field %s is not a list", fd.FullName())
+		case last:
+		case fd.Message() == nil:
+			return nil, invalid("field %s is not a message field", fd.FullName())
+		case fd.IsList() && index < 0:
+			return nil, invalid("the elements of field %s are selected by index", fd.FullName())
+		case fd.Message().FullName() == anyFullName:
+			return nil, invalid("field %s is an Any", fd.FullName())
+		}
+		steps[i] = step{fd: fd, index: index}
+		md = fd.Message()
+	}
+	return steps, nil
+}
+
+// Set returns a copy of b, the encoding of a message described by md, in which the
+// field or list element at path is set to v. A field which is not encoded is
+// appended to the encoding of its message, which is created if needed, and a
+// singular field encoded several times is replaced by its last occurrence. The
+// other members of the oneofs of the fields of path are removed. A field without
+// presence set to its zero value is cleared, like the protobuf
+// runtime does not encode it.
+func Set(md protoreflect.MessageDescriptor, b []byte, path string, v protoreflect.Value) ([]byte, error) {
+	steps, err := parsePath(md, path)
+	if err != nil {
+		return nil, err
+	}
+	last := steps[len(steps)-1]
+	fd := last.fd
+	switch {
+	case fd.IsList() && last.index < 0:
+		return nil, fmt.Errorf("cannot set list field %s, whose elements are set by index", fd.FullName())
+	case !fd.IsList() && !fd.HasPresence() && isZero(fd, v):
+		return patch(b, steps, nil)
+	}
+
+	var value []byte
+	if fd.Message() != nil {
+		bz, err := proto.Marshal(v.Message().Interface())
+		if err != nil {
+			return nil, err
+		}
+		value = protowire.AppendBytes(nil, bz)
+	} else {
+		value = runtime.AppendScalar(nil, fd.Kind(), v)
+	}
+	return patch(b, steps, value)
+}
+
+// Clear returns a copy of b, the encoding of a message described by md, without
+// the field at path, or without the list element at path, in which case the
+// following elements are shifted.
+func Clear(md protoreflect.MessageDescriptor, b []byte, path string) ([]byte, error) {
+	steps, err := parsePath(md, path)
+	if err != nil {
+		return nil, err
+	}
+	return patch(b, steps, nil)
+}
+
+// isZero reports whether v is the zero value of the scalar field fd, which is not
+// encoded if fd has no presence. Negative zeros are encoded.
+func isZero(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return false
+	case protoreflect.BytesKind:
+		return len(v.Bytes()) == 0
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return math.Float64bits(v.Float()) == 0
+	default:
+		return v.Interface() == fd.Default().Interface()
+	}
+}
+
+// occurrence is the position of an encoded field in the encoding of its message.
+type occurrence struct {
+	// start and end delimit the field, whose value starts at value.
+	start, value, end int
+}
+
+// edit replaces the bytes of an encoding between start and end by data.
+type edit struct {
+	start, end int
+	data       []byte
+}
+
+// patch returns a copy of b, the encoding of a message, in which the field or
+// list element of the first of steps, or of the following steps in its value,
+// is set to value, the encoding of a value without its tag, or is cleared if
+// value is nil.
+func patch(b []byte, steps []step, value []byte) ([]byte, error) {
+	s, sub := steps[0], steps[1:]
+	num, kind := s.fd.Number(), s.fd.Kind()
+	// the other members of the oneof of the field are removed when setting it
+	oneof := s.fd.ContainingOneof()
+	if value == nil || oneof != nil && oneof.IsSynthetic() {
+		oneof = nil
+	}
+
+	// the fields of b with another wire type are unknown fields
+	var occurrences, others []occurrence
+	for off := 0; off < len(b); {
+		fnum, wtyp, n := protowire.ConsumeTag(b[off:])
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		m, err := runtime.Skip(b[off:])
+		if err != nil {
+			return nil, err
+		}
+		if off+m > len(b) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if fnum == num {
+			switch {
+			case s.fd.IsList() && wtyp == protowire.BytesType && runtime.IsPackable(kind):
+				if s.index >= 0 {
+					return nil, fmt.Errorf("cannot patch the elements of the packed field %s", s.fd.FullName())
+				}
+				occurrences = append(occurrences, occurrence{start: off, value: off + n, end: off + m})
+			case wtyp == runtime.WireType(kind):
+				occurrences = append(occurrences, occurrence{start: off, value: off + n, end: off + m})
+			}
+		} else if oneof != nil {
+			if other := oneof.Fields().ByNumber(fnum); other != nil && wtyp == runtime.WireType(other.Kind()) {
+				others = append(others, occurrence{start: off, end: off + m})
+			}
+		}
+		off += m
+	}
+
+	if s.index >= 0 {
+		if s.index >= len(occurrences) {
+			return nil, fmt.Errorf("index %d out of range of field %s with %d elements", s.index, s.fd.FullName(), len(occurrences))
+		}
+		occurrences = occurrences[s.index : s.index+1]
+	}
+
+	var edits []edit
+	for _, o := range others {
+		edits = append(edits, edit{start: o.start, end: o.end})
+	}
+	switch {
+	case len(sub) == 0 && value == nil:
+		for _, o := range occurrences {
+			edits = append(edits, edit{start: o.start, end: o.end})
+		}
+	case len(sub) == 0:
+		if len(occurrences) == 0 {
+			field := protowire.AppendTag(nil, num, runtime.WireType(kind))
+			edits = append(edits, edit{start: len(b), end: len(b), data: append(field, value...)})
+			break
+		}
+		last := occurrences[len(occurrences)-1]
+		for _, o := range occurrences[:len(occurrences)-1] {
+			edits = append(edits, edit{start: o.start, end: o.end})
+		}
+		edits = append(edits, edit{start: last.value, end: last.end, data: value})
+	case len(occurrences) > 1:
+		return nil, fmt.Errorf("cannot patch field %s, which is encoded several times", s.fd.FullName())
+	case len(occurrences) == 1:
+		o := occurrences[0]
+		msg, n := protowire.ConsumeBytes(b[o.value:o.end])
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		patched, err := patch(msg, sub, value)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, edit{start: o.value, end: o.end, data: protowire.AppendBytes(nil, patched)})
+	case value != nil:
+		patched, err := patch(nil, sub, value)
+		if err != nil {
+			return nil, err
+		}
+		field := protowire.AppendTag(nil, num, protowire.BytesType)
+		edits = append(edits, edit{start: len(b), end: len(b), data: protowire.AppendBytes(field, patched)})
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	return apply(b, edits), nil
+}
+
+// apply returns a copy of b with the edits, which are sorted and do not overlap.
+func apply(b []byte, edits []edit) []byte {
+	size := len(b)
+	for _, e := range edits {
+		size += len(e.data) - (e.end - e.start)
+	}
+	patched := make([]byte, 0, size)
+	off := 0
+	for _, e := range edits {
+		patched = append(patched, b[off:e.start]...)
+		patched = append(patched, e.data...)
+		off = e.end
+	}
+	return append(patched, b[off:]...)
+}
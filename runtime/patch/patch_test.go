@@ -0,0 +1,177 @@
+package patch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-proto/runtime/patch"
+	"github.com/cosmos/cosmos-proto/testpb"
+)
+
+var md = (&testpb.A{}).ProtoReflect().Descriptor()
+
+func newA() *testpb.A {
+	return &testpb.A{
+		INT32:     1,
+		STRING:    "string",
+		MESSAGE:   &testpb.B{X: "message"},
+		LIST:      []*testpb.B{{X: "a"}, {X: "b"}, {X: "c"}},
+		LIST_ENUM: []testpb.Enumeration{testpb.Enumeration_Two},
+		Type_:     "type",
+	}
+}
+
+func marshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestSet(t *testing.T) {
+	for _, tc := range []struct {
+		path   string
+		value  protoreflect.Value
+		modify func(*testpb.A)
+	}{
+		{"INT32", protoreflect.ValueOfInt32(300), func(a *testpb.A) { a.INT32 = 300 }},
+		{"STRING", protoreflect.ValueOfString("longer string"), func(a *testpb.A) { a.STRING = "longer string" }},
+		{"MESSAGE.x", protoreflect.ValueOfString("m"), func(a *testpb.A) { a.MESSAGE.X = "m" }},
+		{"MESSAGE", protoreflect.ValueOfMessage((&testpb.B{}).ProtoReflect()), func(a *testpb.A) { a.MESSAGE = &testpb.B{} }},
+		{"LIST[1].x", protoreflect.ValueOfString("bb"), func(a *testpb.A) { a.LIST[1].X = "bb" }},
+		{"LIST[2]", protoreflect.ValueOfMessage((&testpb.B{X: "cc"}).ProtoReflect()), func(a *testpb.A) { a.LIST[2] = &testpb.B{X: "cc"} }},
+		// the zero values of fields without presence are not encoded
+		{"type", protoreflect.ValueOfString(""), func(a *testpb.A) { a.Type_ = "" }},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			patched, err := patch.Set(md, marshal(t, newA()), tc.path, tc.value)
+			require.NoError(t, err)
+			want := newA()
+			tc.modify(want)
+			// the encoding is the one of the modified message
+			require.Equal(t, marshal(t, want), patched)
+		})
+	}
+
+	// setting a oneof member removes the other members, e.g. from a valid
+	// encoding in which ONEOF_B, the last member, wins
+	bz := append(marshal(t, &testpb.A{ONEOF: &testpb.A_ONEOF_STRING{ONEOF_STRING: "s"}}), marshal(t, &testpb.A{ONEOF: &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "b"}}})...)
+	for _, tc := range []struct {
+		path  string
+		value protoreflect.Value
+		want  *testpb.A
+	}{
+		{"ONEOF_STRING", protoreflect.ValueOfString("x"), &testpb.A{ONEOF: &testpb.A_ONEOF_STRING{ONEOF_STRING: "x"}}},
+		{"ONEOF_B", protoreflect.ValueOfMessage((&testpb.B{X: "x"}).ProtoReflect()), &testpb.A{ONEOF: &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "x"}}}},
+		{"ONEOF_B.x", protoreflect.ValueOfString("x"), &testpb.A{ONEOF: &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "x"}}}},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			patched, err := patch.Set(md, bz, tc.path, tc.value)
+			require.NoError(t, err)
+			// the other members of the oneof are removed
+			require.Equal(t, marshal(t, tc.want), patched)
+		})
+	}
+
+	// setting a member in a message nested in a oneof member removes the other members
+	bz = marshal(t, &testpb.A{ONEOF: &testpb.A_ONEOF_STRING{ONEOF_STRING: "s"}})
+	patched, err := patch.Set(md, bz, "ONEOF_B.x", protoreflect.ValueOfString("x"))
+	require.NoError(t, err)
+	require.Equal(t, marshal(t, &testpb.A{ONEOF: &testpb.A_ONEOF_B{ONEOF_B: &testpb.B{X: "x"}}}), patched)
+
+	// clearing a member keeps the other ones
+	patched, err = patch.Clear(md, bz, "ONEOF_B")
+	require.NoError(t, err)
+	require.Equal(t, bz, patched)
+}
+
+func TestSetMissing(t *testing.T) {
+	// missing fields and messages are appended
+	bz := marshal(t, &testpb.A{INT32: 1})
+	patched, err := patch.Set(md, bz, "MESSAGE.x", protoreflect.ValueOfString("x"))
+	require.NoError(t, err)
+	patched, err = patch.Set(md, patched, "UINT64", protoreflect.ValueOfUint64(2))
+	require.NoError(t, err)
+	require.Equal(t, bz, patched[:len(bz)])
+
+	msg := &testpb.A{}
+	require.NoError(t, proto.Unmarshal(patched, msg))
+	require.True(t, proto.Equal(&testpb.A{INT32: 1, MESSAGE: &testpb.B{X: "x"}, UINT64: 2}, msg), "%v", msg)
+
+	_, err = patch.Set(md, bz, "LIST[0].x", protoreflect.ValueOfString("x"))
+	require.EqualError(t, err, "index 0 out of range of field A.LIST with 0 elements")
+}
+
+func TestPreserve(t *testing.T) {
+	// a singular field encoded several times and unknown fields
+	var bz []byte
+	bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 1)
+	unknown := protowire.AppendTag(nil, 1000, protowire.BytesType)
+	unknown = protowire.AppendString(unknown, "unknown")
+	bz = append(bz, unknown...)
+	// a non-minimal varint, as the value of field 5
+	nonMinimal := []byte{5 << 3, 0x81, 0x00}
+	bz = append(bz, nonMinimal...)
+	bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 2)
+
+	patched, err := patch.Set(md, bz, "INT32", protoreflect.ValueOfInt32(3))
+	require.NoError(t, err)
+	want := append(append(append([]byte(nil), unknown...), nonMinimal...), 3<<3, 3)
+	require.Equal(t, want, patched)
+
+	patched, err = patch.Clear(md, patched, "INT32")
+	require.NoError(t, err)
+	require.Equal(t, want[:len(want)-2], patched)
+}
+
+func TestClear(t *testing.T) {
+	for _, tc := range []struct {
+		path   string
+		modify func(*testpb.A)
+	}{
+		{"INT32", func(a *testpb.A) { a.INT32 = 0 }},
+		{"MESSAGE.x", func(a *testpb.A) { a.MESSAGE.X = "" }},
+		{"MESSAGE", func(a *testpb.A) { a.MESSAGE = nil }},
+		{"LIST", func(a *testpb.A) { a.LIST = nil }},
+		{"LIST[0]", func(a *testpb.A) { a.LIST = a.LIST[1:] }},
+		{"LIST[1].x", func(a *testpb.A) { a.LIST[1].X = "" }},
+		{"LIST_ENUM", func(a *testpb.A) { a.LIST_ENUM = nil }},
+		{"imported", func(a *testpb.A) {}},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			patched, err := patch.Clear(md, marshal(t, newA()), tc.path)
+			require.NoError(t, err)
+			want := newA()
+			tc.modify(want)
+			require.Equal(t, marshal(t, want), patched)
+		})
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	bz := marshal(t, newA())
+	for path, err := range map[string]string{
+		"unknown":  `invalid path "unknown": message A has no field "unknown"`,
+		"STRING.x": `invalid path "STRING.x": field A.STRING is not a message field`,
+		"MAP":      `invalid path "MAP": field A.MAP is a map`,
+		"LIST.x":   `invalid path "LIST.x": the elements of field A.LIST are selected by index`,
+		"INT32[0]": `invalid path "INT32[0]": field A.INT32 is not a list`,
+		"LIST[-1]": `invalid path "LIST[-1]": invalid index in "LIST[-1]"`,
+		"LIST[3]":  "index 3 out of range of field A.LIST with 3 elements",
+		"LIST":     "cannot set list field A.LIST, whose elements are set by index",
+	} {
+		_, actual := patch.Set(md, bz, path, protoreflect.ValueOfMessage((&testpb.B{}).ProtoReflect()))
+		require.EqualError(t, actual, err, path)
+	}
+
+	_, err := patch.Set(md, bz, "LIST_ENUM[0]", protoreflect.ValueOfEnum(1))
+	require.EqualError(t, err, "cannot patch the elements of the packed field A.LIST_ENUM")
+	_, err = patch.Set(md, bz[:len(bz)-1], "INT32", protoreflect.ValueOfInt32(1))
+	require.Error(t, err)
+}
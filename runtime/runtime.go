@@ -3,6 +3,7 @@ package runtime
 import (
 	"fmt"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/runtime/protoiface"
 	"io"
 	"math/bits"
@@ -139,3 +140,23 @@ var (
 	ErrIntOverflow          = fmt.Errorf("proto: integer overflow")
 	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
 )
+
+// requiredNotSetError is the error of a message whose required field is not set,
+// which matches proto.Error like the errors of the protobuf runtime.
+type requiredNotSetError struct {
+	name protoreflect.FullName
+}
+
+func (e requiredNotSetError) Error() string {
+	return fmt.Sprintf("proto: required field %s not set", e.name)
+}
+
+func (e requiredNotSetError) Is(target error) bool {
+	return target == proto.Error
+}
+
+// RequiredNotSet returns the error reported by the generated CheckInitialized
+// methods when the required field fd is not set.
+func RequiredNotSet(fd protoreflect.FieldDescriptor) error {
+	return requiredNotSetError{name: fd.FullName()}
+}
@@ -0,0 +1,104 @@
+package runtime
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ protoreflect.Map = (*SortedMap[string, int32, StringValue, Int32Value])(nil)
+
+// SortedMap is a Map whose Range visits the entries in key order, as ordered
+// by LessMapKey. It implements the maps of the messages generated with the
+// stable_range parameter.
+type SortedMap[K comparable, V any, KC ValueConverter[K], VC ValueConverter[V]] map[K]V
+
+// NewSortedMap returns the SortedMap of the map field pointed to by m.
+func NewSortedMap[K comparable, V any, KC ValueConverter[K], VC ValueConverter[V]](m *map[K]V) *SortedMap[K, V, KC, VC] {
+	return (*SortedMap[K, V, KC, VC])(m)
+}
+
+func (x *SortedMap[K, V, KC, VC]) unsorted() *Map[K, V, KC, VC] {
+	return (*Map[K, V, KC, VC])(x)
+}
+
+// GoMap returns the entries of the map, panicking if the map is invalid.
+func (x *SortedMap[K, V, KC, VC]) GoMap() map[K]V {
+	return *x
+}
+
+func (x *SortedMap[K, V, KC, VC]) Len() int {
+	return x.unsorted().Len()
+}
+
+func (x *SortedMap[K, V, KC, VC]) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x == nil || len(*x) == 0 {
+		return
+	}
+	keys := make([]K, 0, len(*x))
+	for k := range *x {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return LessMapKey(keys[i], keys[j])
+	})
+	var (
+		kc KC
+		vc VC
+	)
+	for _, k := range keys {
+		if !f(protoreflect.MapKey(kc.PBValueOf(k)), vc.PBValueOf((*x)[k])) {
+			break
+		}
+	}
+}
+
+func (x *SortedMap[K, V, KC, VC]) Has(key protoreflect.MapKey) bool {
+	return x.unsorted().Has(key)
+}
+
+func (x *SortedMap[K, V, KC, VC]) Clear(key protoreflect.MapKey) {
+	x.unsorted().Clear(key)
+}
+
+func (x *SortedMap[K, V, KC, VC]) Get(key protoreflect.MapKey) protoreflect.Value {
+	return x.unsorted().Get(key)
+}
+
+func (x *SortedMap[K, V, KC, VC]) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	x.unsorted().Set(key, value)
+}
+
+func (x *SortedMap[K, V, KC, VC]) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	return x.unsorted().Mutable(key)
+}
+
+func (x *SortedMap[K, V, KC, VC]) NewValue() protoreflect.Value {
+	return x.unsorted().NewValue()
+}
+
+func (x *SortedMap[K, V, KC, VC]) IsValid() bool {
+	return x != nil
+}
+
+// LessMapKey orders the keys of map fields, which are integers, booleans or
+// strings, as the deterministic encoding of maps.
+func LessMapKey[K any](a, b K) bool {
+	switch a := any(a).(type) {
+	case bool:
+		return !a && any(b).(bool)
+	case int32:
+		return a < any(b).(int32)
+	case int64:
+		return a < any(b).(int64)
+	case uint32:
+		return a < any(b).(uint32)
+	case uint64:
+		return a < any(b).(uint64)
+	case string:
+		return a < any(b).(string)
+	default:
+		panic(fmt.Sprintf("invalid map key type %T", a))
+	}
+}
@@ -0,0 +1,42 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestLessMapKey(t *testing.T) {
+	require.True(t, LessMapKey(false, true))
+	require.False(t, LessMapKey(true, false))
+	require.True(t, LessMapKey(int32(-1), int32(0)))
+	require.True(t, LessMapKey(uint64(1), uint64(2)))
+	require.True(t, LessMapKey("a", "b"))
+	require.Panics(t, func() { LessMapKey(1.5, 2.5) })
+}
+
+func TestSortedMapRange(t *testing.T) {
+	m := map[int32]string{}
+	for i := int32(100); i > -100; i-- {
+		m[i] = "value"
+	}
+	var keys []int32
+	NewSortedMap[int32, string, Int32Value, StringValue](&m).Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		keys = append(keys, int32(k.Int()))
+		require.Equal(t, "value", v.String())
+		return len(keys) < 150
+	})
+	require.Len(t, keys, 150)
+	for i := range keys {
+		require.Equal(t, int32(i-99), keys[i])
+	}
+
+	var invalid *SortedMap[int32, string, Int32Value, StringValue]
+	require.False(t, invalid.IsValid())
+	require.Zero(t, invalid.Len())
+	invalid.Range(func(protoreflect.MapKey, protoreflect.Value) bool {
+		t.Fatal("ranged over an invalid map")
+		return false
+	})
+}
@@ -0,0 +1,220 @@
+package table
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Codec encodes the values of type T of a protobuf scalar kind, without their tag.
+type Codec[T any] struct {
+	WireType protowire.Type
+	Size     func(v T) int
+	Append   func(b []byte, v T) []byte
+	// Consume decodes a value from b, returning a negative length on error,
+	// as protowire does.
+	Consume func(b []byte) (T, int)
+	// IsZero reports whether v is the default value, which singular fields
+	// without presence do not encode.
+	IsZero func(v T) bool
+}
+
+// packed reports whether the repeated fields of the codec can be packed.
+func (c Codec[T]) packed() bool {
+	return c.WireType != protowire.BytesType
+}
+
+func sizeVarint[T ~int32 | ~int64 | ~uint32 | ~uint64](v T) int {
+	return protowire.SizeVarint(uint64(v))
+}
+
+func appendVarint[T ~int32 | ~int64 | ~uint32 | ~uint64](b []byte, v T) []byte {
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+func isZero[T comparable](v T) bool {
+	var zero T
+	return v == zero
+}
+
+var (
+	Int32 = Codec[int32]{
+		WireType: protowire.VarintType,
+		Size:     sizeVarint[int32],
+		Append:   appendVarint[int32],
+		Consume: func(b []byte) (int32, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return int32(v), n
+		},
+		IsZero: isZero[int32],
+	}
+	Int64 = Codec[int64]{
+		WireType: protowire.VarintType,
+		Size:     sizeVarint[int64],
+		Append:   appendVarint[int64],
+		Consume: func(b []byte) (int64, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return int64(v), n
+		},
+		IsZero: isZero[int64],
+	}
+	Uint32 = Codec[uint32]{
+		WireType: protowire.VarintType,
+		Size:     sizeVarint[uint32],
+		Append:   appendVarint[uint32],
+		Consume: func(b []byte) (uint32, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return uint32(v), n
+		},
+		IsZero: isZero[uint32],
+	}
+	Uint64 = Codec[uint64]{
+		WireType: protowire.VarintType,
+		Size:     sizeVarint[uint64],
+		Append:   appendVarint[uint64],
+		Consume:  protowire.ConsumeVarint,
+		IsZero:   isZero[uint64],
+	}
+	Sint32 = Codec[int32]{
+		WireType: protowire.VarintType,
+		Size: func(v int32) int {
+			return protowire.SizeVarint(protowire.EncodeZigZag(int64(v)))
+		},
+		Append: func(b []byte, v int32) []byte {
+			return protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v)))
+		},
+		Consume: func(b []byte) (int32, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return int32(protowire.DecodeZigZag(v & math.MaxUint32)), n
+		},
+		IsZero: isZero[int32],
+	}
+	Sint64 = Codec[int64]{
+		WireType: protowire.VarintType,
+		Size: func(v int64) int {
+			return protowire.SizeVarint(protowire.EncodeZigZag(v))
+		},
+		Append: func(b []byte, v int64) []byte {
+			return protowire.AppendVarint(b, protowire.EncodeZigZag(v))
+		},
+		Consume: func(b []byte) (int64, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return protowire.DecodeZigZag(v), n
+		},
+		IsZero: isZero[int64],
+	}
+	Fixed32 = Codec[uint32]{
+		WireType: protowire.Fixed32Type,
+		Size:     func(uint32) int { return 4 },
+		Append:   protowire.AppendFixed32,
+		Consume:  protowire.ConsumeFixed32,
+		IsZero:   isZero[uint32],
+	}
+	Fixed64 = Codec[uint64]{
+		WireType: protowire.Fixed64Type,
+		Size:     func(uint64) int { return 8 },
+		Append:   protowire.AppendFixed64,
+		Consume:  protowire.ConsumeFixed64,
+		IsZero:   isZero[uint64],
+	}
+	Sfixed32 = Codec[int32]{
+		WireType: protowire.Fixed32Type,
+		Size:     func(int32) int { return 4 },
+		Append: func(b []byte, v int32) []byte {
+			return protowire.AppendFixed32(b, uint32(v))
+		},
+		Consume: func(b []byte) (int32, int) {
+			v, n := protowire.ConsumeFixed32(b)
+			return int32(v), n
+		},
+		IsZero: isZero[int32],
+	}
+	Sfixed64 = Codec[int64]{
+		WireType: protowire.Fixed64Type,
+		Size:     func(int64) int { return 8 },
+		Append: func(b []byte, v int64) []byte {
+			return protowire.AppendFixed64(b, uint64(v))
+		},
+		Consume: func(b []byte) (int64, int) {
+			v, n := protowire.ConsumeFixed64(b)
+			return int64(v), n
+		},
+		IsZero: isZero[int64],
+	}
+	Float = Codec[float32]{
+		WireType: protowire.Fixed32Type,
+		Size:     func(float32) int { return 4 },
+		Append: func(b []byte, v float32) []byte {
+			return protowire.AppendFixed32(b, math.Float32bits(v))
+		},
+		Consume: func(b []byte) (float32, int) {
+			v, n := protowire.ConsumeFixed32(b)
+			return math.Float32frombits(v), n
+		},
+		// negative zero is encoded
+		IsZero: func(v float32) bool { return v == 0 && !math.Signbit(float64(v)) },
+	}
+	Double = Codec[float64]{
+		WireType: protowire.Fixed64Type,
+		Size:     func(float64) int { return 8 },
+		Append: func(b []byte, v float64) []byte {
+			return protowire.AppendFixed64(b, math.Float64bits(v))
+		},
+		Consume: func(b []byte) (float64, int) {
+			v, n := protowire.ConsumeFixed64(b)
+			return math.Float64frombits(v), n
+		},
+		IsZero: func(v float64) bool { return v == 0 && !math.Signbit(v) },
+	}
+	Bool = Codec[bool]{
+		WireType: protowire.VarintType,
+		Size:     func(bool) int { return 1 },
+		Append: func(b []byte, v bool) []byte {
+			return protowire.AppendVarint(b, protowire.EncodeBool(v))
+		},
+		Consume: func(b []byte) (bool, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return v != 0, n
+		},
+		IsZero: func(v bool) bool { return !v },
+	}
+	String = Codec[string]{
+		WireType: protowire.BytesType,
+		Size: func(v string) int {
+			return protowire.SizeBytes(len(v))
+		},
+		Append: protowire.AppendString,
+		Consume: func(b []byte) (string, int) {
+			v, n := protowire.ConsumeBytes(b)
+			return string(v), n
+		},
+		IsZero: func(v string) bool { return len(v) == 0 },
+	}
+	Bytes = Codec[[]byte]{
+		WireType: protowire.BytesType,
+		Size: func(v []byte) int {
+			return protowire.SizeBytes(len(v))
+		},
+		Append: protowire.AppendBytes,
+		Consume: func(b []byte) ([]byte, int) {
+			v, n := protowire.ConsumeBytes(b)
+			// the input buffer may be reused by the caller
+			return append([]byte{}, v...), n
+		},
+		IsZero: func(v []byte) bool { return len(v) == 0 },
+	}
+)
+
+// Enum returns the codec of the enum type E.
+func Enum[E ~int32]() Codec[E] {
+	return Codec[E]{
+		WireType: protowire.VarintType,
+		Size:     sizeVarint[E],
+		Append:   appendVarint[E],
+		Consume: func(b []byte) (E, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return E(v), n
+		},
+		IsZero: isZero[E],
+	}
+}
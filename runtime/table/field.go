@@ -0,0 +1,617 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Field is the codec of a field of the messages of type M, created by the
+// constructor matching its cardinality and kind, e.g. Scalar or Message.
+type Field[M any] struct {
+	num       protowire.Number
+	size      func(m *M, opts proto.MarshalOptions) int
+	marshal   func(b []byte, m *M, opts proto.MarshalOptions) ([]byte, error)
+	unmarshal func(b []byte, wtyp protowire.Type, m *M, opts proto.UnmarshalOptions) (int, error)
+}
+
+// messagePtr is the pointer type P of the message type T.
+type messagePtr[T any] interface {
+	*T
+	proto.Message
+}
+
+func errWireType(num protowire.Number, wtyp protowire.Type) error {
+	return fmt.Errorf("proto: wrong wireType = %d for field %d", wtyp, num)
+}
+
+// Scalar returns the codec of a singular scalar field without presence,
+// which is not encoded when it is the default value.
+func Scalar[M, T any](num protowire.Number, c Codec[T], get func(*M) *T) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			v := *get(m)
+			if c.IsZero(v) {
+				return 0
+			}
+			return tagSize + c.Size(v)
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			v := *get(m)
+			if c.IsZero(v) {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, c.WireType)
+			return c.Append(b, v), nil
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, _ proto.UnmarshalOptions) (int, error) {
+			if wtyp != c.WireType {
+				return 0, errWireType(num, wtyp)
+			}
+			v, n := c.Consume(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			*get(m) = v
+			return n, nil
+		},
+	}
+}
+
+// Optional returns the codec of a scalar field with presence, e.g. a proto3
+// optional field, whose Go field is a pointer encoded when it is not nil.
+func Optional[M, T any](num protowire.Number, c Codec[T], get func(*M) **T) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			p := *get(m)
+			if p == nil {
+				return 0
+			}
+			return tagSize + c.Size(*p)
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			p := *get(m)
+			if p == nil {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, c.WireType)
+			return c.Append(b, *p), nil
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, _ proto.UnmarshalOptions) (int, error) {
+			if wtyp != c.WireType {
+				return 0, errWireType(num, wtyp)
+			}
+			v, n := c.Consume(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			*get(m) = &v
+			return n, nil
+		},
+	}
+}
+
+// OptionalBytes returns the codec of a bytes field with presence, which is
+// encoded when it is not nil.
+func OptionalBytes[M any](num protowire.Number, get func(*M) *[]byte) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			v := *get(m)
+			if v == nil {
+				return 0
+			}
+			return tagSize + Bytes.Size(v)
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			v := *get(m)
+			if v == nil {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			return Bytes.Append(b, v), nil
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, _ proto.UnmarshalOptions) (int, error) {
+			if wtyp != protowire.BytesType {
+				return 0, errWireType(num, wtyp)
+			}
+			v, n := Bytes.Consume(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			*get(m) = v
+			return n, nil
+		},
+	}
+}
+
+// Repeated returns the codec of an unpacked repeated scalar field.
+func Repeated[M, T any](num protowire.Number, c Codec[T], get func(*M) *[]T) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			var n int
+			for _, v := range *get(m) {
+				n += tagSize + c.Size(v)
+			}
+			return n
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			for _, v := range *get(m) {
+				b = protowire.AppendTag(b, num, c.WireType)
+				b = c.Append(b, v)
+			}
+			return b, nil
+		},
+		unmarshal: unmarshalRepeated(num, c, get),
+	}
+}
+
+// Packed returns the codec of a packed repeated scalar field.
+func Packed[M, T any](num protowire.Number, c Codec[T], get func(*M) *[]T) Field[M] {
+	if !c.packed() {
+		panic(fmt.Sprintf("field %d: length-delimited values cannot be packed", num))
+	}
+	tagSize := protowire.SizeTag(num)
+	packedSize := func(s []T) int {
+		var n int
+		for _, v := range s {
+			n += c.Size(v)
+		}
+		return n
+	}
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			s := *get(m)
+			if len(s) == 0 {
+				return 0
+			}
+			return tagSize + protowire.SizeBytes(packedSize(s))
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			s := *get(m)
+			if len(s) == 0 {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendVarint(b, uint64(packedSize(s)))
+			for _, v := range s {
+				b = c.Append(b, v)
+			}
+			return b, nil
+		},
+		unmarshal: unmarshalRepeated(num, c, get),
+	}
+}
+
+// unmarshalRepeated decodes both the packed and the unpacked encodings of a
+// repeated scalar field, as parsers must accept both.
+func unmarshalRepeated[M, T any](num protowire.Number, c Codec[T], get func(*M) *[]T) func([]byte, protowire.Type, *M, proto.UnmarshalOptions) (int, error) {
+	return func(b []byte, wtyp protowire.Type, m *M, _ proto.UnmarshalOptions) (int, error) {
+		s := get(m)
+		if wtyp == c.WireType {
+			v, n := c.Consume(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			*s = append(*s, v)
+			return n, nil
+		}
+		if wtyp != protowire.BytesType || !c.packed() {
+			return 0, errWireType(num, wtyp)
+		}
+		packed, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		for len(packed) > 0 {
+			v, vn := c.Consume(packed)
+			if vn < 0 {
+				return 0, protowire.ParseError(vn)
+			}
+			*s = append(*s, v)
+			packed = packed[vn:]
+		}
+		return n, nil
+	}
+}
+
+// sizeMessage returns the size of p, which is encoded as an empty message when nil.
+func sizeMessage[T any, P messagePtr[T]](p P, opts proto.MarshalOptions) int {
+	if p == nil {
+		return 0
+	}
+	return opts.Size(p)
+}
+
+func appendMessage[T any, P messagePtr[T]](b []byte, p P, opts proto.MarshalOptions) ([]byte, error) {
+	size := sizeMessage(p, opts)
+	b = protowire.AppendVarint(b, uint64(size))
+	if size == 0 {
+		return b, nil
+	}
+	// calling the methods of the message directly avoids computing its size again
+	m := p.ProtoReflect()
+	if methods := m.ProtoMethods(); methods != nil && methods.Marshal != nil {
+		var flags protoiface.MarshalInputFlags
+		if opts.Deterministic {
+			flags |= protoiface.MarshalDeterministic
+		}
+		out, err := methods.Marshal(protoiface.MarshalInput{Message: m, Buf: b, Flags: flags})
+		return out.Buf, err
+	}
+	return opts.MarshalAppend(b, p)
+}
+
+// consumeMessage merges the length-delimited message at the start of b into p.
+func consumeMessage(b []byte, p proto.Message, opts proto.UnmarshalOptions) (int, error) {
+	v, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	m := p.ProtoReflect()
+	if methods := m.ProtoMethods(); methods != nil && methods.Unmarshal != nil {
+		var flags protoiface.UnmarshalInputFlags
+		if opts.DiscardUnknown {
+			flags |= protoiface.UnmarshalDiscardUnknown
+		}
+		resolver := opts.Resolver
+		if resolver == nil {
+			resolver = protoregistry.GlobalTypes
+		}
+		_, err := methods.Unmarshal(protoiface.UnmarshalInput{Message: m, Buf: v, Flags: flags, Resolver: resolver})
+		return n, err
+	}
+	opts.Merge = true
+	return n, opts.Unmarshal(v, p)
+}
+
+// Message returns the codec of a singular message field.
+func Message[M, T any, P messagePtr[T]](num protowire.Number, get func(*M) *P) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, opts proto.MarshalOptions) int {
+			p := *get(m)
+			if p == nil {
+				return 0
+			}
+			return tagSize + protowire.SizeBytes(opts.Size(p))
+		},
+		marshal: func(b []byte, m *M, opts proto.MarshalOptions) ([]byte, error) {
+			p := *get(m)
+			if p == nil {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			return appendMessage(b, p, opts)
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, opts proto.UnmarshalOptions) (int, error) {
+			if wtyp != protowire.BytesType {
+				return 0, errWireType(num, wtyp)
+			}
+			p := get(m)
+			if *p == nil {
+				*p = new(T)
+			}
+			return consumeMessage(b, *p, opts)
+		},
+	}
+}
+
+// RepeatedMessage returns the codec of a repeated message field.
+func RepeatedMessage[M, T any, P messagePtr[T]](num protowire.Number, get func(*M) *[]P) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, opts proto.MarshalOptions) int {
+			var n int
+			for _, p := range *get(m) {
+				n += tagSize + protowire.SizeBytes(sizeMessage(p, opts))
+			}
+			return n
+		},
+		marshal: func(b []byte, m *M, opts proto.MarshalOptions) ([]byte, error) {
+			var err error
+			for _, p := range *get(m) {
+				b = protowire.AppendTag(b, num, protowire.BytesType)
+				if b, err = appendMessage(b, p, opts); err != nil {
+					return b, err
+				}
+			}
+			return b, nil
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, opts proto.UnmarshalOptions) (int, error) {
+			if wtyp != protowire.BytesType {
+				return 0, errWireType(num, wtyp)
+			}
+			p := P(new(T))
+			s := get(m)
+			*s = append(*s, p)
+			return consumeMessage(b, p, opts)
+		},
+	}
+}
+
+// Custom returns the codec of a singular field mapped to a custom type,
+// encoded as a length-delimited value when its size is not zero.
+func Custom[M, T any, P interface {
+	*T
+	runtime.CustomType
+}](num protowire.Number, get func(*M) *T) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			l := P(get(m)).Size()
+			if l == 0 {
+				return 0
+			}
+			return tagSize + protowire.SizeBytes(l)
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			v := P(get(m))
+			l := v.Size()
+			if l == 0 {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendVarint(b, uint64(l))
+			n := len(b)
+			b = append(b, make([]byte, l)...)
+			_, err := v.MarshalTo(b[n:])
+			return b, err
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, _ proto.UnmarshalOptions) (int, error) {
+			if wtyp != protowire.BytesType {
+				return 0, errWireType(num, wtyp)
+			}
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			return n, P(get(m)).Unmarshal(v)
+		},
+	}
+}
+
+// mapEntry is the codec of the entries of a map field, whose values are
+// encoded by the value functions.
+type mapEntry[K comparable, V any] struct {
+	num        protowire.Number
+	key        Codec[K]
+	valueType  protowire.Type
+	valueSize  func(v V, opts proto.MarshalOptions) int
+	appendVal  func(b []byte, v V, opts proto.MarshalOptions) ([]byte, error)
+	consumeVal func(b []byte, opts proto.UnmarshalOptions) (V, int, error)
+	defaultVal func() V
+}
+
+var (
+	keyTagSize   = protowire.SizeTag(1)
+	valueTagSize = protowire.SizeTag(2)
+)
+
+func (e mapEntry[K, V]) entrySize(k K, v V, opts proto.MarshalOptions) int {
+	return keyTagSize + e.key.Size(k) + valueTagSize + e.valueSize(v, opts)
+}
+
+// mapField returns the codec of the map field of M described by e.
+func mapField[M any, K comparable, V any](e mapEntry[K, V], get func(*M) *map[K]V) Field[M] {
+	tagSize := protowire.SizeTag(e.num)
+	appendEntry := func(b []byte, k K, v V, opts proto.MarshalOptions) ([]byte, error) {
+		b = protowire.AppendTag(b, e.num, protowire.BytesType)
+		b = protowire.AppendVarint(b, uint64(e.entrySize(k, v, opts)))
+		b = protowire.AppendTag(b, 1, e.key.WireType)
+		b = e.key.Append(b, k)
+		b = protowire.AppendTag(b, 2, e.valueType)
+		return e.appendVal(b, v, opts)
+	}
+	return Field[M]{
+		num: e.num,
+		size: func(m *M, opts proto.MarshalOptions) int {
+			var n int
+			for k, v := range *get(m) {
+				n += tagSize + protowire.SizeBytes(e.entrySize(k, v, opts))
+			}
+			return n
+		},
+		marshal: func(b []byte, m *M, opts proto.MarshalOptions) ([]byte, error) {
+			entries := *get(m)
+			var err error
+			if !opts.Deterministic {
+				for k, v := range entries {
+					if b, err = appendEntry(b, k, v, opts); err != nil {
+						return b, err
+					}
+				}
+				return b, nil
+			}
+			keys := make([]K, 0, len(entries))
+			for k := range entries {
+				keys = append(keys, k)
+			}
+			sort.Slice(keys, func(i, j int) bool {
+				return runtime.LessMapKey(keys[i], keys[j])
+			})
+			for _, k := range keys {
+				if b, err = appendEntry(b, k, entries[k], opts); err != nil {
+					return b, err
+				}
+			}
+			return b, nil
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, opts proto.UnmarshalOptions) (int, error) {
+			if wtyp != protowire.BytesType {
+				return 0, errWireType(e.num, wtyp)
+			}
+			entry, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			var (
+				k        K
+				v        V
+				hasValue bool
+			)
+			for len(entry) > 0 {
+				num, typ, tn := protowire.ConsumeTag(entry)
+				if tn < 0 {
+					return 0, protowire.ParseError(tn)
+				}
+				entry = entry[tn:]
+				var vn int
+				switch {
+				case num == 1 && typ == e.key.WireType:
+					k, vn = e.key.Consume(entry)
+				case num == 2 && typ == e.valueType:
+					var err error
+					if v, vn, err = e.consumeVal(entry, opts); err != nil {
+						return 0, err
+					}
+					hasValue = true
+				default:
+					vn = protowire.ConsumeFieldValue(num, typ, entry)
+				}
+				if vn < 0 {
+					return 0, protowire.ParseError(vn)
+				}
+				entry = entry[vn:]
+			}
+			if !hasValue {
+				v = e.defaultVal()
+			}
+			entries := get(m)
+			if *entries == nil {
+				*entries = make(map[K]V)
+			}
+			(*entries)[k] = v
+			return n, nil
+		},
+	}
+}
+
+// Map returns the codec of a map field with scalar values.
+func Map[M any, K comparable, V any](num protowire.Number, key Codec[K], value Codec[V], get func(*M) *map[K]V) Field[M] {
+	return mapField(mapEntry[K, V]{
+		num:       num,
+		key:       key,
+		valueType: value.WireType,
+		valueSize: func(v V, _ proto.MarshalOptions) int { return value.Size(v) },
+		appendVal: func(b []byte, v V, _ proto.MarshalOptions) ([]byte, error) {
+			return value.Append(b, v), nil
+		},
+		consumeVal: func(b []byte, _ proto.UnmarshalOptions) (V, int, error) {
+			v, n := value.Consume(b)
+			return v, n, nil
+		},
+		defaultVal: func() V {
+			var zero V
+			return zero
+		},
+	}, get)
+}
+
+// MessageMap returns the codec of a map field with message values.
+func MessageMap[M any, K comparable, T any, P messagePtr[T]](num protowire.Number, key Codec[K], get func(*M) *map[K]P) Field[M] {
+	return mapField(mapEntry[K, P]{
+		num:       num,
+		key:       key,
+		valueType: protowire.BytesType,
+		valueSize: func(p P, opts proto.MarshalOptions) int {
+			return protowire.SizeBytes(sizeMessage(p, opts))
+		},
+		appendVal: func(b []byte, p P, opts proto.MarshalOptions) ([]byte, error) {
+			return appendMessage(b, p, opts)
+		},
+		consumeVal: func(b []byte, opts proto.UnmarshalOptions) (P, int, error) {
+			p := P(new(T))
+			n, err := consumeMessage(b, p, opts)
+			return p, n, err
+		},
+		defaultVal: func() P { return new(T) },
+	}, get)
+}
+
+// OneofScalar returns the codec of a scalar field of a oneof, whose value is
+// the field of the wrapper type W set in the oneof field of type I of M.
+func OneofScalar[M, I, W, T any](num protowire.Number, c Codec[T], oneof func(*M) *I, field func(*W) *T) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, _ proto.MarshalOptions) int {
+			w, ok := any(*oneof(m)).(*W)
+			if !ok || w == nil {
+				return 0
+			}
+			return tagSize + c.Size(*field(w))
+		},
+		marshal: func(b []byte, m *M, _ proto.MarshalOptions) ([]byte, error) {
+			w, ok := any(*oneof(m)).(*W)
+			if !ok || w == nil {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, c.WireType)
+			return c.Append(b, *field(w)), nil
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, _ proto.UnmarshalOptions) (int, error) {
+			if wtyp != c.WireType {
+				return 0, errWireType(num, wtyp)
+			}
+			v, n := c.Consume(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			w := new(W)
+			*field(w) = v
+			*oneof(m) = any(w).(I)
+			return n, nil
+		},
+	}
+}
+
+// OneofMessage returns the codec of a message field of a oneof, whose value is
+// the field of the wrapper type W set in the oneof field of type I of M.
+func OneofMessage[M, I, W, T any, P messagePtr[T]](num protowire.Number, oneof func(*M) *I, field func(*W) *P) Field[M] {
+	tagSize := protowire.SizeTag(num)
+	return Field[M]{
+		num: num,
+		size: func(m *M, opts proto.MarshalOptions) int {
+			w, ok := any(*oneof(m)).(*W)
+			if !ok || w == nil {
+				return 0
+			}
+			return tagSize + protowire.SizeBytes(sizeMessage(*field(w), opts))
+		},
+		marshal: func(b []byte, m *M, opts proto.MarshalOptions) ([]byte, error) {
+			w, ok := any(*oneof(m)).(*W)
+			if !ok || w == nil {
+				return b, nil
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			return appendMessage(b, *field(w), opts)
+		},
+		unmarshal: func(b []byte, wtyp protowire.Type, m *M, opts proto.UnmarshalOptions) (int, error) {
+			if wtyp != protowire.BytesType {
+				return 0, errWireType(num, wtyp)
+			}
+			p := P(new(T))
+			w := new(W)
+			*field(w) = p
+			*oneof(m) = any(w).(I)
+			return consumeMessage(b, p, opts)
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+type optionalMessage struct {
+	value *int64
+	bytes []byte
+}
+
+func TestOptional(t *testing.T) {
+	f := Optional(3, Sint64, func(m *optionalMessage) **int64 { return &m.value })
+
+	// unset fields are not encoded, set fields are even when zero
+	m := &optionalMessage{}
+	require.Zero(t, f.size(m, proto.MarshalOptions{}))
+	zero := int64(0)
+	m.value = &zero
+	b, err := f.marshal(nil, m, proto.MarshalOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []byte{3 << 3, 0}, b)
+	require.Equal(t, len(b), f.size(m, proto.MarshalOptions{}))
+
+	got := &optionalMessage{}
+	n, err := f.unmarshal(protowire.AppendVarint(nil, protowire.EncodeZigZag(-2)), protowire.VarintType, got, proto.UnmarshalOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, int64(-2), *got.value)
+
+	_, err = f.unmarshal(nil, protowire.BytesType, got, proto.UnmarshalOptions{})
+	require.ErrorContains(t, err, "wrong wireType = 2 for field 3")
+}
+
+func TestOptionalBytes(t *testing.T) {
+	f := OptionalBytes(4, func(m *optionalMessage) *[]byte { return &m.bytes })
+
+	// nil fields are not encoded, empty fields are
+	m := &optionalMessage{}
+	require.Zero(t, f.size(m, proto.MarshalOptions{}))
+	m.bytes = []byte{}
+	b, err := f.marshal(nil, m, proto.MarshalOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []byte{4<<3 | 2, 0}, b)
+	require.Equal(t, len(b), f.size(m, proto.MarshalOptions{}))
+
+	got := &optionalMessage{}
+	n, err := f.unmarshal([]byte{0}, protowire.BytesType, got, proto.UnmarshalOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.NotNil(t, got.bytes)
+	require.Empty(t, got.bytes)
+}
+
+func TestNewPanics(t *testing.T) {
+	get := func(m *optionalMessage) **int64 { return &m.value }
+	require.PanicsWithValue(t, "duplicate field number 300", func() {
+		New(nil, Optional(300, Int64, get), Optional(300, Int64, get))
+	})
+	require.Panics(t, func() {
+		Packed(1, String, func(*optionalMessage) *[]string { return nil })
+	})
+}
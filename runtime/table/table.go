@@ -0,0 +1,138 @@
+// Package table implements the size, marshal and unmarshal methods of the
+// messages generated with the codec=table parameter, from tables of codecs
+// describing their fields, rather than from code unrolled for every message.
+package table
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-proto/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// maxDenseNumber is the highest field number looked up in a slice rather than a map.
+const maxDenseNumber = 256
+
+// Table is the codec of the messages of type M.
+type Table[M any] struct {
+	unknown func(*M) *[]byte
+	// fields in marshal order
+	fields []Field[M]
+	// dense indexes the fields by number up to maxDenseNumber, sparse
+	// holds the fields with higher numbers.
+	dense  []*Field[M]
+	sparse map[protowire.Number]*Field[M]
+}
+
+// New returns the codec of the messages of type M, whose unknown fields are
+// returned by unknown, from the codecs of its fields in marshal order.
+func New[M any](unknown func(*M) *[]byte, fields ...Field[M]) *Table[M] {
+	t := &Table[M]{unknown: unknown, fields: fields}
+	for i := range t.fields {
+		f := &t.fields[i]
+		if t.lookup(f.num) != nil {
+			panic(fmt.Sprintf("duplicate field number %d", f.num))
+		}
+		if f.num > maxDenseNumber {
+			if t.sparse == nil {
+				t.sparse = make(map[protowire.Number]*Field[M])
+			}
+			t.sparse[f.num] = f
+			continue
+		}
+		if int(f.num) >= len(t.dense) {
+			t.dense = append(t.dense, make([]*Field[M], int(f.num)+1-len(t.dense))...)
+		}
+		t.dense[f.num] = f
+	}
+	return t
+}
+
+func (t *Table[M]) lookup(num protowire.Number) *Field[M] {
+	if num < protowire.Number(len(t.dense)) {
+		return t.dense[num]
+	}
+	return t.sparse[num]
+}
+
+// Methods returns the protoiface.Methods of the messages, returned by their
+// ProtoMethods method.
+func (t *Table[M]) Methods() *protoiface.Methods {
+	return &protoiface.Methods{
+		Flags:            protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:             t.size,
+		Marshal:          t.marshal,
+		Unmarshal:        t.unmarshal,
+		CheckInitialized: checkInitialized,
+	}
+}
+
+func (t *Table[M]) size(input protoiface.SizeInput) protoiface.SizeOutput {
+	x, _ := any(input.Message.Interface()).(*M)
+	if x == nil {
+		return protoiface.SizeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	}
+	options := runtime.SizeInputToOptions(input)
+	n := len(*t.unknown(x))
+	for i := range t.fields {
+		n += t.fields[i].size(x, options)
+	}
+	return protoiface.SizeOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Size: n}
+}
+
+func (t *Table[M]) marshal(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	x, _ := any(input.Message.Interface()).(*M)
+	if x == nil {
+		return protoiface.MarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Buf: input.Buf}, nil
+	}
+	options := runtime.MarshalInputToOptions(input)
+	b := input.Buf
+	var err error
+	for i := range t.fields {
+		if b, err = t.fields[i].marshal(b, x, options); err != nil {
+			return protoiface.MarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Buf: input.Buf}, err
+		}
+	}
+	b = append(b, *t.unknown(x)...)
+	return protoiface.MarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Buf: b}, nil
+}
+
+func (t *Table[M]) unmarshal(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+	output := protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals}
+	x, _ := any(input.Message.Interface()).(*M)
+	if x == nil {
+		return output, nil
+	}
+	options := runtime.UnmarshalInputToOptions(input)
+	b := input.Buf
+	for len(b) > 0 {
+		num, wtyp, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return output, protowire.ParseError(n)
+		}
+		f := t.lookup(num)
+		if f == nil {
+			vn := protowire.ConsumeFieldValue(num, wtyp, b[n:])
+			if vn < 0 {
+				return output, protowire.ParseError(vn)
+			}
+			if !options.DiscardUnknown {
+				unknown := t.unknown(x)
+				*unknown = append(*unknown, b[:n+vn]...)
+			}
+			b = b[n+vn:]
+			continue
+		}
+		vn, err := f.unmarshal(b[n:], wtyp, x, options)
+		if err != nil {
+			return output, err
+		}
+		b = b[n+vn:]
+	}
+	return output, nil
+}
+
+func checkInitialized(protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+	return protoiface.CheckInitializedOutput{}, nil
+}
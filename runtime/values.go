@@ -0,0 +1,128 @@
+package runtime
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValueConverter converts the Go values of type T, held by the lists and
+// maps of generated messages, to and from protoreflect.Value. Converters are
+// stateless: List and Map use their zero value.
+type ValueConverter[T any] interface {
+	// PBValueOf returns v as a protoreflect.Value.
+	PBValueOf(v T) protoreflect.Value
+	// GoValueOf returns the Go value of v, panicking if v is of the wrong kind.
+	GoValueOf(v protoreflect.Value) T
+	// New returns the default value of the values, an empty message for messages.
+	New() T
+	// Mutable reports whether the values are mutable, which only messages are.
+	Mutable() bool
+}
+
+type scalarValue struct{}
+
+func (scalarValue) Mutable() bool { return false }
+
+// BoolValue converts bool values.
+type BoolValue struct{ scalarValue }
+
+func (BoolValue) PBValueOf(v bool) protoreflect.Value { return protoreflect.ValueOfBool(v) }
+func (BoolValue) GoValueOf(v protoreflect.Value) bool { return v.Bool() }
+func (BoolValue) New() bool                           { return false }
+
+// Int32Value converts the values of int32, sint32 and sfixed32 fields.
+type Int32Value struct{ scalarValue }
+
+func (Int32Value) PBValueOf(v int32) protoreflect.Value { return protoreflect.ValueOfInt32(v) }
+func (Int32Value) GoValueOf(v protoreflect.Value) int32 { return int32(v.Int()) }
+func (Int32Value) New() int32                           { return 0 }
+
+// Int64Value converts the values of int64, sint64 and sfixed64 fields.
+type Int64Value struct{ scalarValue }
+
+func (Int64Value) PBValueOf(v int64) protoreflect.Value { return protoreflect.ValueOfInt64(v) }
+func (Int64Value) GoValueOf(v protoreflect.Value) int64 { return v.Int() }
+func (Int64Value) New() int64                           { return 0 }
+
+// Uint32Value converts the values of uint32 and fixed32 fields.
+type Uint32Value struct{ scalarValue }
+
+func (Uint32Value) PBValueOf(v uint32) protoreflect.Value { return protoreflect.ValueOfUint32(v) }
+func (Uint32Value) GoValueOf(v protoreflect.Value) uint32 { return uint32(v.Uint()) }
+func (Uint32Value) New() uint32                           { return 0 }
+
+// Uint64Value converts the values of uint64 and fixed64 fields.
+type Uint64Value struct{ scalarValue }
+
+func (Uint64Value) PBValueOf(v uint64) protoreflect.Value { return protoreflect.ValueOfUint64(v) }
+func (Uint64Value) GoValueOf(v protoreflect.Value) uint64 { return v.Uint() }
+func (Uint64Value) New() uint64                           { return 0 }
+
+// Float32Value converts float values.
+type Float32Value struct{ scalarValue }
+
+func (Float32Value) PBValueOf(v float32) protoreflect.Value { return protoreflect.ValueOfFloat32(v) }
+func (Float32Value) GoValueOf(v protoreflect.Value) float32 { return float32(v.Float()) }
+func (Float32Value) New() float32                           { return 0 }
+
+// Float64Value converts double values.
+type Float64Value struct{ scalarValue }
+
+func (Float64Value) PBValueOf(v float64) protoreflect.Value { return protoreflect.ValueOfFloat64(v) }
+func (Float64Value) GoValueOf(v protoreflect.Value) float64 { return v.Float() }
+func (Float64Value) New() float64                           { return 0 }
+
+// StringValue converts string values.
+type StringValue struct{ scalarValue }
+
+func (StringValue) PBValueOf(v string) protoreflect.Value { return protoreflect.ValueOfString(v) }
+func (StringValue) GoValueOf(v protoreflect.Value) string { return v.String() }
+func (StringValue) New() string                           { return "" }
+
+// BytesValue converts bytes values.
+type BytesValue struct{ scalarValue }
+
+func (BytesValue) PBValueOf(v []byte) protoreflect.Value { return protoreflect.ValueOfBytes(v) }
+func (BytesValue) GoValueOf(v protoreflect.Value) []byte { return v.Bytes() }
+func (BytesValue) New() []byte                           { return nil }
+
+// Enum is implemented by the generated enum types.
+type Enum interface {
+	~int32
+	Descriptor() protoreflect.EnumDescriptor
+}
+
+// EnumValue converts the values of the generated enum E.
+type EnumValue[E Enum] struct{ scalarValue }
+
+func (EnumValue[E]) PBValueOf(v E) protoreflect.Value {
+	return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v))
+}
+
+func (EnumValue[E]) GoValueOf(v protoreflect.Value) E { return E(v.Enum()) }
+
+// New returns the first value of E, the default value of enum fields.
+func (EnumValue[E]) New() E {
+	var e E
+	return E(e.Descriptor().Values().Get(0).Number())
+}
+
+// MessagePtr is implemented by the pointers to the generated message M.
+type MessagePtr[M any] interface {
+	*M
+	protoreflect.ProtoMessage
+}
+
+// MessageValue converts the pointers P to the generated message M.
+type MessageValue[M any, P MessagePtr[M]] struct{}
+
+func (MessageValue[M, P]) PBValueOf(v P) protoreflect.Value {
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (MessageValue[M, P]) GoValueOf(v protoreflect.Value) P {
+	return v.Message().Interface().(P)
+}
+
+func (MessageValue[M, P]) New() P { return new(M) }
+
+func (MessageValue[M, P]) Mutable() bool { return true }
@@ -0,0 +1,140 @@
+package runtime
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IsPackable reports whether the repeated fields of the given kind can be packed.
+func IsPackable(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
+
+// WireType returns the wire type of the values of the given kind.
+func WireType(kind protoreflect.Kind) protowire.Type {
+	switch kind {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return protowire.BytesType
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	default:
+		return protowire.VarintType
+	}
+}
+
+// ConsumeScalar decodes the scalar value of the given kind at the start of b,
+// which is encoded with the wire type of the kind, and returns it with its
+// length, which is negative if the value is invalid as for protowire.ParseError.
+// Bytes are copied.
+func ConsumeScalar(kind protoreflect.Kind, b []byte) (protoreflect.Value, int) {
+	switch WireType(kind) {
+	case protowire.VarintType:
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch kind {
+		case protoreflect.BoolKind:
+			return protoreflect.ValueOfBool(protowire.DecodeBool(v)), n
+		case protoreflect.EnumKind:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), n
+		case protoreflect.Int32Kind:
+			return protoreflect.ValueOfInt32(int32(v)), n
+		case protoreflect.Sint32Kind:
+			return protoreflect.ValueOfInt32(int32(protowire.DecodeZigZag(v & math.MaxUint32))), n
+		case protoreflect.Uint32Kind:
+			return protoreflect.ValueOfUint32(uint32(v)), n
+		case protoreflect.Int64Kind:
+			return protoreflect.ValueOfInt64(int64(v)), n
+		case protoreflect.Sint64Kind:
+			return protoreflect.ValueOfInt64(protowire.DecodeZigZag(v)), n
+		default:
+			return protoreflect.ValueOfUint64(v), n
+		}
+	case protowire.Fixed32Type:
+		v, n := protowire.ConsumeFixed32(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch kind {
+		case protoreflect.Sfixed32Kind:
+			return protoreflect.ValueOfInt32(int32(v)), n
+		case protoreflect.FloatKind:
+			return protoreflect.ValueOfFloat32(math.Float32frombits(v)), n
+		default:
+			return protoreflect.ValueOfUint32(v), n
+		}
+	case protowire.Fixed64Type:
+		v, n := protowire.ConsumeFixed64(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch kind {
+		case protoreflect.Sfixed64Kind:
+			return protoreflect.ValueOfInt64(int64(v)), n
+		case protoreflect.DoubleKind:
+			return protoreflect.ValueOfFloat64(math.Float64frombits(v)), n
+		default:
+			return protoreflect.ValueOfUint64(v), n
+		}
+	default:
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		if kind == protoreflect.StringKind {
+			return protoreflect.ValueOfString(string(v)), n
+		}
+		return protoreflect.ValueOfBytes(append([]byte(nil), v...)), n
+	}
+}
+
+// AppendScalar appends the encoding of the scalar value v of the given kind to b,
+// with the wire type of the kind.
+func AppendScalar(b []byte, kind protoreflect.Kind, v protoreflect.Value) []byte {
+	switch WireType(kind) {
+	case protowire.Fixed32Type:
+		return protowire.AppendFixed32(b, uint32(scalarBits(kind, v)))
+	case protowire.Fixed64Type:
+		return protowire.AppendFixed64(b, scalarBits(kind, v))
+	case protowire.BytesType:
+		if kind == protoreflect.StringKind {
+			return protowire.AppendString(b, v.String())
+		}
+		return protowire.AppendBytes(b, v.Bytes())
+	default:
+		return protowire.AppendVarint(b, scalarBits(kind, v))
+	}
+}
+
+// scalarBits returns the bits of the encoding of the scalar v.
+func scalarBits(kind protoreflect.Kind, v protoreflect.Value) uint64 {
+	switch kind {
+	case protoreflect.BoolKind:
+		return protowire.EncodeBool(v.Bool())
+	case protoreflect.EnumKind:
+		return uint64(v.Enum())
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sfixed64Kind:
+		return uint64(v.Int())
+	case protoreflect.Sfixed32Kind:
+		return uint64(uint32(v.Int()))
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.EncodeZigZag(v.Int())
+	case protoreflect.FloatKind:
+		return uint64(math.Float32bits(float32(v.Float())))
+	case protoreflect.DoubleKind:
+		return math.Float64bits(v.Float())
+	default:
+		return v.Uint()
+	}
+}
@@ -0,0 +1,8 @@
+// Package scalar validates the values of fields annotated with the
+// cosmos_proto.scalar option.
+//
+// ScalarDescriptor requires scalars to have a single valid syntactical
+// representation for a given semantic meaning. Validators registered in a
+// Registry therefore return the canonical form of the values they accept,
+// and ValidateScalars rejects values which are valid but not canonical.
+package scalar
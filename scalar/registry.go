@@ -0,0 +1,85 @@
+package scalar
+
+import (
+	"fmt"
+	"sync"
+)
+
+// StringFunc validates the value of a string field annotated with a scalar.
+// It returns the canonical representation of value, or an error if value is
+// not a valid representation of the scalar.
+type StringFunc func(value string) (string, error)
+
+// BytesFunc validates the value of a bytes field annotated with a scalar.
+// It returns the canonical representation of value, or an error if value is
+// not a valid representation of the scalar.
+type BytesFunc func(value []byte) ([]byte, error)
+
+// Registry maps fully-qualified scalar names to the functions validating
+// their values. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	strings map[string]StringFunc
+	bytes   map[string]BytesFunc
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		strings: make(map[string]StringFunc),
+		bytes:   make(map[string]BytesFunc),
+	}
+}
+
+// DefaultRegistry is the registry used by the package level functions.
+var DefaultRegistry = NewRegistry()
+
+// RegisterString registers the validator of the string fields annotated with
+// the named scalar. It returns an error if one is already registered.
+func (r *Registry) RegisterString(name string, fn StringFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.strings[name]; exists {
+		return fmt.Errorf("string validator already registered for scalar %s", name)
+	}
+	r.strings[name] = fn
+	return nil
+}
+
+// RegisterBytes registers the validator of the bytes fields annotated with
+// the named scalar. It returns an error if one is already registered.
+func (r *Registry) RegisterBytes(name string, fn BytesFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.bytes[name]; exists {
+		return fmt.Errorf("bytes validator already registered for scalar %s", name)
+	}
+	r.bytes[name] = fn
+	return nil
+}
+
+// FindString returns the string validator of the named scalar.
+func (r *Registry) FindString(name string) (StringFunc, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	fn, ok := r.strings[name]
+	return fn, ok
+}
+
+// FindBytes returns the bytes validator of the named scalar.
+func (r *Registry) FindBytes(name string) (BytesFunc, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	fn, ok := r.bytes[name]
+	return fn, ok
+}
+
+// RegisterString registers a string validator in the DefaultRegistry.
+func RegisterString(name string, fn StringFunc) error {
+	return DefaultRegistry.RegisterString(name, fn)
+}
+
+// RegisterBytes registers a bytes validator in the DefaultRegistry.
+func RegisterBytes(name string, fn BytesFunc) error {
+	return DefaultRegistry.RegisterBytes(name, fn)
+}
@@ -0,0 +1,116 @@
+package scalar
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrNotCanonical is returned when a scalar value is valid but is not
+// the canonical representation of its semantic value.
+var ErrNotCanonical = errors.New("value is not in canonical form")
+
+// ValidationError reports the field holding an invalid scalar value.
+type ValidationError struct {
+	// Path is the field path of the value relative to the root message,
+	// e.g. "supplies[0].admins[2]".
+	Path   string
+	Scalar string
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: invalid %s: %v", e.Path, e.Scalar, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Name returns the name of the scalar the field is annotated with,
+// or an empty string if it is not annotated.
+func Name(fd protoreflect.FieldDescriptor) string {
+	return proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string)
+}
+
+// ValidateScalars validates msg against the DefaultRegistry.
+func ValidateScalars(msg proto.Message) error {
+	return DefaultRegistry.ValidateScalars(msg)
+}
+
+// ValidateScalars walks msg and checks the value of every populated string
+// and bytes field annotated with a scalar, including list elements and map values,
+// against the validator registered for that scalar. A value must both be valid
+// and be the canonical representation returned by the validator.
+// Fields annotated with scalars which have no registered validator are not checked.
+func (r *Registry) ValidateScalars(msg proto.Message) error {
+	return r.validateMessage(msg.ProtoReflect(), "")
+}
+
+func (r *Registry) validateMessage(m protoreflect.Message, path string) (err error) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		scalar := Name(fd)
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = r.validateValue(fd.Kind(), scalar, list.Get(i), fmt.Sprintf("%s[%d]", fieldPath, i))
+			}
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				err = r.validateValue(fd.MapValue().Kind(), scalar, v, fmt.Sprintf("%s[%v]", fieldPath, k.Interface()))
+				return err == nil
+			})
+		default:
+			err = r.validateValue(fd.Kind(), scalar, v, fieldPath)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func (r *Registry) validateValue(kind protoreflect.Kind, scalar string, v protoreflect.Value, path string) error {
+	switch kind {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return r.validateMessage(v.Message(), path)
+	case protoreflect.StringKind:
+		if scalar == "" {
+			return nil
+		}
+		fn, ok := r.FindString(scalar)
+		if !ok {
+			return nil
+		}
+		canonical, err := fn(v.String())
+		if err == nil && canonical != v.String() {
+			err = ErrNotCanonical
+		}
+		if err != nil {
+			return &ValidationError{Path: path, Scalar: scalar, Err: err}
+		}
+	case protoreflect.BytesKind:
+		if scalar == "" {
+			return nil
+		}
+		fn, ok := r.FindBytes(scalar)
+		if !ok {
+			return nil
+		}
+		canonical, err := fn(v.Bytes())
+		if err == nil && !bytes.Equal(canonical, v.Bytes()) {
+			err = ErrNotCanonical
+		}
+		if err != nil {
+			return &ValidationError{Path: path, Scalar: scalar, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package scalar_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/scalar"
+)
+
+func testRegistry(t *testing.T) *scalar.Registry {
+	r := scalar.NewRegistry()
+	require.NoError(t, r.RegisterString("cosmostest.Address", func(value string) (string, error) {
+		if !strings.HasPrefix(strings.ToLower(value), "addr") {
+			return "", fmt.Errorf("missing addr prefix")
+		}
+		return strings.ToLower(value), nil
+	}))
+	require.NoError(t, r.RegisterString("cosmostest.Int", func(value string) (string, error) {
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return "", fmt.Errorf("not an integer")
+		}
+		if i.Sign() < 0 {
+			return "", fmt.Errorf("negative amount")
+		}
+		return i.String(), nil
+	}))
+	require.NoError(t, r.RegisterBytes("cosmostest.Checksum", func(value []byte) ([]byte, error) {
+		if len(value) != 32 {
+			return nil, fmt.Errorf("expected 32 bytes, got %d", len(value))
+		}
+		return value, nil
+	}))
+	return r
+}
+
+func TestValidateScalars(t *testing.T) {
+	r := testRegistry(t)
+
+	genesis := &cosmostest.Genesis{
+		Admins:  []string{"addr1", "addr2"},
+		Aliases: map[string]string{"alice": "addr3"},
+		Supplies: []*cosmostest.Supply{
+			{Total: &cosmostest.Coin{Denom: "atom", Amount: cosmostest.NewInt(10)}},
+		},
+	}
+	require.NoError(t, r.ValidateScalars(genesis))
+
+	cases := map[string]struct {
+		mutate func(g *cosmostest.Genesis)
+		path   string
+		err    error
+	}{
+		"invalid list element": {
+			mutate: func(g *cosmostest.Genesis) { g.Admins[1] = "bob" },
+			path:   "admins[1]",
+		},
+		"non-canonical list element": {
+			mutate: func(g *cosmostest.Genesis) { g.Admins[0] = "ADDR1" },
+			path:   "admins[0]",
+			err:    scalar.ErrNotCanonical,
+		},
+		"invalid map value": {
+			mutate: func(g *cosmostest.Genesis) { g.Aliases["alice"] = "alice" },
+			path:   "aliases[alice]",
+		},
+		"invalid nested custom type": {
+			mutate: func(g *cosmostest.Genesis) { g.Supplies[0].Total.Amount = cosmostest.NewInt(-1) },
+			path:   "supplies[0].total.amount",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := &cosmostest.Genesis{
+				Admins:   append([]string(nil), genesis.Admins...),
+				Aliases:  map[string]string{"alice": "addr3"},
+				Supplies: []*cosmostest.Supply{{Total: &cosmostest.Coin{Denom: "atom", Amount: cosmostest.NewInt(10)}}},
+			}
+			tc.mutate(g)
+			err := r.ValidateScalars(g)
+			var validationErr *scalar.ValidationError
+			require.True(t, errors.As(err, &validationErr), "got %v", err)
+			require.Equal(t, tc.path, validationErr.Path)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestValidateScalarsDynamic(t *testing.T) {
+	r := testRegistry(t)
+
+	// values which cannot be represented by the generated custom types
+	// are only reachable through dynamic messages.
+	coin := dynamicpb.NewMessage((&cosmostest.Coin{}).ProtoReflect().Descriptor())
+	coin.Set(coin.Descriptor().Fields().ByName("amount"), protoreflect.ValueOfString("007"))
+	err := r.ValidateScalars(coin)
+	require.ErrorIs(t, err, scalar.ErrNotCanonical)
+	require.EqualError(t, err, "amount: invalid cosmostest.Int: value is not in canonical form")
+
+	supply := dynamicpb.NewMessage((&cosmostest.Supply{}).ProtoReflect().Descriptor())
+	supply.Set(supply.Descriptor().Fields().ByName("checksum"), protoreflect.ValueOfBytes([]byte{1, 2}))
+	supply.Set(supply.Descriptor().Fields().ByName("total"), protoreflect.ValueOfMessage(coin))
+	err = r.ValidateScalars(supply)
+	var validationErr *scalar.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, []string{"checksum", "total.amount"}, validationErr.Path)
+}
+
+func TestValidateScalarsUnregistered(t *testing.T) {
+	msg := &cosmostest.MsgSend{FromAddress: "anything", ToAddress: "goes"}
+	require.NoError(t, scalar.NewRegistry().ValidateScalars(msg))
+	require.Error(t, testRegistry(t).ValidateScalars(msg))
+}
+
+func TestRegisterTwice(t *testing.T) {
+	r := testRegistry(t)
+	require.Error(t, r.RegisterString("cosmostest.Address", func(value string) (string, error) { return value, nil }))
+	require.NoError(t, r.RegisterBytes("cosmostest.Address", func(value []byte) ([]byte, error) { return value, nil }))
+}
@@ -2,13 +2,46 @@
 
 set -e
 
+# custom types used by the scalar annotated fields of the test protos
+SCALAR_OPTS="--go-pulsar_opt=scalar=cosmostest.Int=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Int \
+  --go-pulsar_opt=scalar=cosmostest.Checksum=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Checksum"
+
+# messages of the filter test protos generated with slow reflection
+FILTER_OPTS="--go-pulsar_opt=exclude=filtertest.Query* --go-pulsar_opt=exclude=filtertest.*.Nested"
+
 build() {
     echo finding protobuf files in "$1"
-    proto_files=$(find "$1" -name "*.proto")
-    for file in $proto_files; do
-      echo "building proto file $file"
-      protoc -I=. --plugin /usr/bin/protoc-gen-go-pulsar --go-pulsar_out=. --go-pulsar_opt=features=protoc+fast "$file"
-    done
+    proto_files=$(find "$1" -maxdepth 1 -name "*.proto")
+    # the files of a package are built together so that per package
+    # helpers, such as RegisterInterfaceImplementations, are complete
+    echo "building proto files" $proto_files
+    # the reservedtest protos keep their conflicting field names
+    case "$1" in
+        *reservedtest*) RESERVED_OPTS="--go-pulsar_opt=reserved_names=separate" ;;
+        *) RESERVED_OPTS="" ;;
+    esac
+    # the splittest protos generate each feature in its own file
+    case "$1" in
+        *splittest*) SPLIT_OPTS="--go-pulsar_opt=split_features=true" ;;
+        *) SPLIT_OPTS="" ;;
+    esac
+    # the tabletest protos use the table-driven codec, their unrolled copies the default one
+    case "$1" in
+        *tabletest/unrolled*) CODEC_OPTS="--go-pulsar_opt=scalar=tabletest.Int=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Int" ;;
+        *tabletest*) CODEC_OPTS="--go-pulsar_opt=codec=table --go-pulsar_opt=scalar=tabletest.Int=github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest.Int" ;;
+        *) CODEC_OPTS="" ;;
+    esac
+    # the stabletest protos range over their fields in a deterministic order
+    case "$1" in
+        *stabletest*) STABLE_OPTS="--go-pulsar_opt=stable_range=true" ;;
+        *) STABLE_OPTS="" ;;
+    esac
+    # the testpb protos also generate the genid constants and the field mask paths
+    case "$1" in
+        *testpb*) FEATURES="protoc+fast+interfaces+grpc+genid+fieldmask" ;;
+        *) FEATURES="protoc+fast+interfaces+grpc" ;;
+    esac
+    protoc -I=. -I=./proto --plugin /usr/bin/protoc-gen-go-pulsar --go-pulsar_out=. --go-pulsar_opt=features=$FEATURES $SCALAR_OPTS $FILTER_OPTS $RESERVED_OPTS $SPLIT_OPTS $CODEC_OPTS $STABLE_OPTS $proto_files
 }
 
 for dir in "$@"
@@ -0,0 +1,140 @@
+package bech32
+
+import (
+	"errors"
+	"fmt"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/scalar"
+)
+
+// AddressScalarName is the fully-qualified name of the bech32 account address scalar.
+const AddressScalarName = "cosmos.AddressString"
+
+// MaxAddressLen is the maximum length in bytes of an address.
+const MaxAddressLen = 255
+
+var (
+	// ErrUnknownPrefix is returned when an address has a human-readable
+	// prefix which is not accepted by the AddressCodec.
+	ErrUnknownPrefix = errors.New("bech32: unknown address prefix")
+	// ErrInvalidAddressLength is returned when the bytes of an address are
+	// empty or longer than allowed.
+	ErrInvalidAddressLength = errors.New("bech32: invalid address length")
+)
+
+// AddressCodec converts bech32 account addresses between their string
+// and bytes representations.
+type AddressCodec struct {
+	// HRPs are the accepted human-readable prefixes. BytesToString encodes
+	// with the first one. At least one prefix is required.
+	HRPs []string
+
+	// Variant is the checksum variant of the addresses. Defaults to Bech32.
+	Variant Variant
+
+	// MaxLen is the maximum length in bytes of an address. Defaults to MaxAddressLen.
+	MaxLen int
+}
+
+// NewAddressCodec returns an AddressCodec accepting bech32 addresses with the given prefixes.
+func NewAddressCodec(hrps ...string) AddressCodec {
+	return AddressCodec{HRPs: hrps}
+}
+
+func (c AddressCodec) variant() Variant {
+	if c.Variant == 0 {
+		return Bech32
+	}
+	return c.Variant
+}
+
+func (c AddressCodec) checkLen(bz []byte) error {
+	maxLen := c.MaxLen
+	if maxLen <= 0 {
+		maxLen = MaxAddressLen
+	}
+	if len(bz) == 0 || len(bz) > maxLen {
+		return fmt.Errorf("%w: %d bytes", ErrInvalidAddressLength, len(bz))
+	}
+	return nil
+}
+
+func (c AddressCodec) checkHRP(hrp string) error {
+	for _, accepted := range c.HRPs {
+		if hrp == accepted {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownPrefix, hrp)
+}
+
+// StringToBytes decodes the address s. Upper case addresses are accepted.
+func (c AddressCodec) StringToBytes(s string) ([]byte, error) {
+	_, bz, err := c.decode(s)
+	return bz, err
+}
+
+func (c AddressCodec) decode(s string) (string, []byte, error) {
+	hrp, bz, v, err := DecodeBytes(s)
+	if err != nil {
+		return "", nil, err
+	}
+	if v != c.variant() {
+		return "", nil, fmt.Errorf("%w: expected %v, got %v", ErrInvalidChecksum, c.variant(), v)
+	}
+	if err := c.checkHRP(hrp); err != nil {
+		return "", nil, err
+	}
+	if err := c.checkLen(bz); err != nil {
+		return "", nil, err
+	}
+	return hrp, bz, nil
+}
+
+// BytesToString encodes bz with the first accepted prefix.
+func (c AddressCodec) BytesToString(bz []byte) (string, error) {
+	if len(c.HRPs) == 0 {
+		return "", fmt.Errorf("%w: no prefix configured", ErrUnknownPrefix)
+	}
+	return c.BytesToStringWithPrefix(c.HRPs[0], bz)
+}
+
+// BytesToStringWithPrefix encodes bz with the accepted prefix hrp.
+func (c AddressCodec) BytesToStringWithPrefix(hrp string, bz []byte) (string, error) {
+	if err := c.checkHRP(hrp); err != nil {
+		return "", err
+	}
+	if err := c.checkLen(bz); err != nil {
+		return "", err
+	}
+	return EncodeBytes(hrp, bz, c.variant())
+}
+
+// Validate checks that s is a valid address and returns its canonical lower case form.
+// It implements scalar.StringFunc.
+func (c AddressCodec) Validate(s string) (string, error) {
+	hrp, bz, err := c.decode(s)
+	if err != nil {
+		return "", err
+	}
+	return EncodeBytes(hrp, bz, c.variant())
+}
+
+// RegisterScalar registers c as the validator of the named string scalar in r.
+func (c AddressCodec) RegisterScalar(r *scalar.Registry, name string) error {
+	if len(c.HRPs) == 0 {
+		return fmt.Errorf("%w: no prefix configured", ErrUnknownPrefix)
+	}
+	return r.RegisterString(name, c.Validate)
+}
+
+// AddressScalarDescriptor returns the declaration of the AddressString scalar,
+// to be declared with declare_scalar in cosmos/scalars.proto.
+func AddressScalarDescriptor() *cosmos_proto.ScalarDescriptor {
+	return &cosmos_proto.ScalarDescriptor{
+		Name:        "AddressString",
+		Description: "AddressString is a lower case bech32 encoded account address.",
+		FieldType:   []cosmos_proto.ScalarType{cosmos_proto.ScalarType_SCALAR_TYPE_STRING},
+	}
+}
@@ -0,0 +1,101 @@
+package bech32
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+
+	"github.com/cosmos/cosmos-proto/internal/testprotos/cosmostest"
+	"github.com/cosmos/cosmos-proto/rapidproto"
+	"github.com/cosmos/cosmos-proto/scalar"
+)
+
+func TestAddressCodec(t *testing.T) {
+	codec := NewAddressCodec("cosmos", "cosmosvaloper")
+	bz := make([]byte, 20)
+	for i := range bz {
+		bz[i] = byte(i)
+	}
+
+	s, err := codec.BytesToString(bz)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(s, "cosmos1"))
+	decoded, err := codec.StringToBytes(s)
+	require.NoError(t, err)
+	require.Equal(t, bz, decoded)
+
+	decoded, err = codec.StringToBytes(strings.ToUpper(s))
+	require.NoError(t, err)
+	require.Equal(t, bz, decoded)
+
+	valoper, err := codec.BytesToStringWithPrefix("cosmosvaloper", bz)
+	require.NoError(t, err)
+	_, err = codec.StringToBytes(valoper)
+	require.NoError(t, err)
+
+	_, err = codec.BytesToStringWithPrefix("osmo", bz)
+	require.ErrorIs(t, err, ErrUnknownPrefix)
+	osmo, err := EncodeBytes("osmo", bz, Bech32)
+	require.NoError(t, err)
+	_, err = codec.StringToBytes(osmo)
+	require.ErrorIs(t, err, ErrUnknownPrefix)
+
+	m, err := EncodeBytes("cosmos", bz, Bech32m)
+	require.NoError(t, err)
+	_, err = codec.StringToBytes(m)
+	require.ErrorIs(t, err, ErrInvalidChecksum)
+
+	_, err = codec.BytesToString(nil)
+	require.ErrorIs(t, err, ErrInvalidAddressLength)
+	_, err = AddressCodec{HRPs: []string{"cosmos"}, MaxLen: 20}.BytesToString(make([]byte, 32))
+	require.ErrorIs(t, err, ErrInvalidAddressLength)
+}
+
+func TestValidateAddressScalar(t *testing.T) {
+	codec := NewAddressCodec("cosmos")
+	r := scalar.NewRegistry()
+	require.NoError(t, codec.RegisterScalar(r, "cosmostest.Address"))
+
+	addr, err := codec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+
+	require.NoError(t, r.ValidateScalars(&cosmostest.MsgSend{FromAddress: addr, ToAddress: addr}))
+	require.ErrorIs(t, r.ValidateScalars(&cosmostest.MsgSend{FromAddress: strings.ToUpper(addr)}), scalar.ErrNotCanonical)
+	require.ErrorIs(t, r.ValidateScalars(&cosmostest.MsgSend{FromAddress: addr + "q"}), ErrInvalidChecksum)
+	require.Error(t, AddressCodec{}.RegisterScalar(r, "cosmostest.Other"))
+}
+
+func TestFieldMapper(t *testing.T) {
+	codec := NewAddressCodec("cosmos", "cosmosvaloper")
+	r := scalar.NewRegistry()
+	require.NoError(t, codec.RegisterScalar(r, "cosmostest.Address"))
+
+	// the fields mapped to custom types need valid values as well
+	customTypes := func(t *rapid.T, fd protoreflect.FieldDescriptor, name string) (protoreflect.Value, bool) {
+		switch scalar.Name(fd) {
+		case "cosmostest.Int":
+			return protoreflect.ValueOfString(fmt.Sprint(rapid.Int64().Draw(t, name))), true
+		case "cosmostest.Checksum":
+			return protoreflect.ValueOfBytes(rapid.SliceOfN(rapid.Byte(), 32, 32).Draw(t, name)), true
+		}
+		return protoreflect.Value{}, false
+	}
+	opts := rapidproto.GeneratorOptions{
+		FieldMaps: []rapidproto.FieldMapper{codec.FieldMapper("cosmostest.Address"), customTypes},
+	}
+	rapid.Check(t, func(t *rapid.T) {
+		genesis := rapidproto.MessageGenerator(&cosmostest.Genesis{}, opts).Draw(t, "genesis")
+		require.NoError(t, r.ValidateScalars(genesis))
+		for _, addr := range genesis.Aliases {
+			_, err := codec.StringToBytes(addr)
+			require.NoError(t, err)
+		}
+
+		send := rapidproto.MessageGenerator(&cosmostest.MsgSend{}, opts).Draw(t, "send")
+		require.NoError(t, r.ValidateScalars(send))
+	})
+}
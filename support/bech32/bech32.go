@@ -0,0 +1,247 @@
+package bech32
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Variant is the checksum variant of a bech32 string.
+type Variant int
+
+const (
+	// Bech32 is the original checksum variant specified by BIP 173.
+	Bech32 Variant = iota + 1
+	// Bech32m is the modified checksum variant specified by BIP 350.
+	Bech32m
+)
+
+func (v Variant) String() string {
+	switch v {
+	case Bech32:
+		return "bech32"
+	case Bech32m:
+		return "bech32m"
+	default:
+		return fmt.Sprintf("Variant(%d)", int(v))
+	}
+}
+
+func (v Variant) constant() uint32 {
+	if v == Bech32m {
+		return 0x2bc830a3
+	}
+	return 1
+}
+
+// MaxLength is the maximum length of a bech32 string. BIP 173 limits it to 90
+// characters, which is too short for some addresses, so a larger limit is used.
+const MaxLength = 1023
+
+const (
+	charset      = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	checksumLen  = 6
+	separator    = '1'
+	minHRPLength = 1
+)
+
+var (
+	// ErrInvalidLength is returned when a string is too short or too long.
+	ErrInvalidLength = errors.New("bech32: invalid length")
+	// ErrMixedCase is returned when a string contains both upper and lower case characters.
+	ErrMixedCase = errors.New("bech32: mixed case")
+	// ErrInvalidSeparator is returned when a string has no separator or an empty prefix.
+	ErrInvalidSeparator = errors.New("bech32: invalid separator position")
+	// ErrInvalidCharacter is returned when a string contains a character outside of
+	// the allowed ranges of the prefix and data parts.
+	ErrInvalidCharacter = errors.New("bech32: invalid character")
+	// ErrInvalidChecksum is returned when the checksum of a string matches neither variant.
+	ErrInvalidChecksum = errors.New("bech32: invalid checksum")
+	// ErrInvalidPadding is returned when converting bits leaves non-zero or excess padding.
+	ErrInvalidPadding = errors.New("bech32: invalid padding")
+)
+
+var charsetRev = func() (rev [128]int8) {
+	for i := range rev {
+		rev[i] = -1
+	}
+	for i, c := range charset {
+		rev[c] = int8(i)
+	}
+	return rev
+}()
+
+func polymod(values []byte, chk uint32) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	exp := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		exp = append(exp, hrp[i]>>5)
+	}
+	exp = append(exp, 0)
+	for i := 0; i < len(hrp); i++ {
+		exp = append(exp, hrp[i]&31)
+	}
+	return exp
+}
+
+func checksum(hrp string, data []byte, v Variant) []byte {
+	values := append(hrpExpand(hrp), data...)
+	values = append(values, make([]byte, checksumLen)...)
+	mod := polymod(values, 1) ^ v.constant()
+	sum := make([]byte, checksumLen)
+	for i := range sum {
+		sum[i] = byte(mod>>uint(5*(5-i))) & 31
+	}
+	return sum
+}
+
+// Encode encodes the 5-bit groups in data with the human-readable prefix hrp.
+// The result is always lower case.
+func Encode(hrp string, data []byte, v Variant) (string, error) {
+	if v != Bech32 && v != Bech32m {
+		return "", fmt.Errorf("bech32: unknown variant %v", v)
+	}
+	if len(hrp) < minHRPLength || len(hrp)+len(data)+1+checksumLen > MaxLength {
+		return "", ErrInvalidLength
+	}
+	if strings.ToLower(hrp) != hrp && strings.ToUpper(hrp) != hrp {
+		return "", ErrMixedCase
+	}
+	hrp = strings.ToLower(hrp)
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", ErrInvalidCharacter
+		}
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + checksumLen)
+	sb.WriteString(hrp)
+	sb.WriteByte(separator)
+	for _, b := range data {
+		if b >= 32 {
+			return "", fmt.Errorf("%w: data value %d is not a 5-bit group", ErrInvalidCharacter, b)
+		}
+		sb.WriteByte(charset[b])
+	}
+	for _, b := range checksum(hrp, data, v) {
+		sb.WriteByte(charset[b])
+	}
+	return sb.String(), nil
+}
+
+// Decode decodes a bech32 or bech32m string. It returns the lower case
+// human-readable prefix, the 5-bit groups of the data part without the checksum,
+// and the checksum variant. Strings must be either all lower case or all upper case.
+func Decode(s string) (hrp string, data []byte, v Variant, err error) {
+	if len(s) > MaxLength {
+		return "", nil, 0, ErrInvalidLength
+	}
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return "", nil, 0, ErrMixedCase
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 33 || s[i] > 126 {
+			return "", nil, 0, ErrInvalidCharacter
+		}
+	}
+
+	sep := strings.LastIndexByte(lower, separator)
+	if sep < minHRPLength {
+		return "", nil, 0, ErrInvalidSeparator
+	}
+	if len(lower)-sep-1 < checksumLen {
+		return "", nil, 0, ErrInvalidLength
+	}
+	hrp = lower[:sep]
+
+	values := make([]byte, 0, len(lower)-sep-1)
+	for i := sep + 1; i < len(lower); i++ {
+		c := charsetRev[lower[i]]
+		if c < 0 {
+			return "", nil, 0, fmt.Errorf("%w: %q", ErrInvalidCharacter, lower[i])
+		}
+		values = append(values, byte(c))
+	}
+
+	switch polymod(append(hrpExpand(hrp), values...), 1) {
+	case Bech32.constant():
+		v = Bech32
+	case Bech32m.constant():
+		v = Bech32m
+	default:
+		return "", nil, 0, ErrInvalidChecksum
+	}
+	return hrp, values[:len(values)-checksumLen], v, nil
+}
+
+// ConvertBits regroups the bits of data from groups of fromBits bits to groups of
+// toBits bits, e.g. from bytes to the 5-bit groups accepted by Encode.
+// If pad is true the last group is padded with zeros, otherwise any leftover
+// bits must be zero and fewer than fromBits.
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	if fromBits < 1 || fromBits > 8 || toBits < 1 || toBits > 8 {
+		return nil, fmt.Errorf("bech32: invalid bit group sizes %d and %d", fromBits, toBits)
+	}
+	var (
+		acc  uint32
+		bits uint
+		out  = make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+		max  = uint32(1)<<toBits - 1
+	)
+	for _, b := range data {
+		if uint32(b)>>fromBits != 0 {
+			return nil, fmt.Errorf("%w: value %d does not fit in %d bits", ErrInvalidCharacter, b, fromBits)
+		}
+		acc = acc<<fromBits | uint32(b)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&max))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&max))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&max != 0 {
+		return nil, ErrInvalidPadding
+	}
+	return out, nil
+}
+
+// EncodeBytes converts data to 5-bit groups and encodes it with the human-readable prefix hrp.
+func EncodeBytes(hrp string, data []byte, v Variant) (string, error) {
+	conv, err := ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return Encode(hrp, conv, v)
+}
+
+// DecodeBytes decodes s and converts its data part back to bytes.
+func DecodeBytes(s string) (hrp string, data []byte, v Variant, err error) {
+	hrp, conv, v, err := Decode(s)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	data, err = ConvertBits(conv, 5, 8, false)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	return hrp, data, v, nil
+}
@@ -0,0 +1,94 @@
+package bech32
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestDecodeValid(t *testing.T) {
+	tcs := []struct {
+		s       string
+		variant Variant
+	}{
+		// BIP 173 test vectors
+		{"A12UEL5L", Bech32},
+		{"a12uel5l", Bech32},
+		{"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs", Bech32},
+		{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", Bech32},
+		{"11qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqc8247j", Bech32},
+		{"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w", Bech32},
+		{"?1ezyfcl", Bech32},
+		// BIP 350 test vectors
+		{"A1LQFN3A", Bech32m},
+		{"a1lqfn3a", Bech32m},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", Bech32m},
+		{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", Bech32m},
+		{"?1v759aa", Bech32m},
+	}
+	for _, tc := range tcs {
+		hrp, data, v, err := Decode(tc.s)
+		require.NoError(t, err, tc.s)
+		require.Equal(t, tc.variant, v, tc.s)
+
+		encoded, err := Encode(hrp, data, v)
+		require.NoError(t, err, tc.s)
+		require.Equal(t, strings.ToLower(tc.s), encoded)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tcs := []struct {
+		s   string
+		err error
+	}{
+		{"\x201nwldj5", ErrInvalidCharacter},
+		{"\x7f1axkwrx", ErrInvalidCharacter},
+		{"pzry9x0s0muk", ErrInvalidSeparator},
+		{"1pzry9x0s0muk", ErrInvalidSeparator},
+		{"x1b4n0q5v", ErrInvalidCharacter},
+		{"li1dgmt3", ErrInvalidLength},
+		{"A1G7SGD8", ErrInvalidChecksum},
+		{"10a06t8", ErrInvalidSeparator},
+		{"1qzzfhee", ErrInvalidSeparator},
+		{"a12UEL5L", ErrMixedCase},
+		{"M1VUXWEZ", ErrInvalidChecksum},
+		{"a1" + strings.Repeat("q", MaxLength), ErrInvalidLength},
+	}
+	for _, tc := range tcs {
+		_, _, _, err := Decode(tc.s)
+		require.ErrorIs(t, err, tc.err, "%q", tc.s)
+	}
+}
+
+func TestConvertBits(t *testing.T) {
+	// BIP 173 segwit example, without the witness version
+	_, data, _, err := Decode("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+	require.NoError(t, err)
+	program, err := ConvertBits(data[1:], 5, 8, false)
+	require.NoError(t, err)
+	require.Equal(t, "751e76e8199196d454941c45d1b3a323f1433bd6", hex.EncodeToString(program))
+
+	_, err = ConvertBits([]byte{0x1f}, 5, 8, false)
+	require.ErrorIs(t, err, ErrInvalidPadding)
+	_, err = ConvertBits([]byte{0xff}, 5, 8, true)
+	require.ErrorIs(t, err, ErrInvalidCharacter)
+
+	rapid.Check(t, func(t *rapid.T) {
+		bz := rapid.SliceOf(rapid.Byte()).Draw(t, "bytes")
+		v := rapid.SampledFrom([]Variant{Bech32, Bech32m}).Draw(t, "variant")
+		s, err := EncodeBytes("cosmos", bz, v)
+		require.NoError(t, err)
+		hrp, decoded, decodedV, err := DecodeBytes(s)
+		require.NoError(t, err)
+		require.Equal(t, "cosmos", hrp)
+		require.Equal(t, v, decodedV)
+		require.Equal(t, len(bz), len(decoded))
+		if len(bz) > 0 {
+			require.Equal(t, bz, decoded)
+		}
+	})
+}
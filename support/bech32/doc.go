@@ -0,0 +1,11 @@
+/*
+Package bech32 implements the bech32 (BIP 173) and bech32m (BIP 350) encodings
+and the validation of the bech32 account addresses used by the
+cosmos.AddressString scalar.
+
+An AddressCodec converts between the string and bytes representations of
+addresses with a configurable set of human-readable prefixes, can be
+registered as a validator in a scalar.Registry and provides a
+rapidproto.FieldMapper generating valid addresses for annotated fields.
+*/
+package bech32
@@ -0,0 +1,61 @@
+package bech32
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"pgregory.net/rapid"
+
+	"github.com/cosmos/cosmos-proto/rapidproto"
+	"github.com/cosmos/cosmos-proto/scalar"
+)
+
+// Generator returns a generator of valid addresses using any of the accepted prefixes.
+// Addresses are 20 or 32 bytes long unless MaxLen is shorter.
+func (c AddressCodec) Generator() *rapid.Generator[string] {
+	return rapid.Custom(func(t *rapid.T) string {
+		hrp := rapid.SampledFrom(c.HRPs).Draw(t, "hrp")
+		n := rapid.SampledFrom([]int{20, 32}).Draw(t, "len")
+		if c.MaxLen > 0 && n > c.MaxLen {
+			n = c.MaxLen
+		}
+		bz := rapid.SliceOfN(rapid.Byte(), n, n).Draw(t, "bytes")
+		s, err := c.BytesToStringWithPrefix(hrp, bz)
+		if err != nil {
+			t.Fatalf("generating address: %v", err)
+		}
+		return s
+	})
+}
+
+// FieldMapper returns a rapidproto.FieldMapper generating valid addresses for the
+// string fields, list elements and map values annotated with the named scalar.
+func (c AddressCodec) FieldMapper(scalarName string) rapidproto.FieldMapper {
+	gen := c.Generator()
+	return func(t *rapid.T, fd protoreflect.FieldDescriptor, name string) (protoreflect.Value, bool) {
+		if fd.Kind() != protoreflect.StringKind || fieldScalar(fd) != scalarName {
+			return protoreflect.Value{}, false
+		}
+		return protoreflect.ValueOfString(gen.Draw(t, fmt.Sprintf("%s-address", name))), true
+	}
+}
+
+// fieldScalar returns the scalar annotation of fd. For map values it
+// is the annotation of the map field, which the entry fields do not carry.
+func fieldScalar(fd protoreflect.FieldDescriptor) string {
+	entry := fd.ContainingMessage()
+	if entry == nil || !entry.IsMapEntry() || fd.Number() != 2 {
+		return scalar.Name(fd)
+	}
+	parent, ok := entry.Parent().(protoreflect.MessageDescriptor)
+	if !ok {
+		return ""
+	}
+	fields := parent.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if f := fields.Get(i); f.IsMap() && f.Message().FullName() == entry.FullName() {
+			return scalar.Name(f)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+package bignum
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+const (
+	// Precision is the number of decimal places of a Dec.
+	Precision = 18
+	// MaxDecBitLen is the maximum bit length of the absolute value of a Dec
+	// scaled by 10^Precision, so that every Int converts to a Dec. The integer
+	// part of the largest Decs does not fit in an Int.
+	MaxDecBitLen = MaxBitLen + 60
+)
+
+var (
+	precisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(Precision), nil)
+	decRegexp           = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]*[1-9])?$`)
+)
+
+// Dec is a fixed-point decimal number with Precision decimal places.
+// The zero value is a nil Dec, which represents an unset field and
+// is treated as zero by arithmetic operations.
+type Dec struct {
+	// i is the value of the decimal multiplied by 10^Precision
+	i *big.Int
+}
+
+// NewDec returns a new Dec from the integer x.
+func NewDec(x int64) Dec {
+	return NewDecWithPrec(x, 0)
+}
+
+// NewDecWithPrec returns a new Dec with value x * 10^-prec, e.g. NewDecWithPrec(15, 1) is 1.5.
+// It panics if prec is negative or greater than Precision.
+func NewDecWithPrec(x int64, prec int) Dec {
+	if prec < 0 || prec > Precision {
+		panic(fmt.Sprintf("bignum: invalid precision %d", prec))
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Precision-prec)), nil)
+	return Dec{i: new(big.Int).Mul(big.NewInt(x), scale)}
+}
+
+// NewDecFromInt returns a new Dec with the value of i, or ErrOverflow.
+func NewDecFromInt(i Int) (Dec, error) {
+	return newDec(new(big.Int).Mul(i.bigInt(), precisionMultiplier))
+}
+
+func newDec(x *big.Int) (Dec, error) {
+	if x.BitLen() > MaxDecBitLen {
+		return Dec{}, ErrOverflow
+	}
+	return Dec{i: x}, nil
+}
+
+// ParseDec parses the canonical encoding of a Dec. The integer part has no leading
+// zeros and the optional fractional part, of at most Precision digits, has no trailing
+// zeros, e.g. "1", "-0.5" or "12.125". Zero is encoded as "0", never as "-0".
+func ParseDec(s string) (Dec, error) {
+	if !decRegexp.MatchString(s) || s == "-0" {
+		return Dec{}, fmt.Errorf("bignum: invalid decimal %q", s)
+	}
+	intPart, fracPart := s, ""
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		intPart, fracPart = s[:dot], s[dot+1:]
+	}
+	if len(fracPart) > Precision {
+		return Dec{}, fmt.Errorf("bignum: decimal %q has more than %d decimal places", s, Precision)
+	}
+	x, _ := new(big.Int).SetString(intPart+fracPart+strings.Repeat("0", Precision-len(fracPart)), 10)
+	return newDec(x)
+}
+
+func (d Dec) bigInt() *big.Int {
+	if d.i == nil {
+		return new(big.Int)
+	}
+	return d.i
+}
+
+// IsNil reports whether d is the zero value of Dec.
+func (d Dec) IsNil() bool {
+	return d.i == nil
+}
+
+// String returns the canonical encoding of d.
+func (d Dec) String() string {
+	abs := new(big.Int).Abs(d.bigInt())
+	intPart, fracPart := new(big.Int).QuoRem(abs, precisionMultiplier, new(big.Int))
+
+	var sb strings.Builder
+	if d.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(intPart.String())
+	if fracPart.Sign() != 0 {
+		frac := fracPart.String()
+		frac = strings.Repeat("0", Precision-len(frac)) + frac
+		sb.WriteByte('.')
+		sb.WriteString(strings.TrimRight(frac, "0"))
+	}
+	return sb.String()
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Dec) Sign() int {
+	return d.bigInt().Sign()
+}
+
+// IsZero reports whether d is zero.
+func (d Dec) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and e and returns -1 when d < e, 0 when d == e and +1 otherwise.
+func (d Dec) Cmp(e Dec) int {
+	return d.bigInt().Cmp(e.bigInt())
+}
+
+// Equal reports whether d and e have the same value.
+func (d Dec) Equal(e Dec) bool {
+	return d.Cmp(e) == 0
+}
+
+// Neg returns -d.
+func (d Dec) Neg() Dec {
+	return Dec{i: new(big.Int).Neg(d.bigInt())}
+}
+
+// TruncateInt returns the integer part of d, or ErrOverflow if it does not fit
+// in MaxBitLen bits.
+func (d Dec) TruncateInt() (Int, error) {
+	return newInt(new(big.Int).Quo(d.bigInt(), precisionMultiplier))
+}
+
+// Add returns d + e, or ErrOverflow.
+func (d Dec) Add(e Dec) (Dec, error) {
+	return newDec(new(big.Int).Add(d.bigInt(), e.bigInt()))
+}
+
+// Sub returns d - e, or ErrOverflow.
+func (d Dec) Sub(e Dec) (Dec, error) {
+	return newDec(new(big.Int).Sub(d.bigInt(), e.bigInt()))
+}
+
+// Mul returns d * e truncated towards zero to Precision decimal places, or ErrOverflow.
+func (d Dec) Mul(e Dec) (Dec, error) {
+	x := new(big.Int).Mul(d.bigInt(), e.bigInt())
+	return newDec(x.Quo(x, precisionMultiplier))
+}
+
+// Quo returns d / e truncated towards zero to Precision decimal places,
+// ErrDivisionByZero or ErrOverflow.
+func (d Dec) Quo(e Dec) (Dec, error) {
+	if e.IsZero() {
+		return Dec{}, ErrDivisionByZero
+	}
+	x := new(big.Int).Mul(d.bigInt(), precisionMultiplier)
+	return newDec(x.Quo(x, e.bigInt()))
+}
+
+// Marshal implements runtime.CustomType. A nil Dec is encoded as an empty value.
+func (d Dec) Marshal() ([]byte, error) {
+	if d.IsNil() {
+		return nil, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// MarshalTo implements runtime.CustomType.
+func (d Dec) MarshalTo(data []byte) (int, error) {
+	if d.IsNil() {
+		return 0, nil
+	}
+	return copy(data, d.String()), nil
+}
+
+// Unmarshal implements runtime.CustomType. An empty value decodes to a nil Dec.
+func (d *Dec) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*d = Dec{}
+		return nil
+	}
+	v, err := ParseDec(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Size implements runtime.CustomType.
+func (d Dec) Size() int {
+	if d.IsNil() {
+		return 0
+	}
+	return len(d.String())
+}
@@ -0,0 +1,114 @@
+package bignum
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestParseDec(t *testing.T) {
+	valid := []string{"0", "1", "-1", "0.5", "-0.5", "12.125", "0.000000000000000001", "123456789.987654321"}
+	for _, s := range valid {
+		d, err := ParseDec(s)
+		require.NoError(t, err, s)
+		require.Equal(t, s, d.String())
+	}
+
+	invalid := []string{
+		"", "-", "-0", "+1", "01", "1.", ".5", "1.50", "0.0", "-0.0", "1e3", "1,5",
+		"0.0000000000000000001", // more than Precision decimal places
+	}
+	for _, s := range invalid {
+		_, err := ParseDec(s)
+		require.Error(t, err, s)
+	}
+
+	_, err := ParseDec("1" + strings.Repeat("0", 76))
+	require.NoError(t, err)
+	_, err = ParseDec("1" + strings.Repeat("0", 80))
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestDecArithmetic(t *testing.T) {
+	require.Equal(t, "1.5", NewDecWithPrec(15, 1).String())
+	require.Equal(t, "-3", NewDec(-3).String())
+	require.Panics(t, func() { NewDecWithPrec(1, Precision+1) })
+
+	sum, err := NewDecWithPrec(1, 1).Add(NewDecWithPrec(2, 1))
+	require.NoError(t, err)
+	require.Equal(t, "0.3", sum.String())
+
+	diff, err := NewDec(1).Sub(NewDecWithPrec(15, 1))
+	require.NoError(t, err)
+	require.Equal(t, "-0.5", diff.String())
+
+	prod, err := NewDecWithPrec(15, 1).Mul(NewDecWithPrec(-3, 0))
+	require.NoError(t, err)
+	require.Equal(t, "-4.5", prod.String())
+
+	// results are truncated towards zero
+	prod, err = NewDecWithPrec(1, Precision).Mul(NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	require.True(t, prod.IsZero())
+	quo, err := NewDec(-2).Quo(NewDec(3))
+	require.NoError(t, err)
+	require.Equal(t, "-0.666666666666666666", quo.String())
+
+	_, err = NewDec(1).Quo(Dec{})
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	big, err := ParseDec("1" + strings.Repeat("0", 60))
+	require.NoError(t, err)
+	_, err = big.Mul(big)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	d, err := ParseDec("-12.75")
+	require.NoError(t, err)
+	truncated, err := d.TruncateInt()
+	require.NoError(t, err)
+	require.Equal(t, "-12", truncated.String())
+	fromInt, err := NewDecFromInt(NewInt(7))
+	require.NoError(t, err)
+	require.True(t, fromInt.Equal(NewDec(7)))
+}
+
+func TestDecTruncateIntBounds(t *testing.T) {
+	// the largest Int converts to a Dec and back
+	maxInt, err := NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxBitLen), big.NewInt(1)))
+	require.NoError(t, err)
+	d, err := NewDecFromInt(maxInt)
+	require.NoError(t, err)
+	truncated, err := d.TruncateInt()
+	require.NoError(t, err)
+	require.True(t, truncated.Equal(maxInt))
+
+	// the integer part of the largest Dec does not fit in an Int
+	maxDec, err := newDec(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxDecBitLen), big.NewInt(1)))
+	require.NoError(t, err)
+	_, err = maxDec.TruncateInt()
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = maxDec.Neg().TruncateInt()
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestDecStringRoundTrip(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := rapid.StringMatching(`-?(0|[1-9][0-9]{0,40})(\.[0-9]{0,17}[1-9])?`).Draw(t, "s")
+		if s == "-0" {
+			return
+		}
+		d, err := ParseDec(s)
+		require.NoError(t, err)
+		require.Equal(t, s, d.String())
+
+		var decoded Dec
+		bz, err := d.Marshal()
+		require.NoError(t, err)
+		require.Len(t, bz, d.Size())
+		require.NoError(t, decoded.Unmarshal(bz))
+		require.True(t, decoded.Equal(d))
+	})
+}
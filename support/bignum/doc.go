@@ -0,0 +1,10 @@
+/*
+Package bignum provides the arbitrary-precision Int and fixed-point Dec types
+backing the cosmos.Int and cosmos.Dec scalars.
+
+Both types have a single canonical string encoding which is the only one
+accepted when parsing, as required by ScalarDescriptor. They implement
+runtime.CustomType, so they can be used as the Go types of scalar-annotated
+fields, and RegisterScalars registers their validators in a scalar.Registry.
+*/
+package bignum
@@ -0,0 +1,169 @@
+package bignum
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+)
+
+// MaxBitLen is the maximum bit length of the absolute value of an Int.
+const MaxBitLen = 256
+
+var (
+	// ErrOverflow is returned when the result of an operation does not fit in MaxBitLen bits.
+	ErrOverflow = errors.New("bignum: overflow")
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("bignum: division by zero")
+)
+
+var intRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)$`)
+
+// Int is an arbitrary-precision integer whose absolute value fits in MaxBitLen bits.
+// The zero value is a nil Int, which represents an unset field and
+// is treated as zero by arithmetic operations.
+type Int struct {
+	i *big.Int
+}
+
+// NewInt returns a new Int from x.
+func NewInt(x int64) Int {
+	return Int{i: big.NewInt(x)}
+}
+
+// NewIntFromBigInt returns a new Int from a copy of x,
+// or ErrOverflow if it does not fit in MaxBitLen bits.
+func NewIntFromBigInt(x *big.Int) (Int, error) {
+	if x == nil {
+		return Int{}, nil
+	}
+	return newInt(new(big.Int).Set(x))
+}
+
+func newInt(x *big.Int) (Int, error) {
+	if x.BitLen() > MaxBitLen {
+		return Int{}, ErrOverflow
+	}
+	return Int{i: x}, nil
+}
+
+// ParseInt parses the canonical encoding of an Int, which is its base 10 representation
+// without leading zeros or a plus sign. Zero is encoded as "0", never as "-0".
+func ParseInt(s string) (Int, error) {
+	if !intRegexp.MatchString(s) || s == "-0" {
+		return Int{}, fmt.Errorf("bignum: invalid integer %q", s)
+	}
+	x, _ := new(big.Int).SetString(s, 10)
+	return newInt(x)
+}
+
+func (i Int) bigInt() *big.Int {
+	if i.i == nil {
+		return new(big.Int)
+	}
+	return i.i
+}
+
+// IsNil reports whether i is the zero value of Int.
+func (i Int) IsNil() bool {
+	return i.i == nil
+}
+
+// BigInt returns a copy of the value of i.
+func (i Int) BigInt() *big.Int {
+	return new(big.Int).Set(i.bigInt())
+}
+
+// String returns the canonical encoding of i.
+func (i Int) String() string {
+	return i.bigInt().String()
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of i.
+func (i Int) Sign() int {
+	return i.bigInt().Sign()
+}
+
+// IsZero reports whether i is zero.
+func (i Int) IsZero() bool {
+	return i.Sign() == 0
+}
+
+// Cmp compares i and j and returns -1 when i < j, 0 when i == j and +1 otherwise.
+func (i Int) Cmp(j Int) int {
+	return i.bigInt().Cmp(j.bigInt())
+}
+
+// Equal reports whether i and j have the same value.
+func (i Int) Equal(j Int) bool {
+	return i.Cmp(j) == 0
+}
+
+// Neg returns -i.
+func (i Int) Neg() Int {
+	return Int{i: new(big.Int).Neg(i.bigInt())}
+}
+
+// Add returns i + j, or ErrOverflow.
+func (i Int) Add(j Int) (Int, error) {
+	return newInt(new(big.Int).Add(i.bigInt(), j.bigInt()))
+}
+
+// Sub returns i - j, or ErrOverflow.
+func (i Int) Sub(j Int) (Int, error) {
+	return newInt(new(big.Int).Sub(i.bigInt(), j.bigInt()))
+}
+
+// Mul returns i * j, or ErrOverflow.
+func (i Int) Mul(j Int) (Int, error) {
+	if i.bigInt().BitLen()+j.bigInt().BitLen()-1 > MaxBitLen {
+		return Int{}, ErrOverflow
+	}
+	return newInt(new(big.Int).Mul(i.bigInt(), j.bigInt()))
+}
+
+// Quo returns i / j truncated towards zero, or ErrDivisionByZero.
+func (i Int) Quo(j Int) (Int, error) {
+	if j.IsZero() {
+		return Int{}, ErrDivisionByZero
+	}
+	return newInt(new(big.Int).Quo(i.bigInt(), j.bigInt()))
+}
+
+// Marshal implements runtime.CustomType. A nil Int is encoded as an empty value.
+func (i Int) Marshal() ([]byte, error) {
+	if i.IsNil() {
+		return nil, nil
+	}
+	return []byte(i.String()), nil
+}
+
+// MarshalTo implements runtime.CustomType.
+func (i Int) MarshalTo(data []byte) (int, error) {
+	if i.IsNil() {
+		return 0, nil
+	}
+	return copy(data, i.String()), nil
+}
+
+// Unmarshal implements runtime.CustomType. An empty value decodes to a nil Int.
+func (i *Int) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*i = Int{}
+		return nil
+	}
+	v, err := ParseInt(string(data))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// Size implements runtime.CustomType.
+func (i Int) Size() int {
+	if i.IsNil() {
+		return 0
+	}
+	return len(i.String())
+}
@@ -0,0 +1,117 @@
+package bignum
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestParseInt(t *testing.T) {
+	valid := []string{"0", "1", "-1", "1234567890", "-987654321"}
+	for _, s := range valid {
+		i, err := ParseInt(s)
+		require.NoError(t, err, s)
+		require.Equal(t, s, i.String())
+	}
+
+	invalid := []string{"", "-", "-0", "+1", "01", "00", "1.0", "1e3", " 1", "0x10", "１"}
+	for _, s := range invalid {
+		_, err := ParseInt(s)
+		require.Error(t, err, s)
+	}
+
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxBitLen), big.NewInt(1))
+	_, err := ParseInt(max.String())
+	require.NoError(t, err)
+	_, err = ParseInt(new(big.Int).Neg(max).String())
+	require.NoError(t, err)
+	_, err = ParseInt(new(big.Int).Add(max, big.NewInt(1)).String())
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestIntArithmetic(t *testing.T) {
+	max, err := NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxBitLen), big.NewInt(1)))
+	require.NoError(t, err)
+
+	sum, err := NewInt(2).Add(NewInt(3))
+	require.NoError(t, err)
+	require.Equal(t, "5", sum.String())
+	_, err = max.Add(NewInt(1))
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = max.Neg().Sub(NewInt(1))
+	require.ErrorIs(t, err, ErrOverflow)
+
+	prod, err := NewInt(-4).Mul(NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, "-20", prod.String())
+	_, err = max.Mul(NewInt(2))
+	require.ErrorIs(t, err, ErrOverflow)
+	prod, err = max.Mul(NewInt(1))
+	require.NoError(t, err)
+	require.True(t, prod.Equal(max))
+
+	quo, err := NewInt(-7).Quo(NewInt(2))
+	require.NoError(t, err)
+	require.Equal(t, "-3", quo.String())
+	_, err = NewInt(1).Quo(Int{})
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	// a nil Int is zero
+	sum, err = Int{}.Add(NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, "1", sum.String())
+	require.True(t, Int{}.IsZero())
+	require.True(t, Int{}.Equal(NewInt(0)))
+}
+
+func TestIntMulProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		a, _ := new(big.Int).SetString(rapid.StringMatching(`-?[1-9][0-9]{0,80}`).Draw(t, "a"), 10)
+		b := rapid.Int64().Draw(t, "b")
+		x, err := NewIntFromBigInt(a)
+		if a.BitLen() > MaxBitLen {
+			require.ErrorIs(t, err, ErrOverflow)
+			return
+		}
+		require.NoError(t, err)
+
+		prod, err := x.Mul(NewInt(b))
+		expected := new(big.Int).Mul(a, big.NewInt(b))
+		if expected.BitLen() > MaxBitLen {
+			require.ErrorIs(t, err, ErrOverflow)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), prod.String())
+
+		parsed, err := ParseInt(prod.String())
+		require.NoError(t, err)
+		require.True(t, parsed.Equal(prod))
+	})
+}
+
+func TestIntCustomType(t *testing.T) {
+	var i Int
+	require.Equal(t, 0, i.Size())
+	bz, err := i.Marshal()
+	require.NoError(t, err)
+	require.Empty(t, bz)
+
+	i = NewInt(-42)
+	bz, err = i.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, "-42", string(bz))
+	buf := make([]byte, i.Size())
+	n, err := i.MarshalTo(buf)
+	require.NoError(t, err)
+	require.Equal(t, bz, buf[:n])
+
+	var decoded Int
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.True(t, decoded.Equal(i))
+	require.NoError(t, decoded.Unmarshal(nil))
+	require.True(t, decoded.IsNil())
+	require.Error(t, decoded.Unmarshal([]byte("042")))
+}
@@ -0,0 +1,53 @@
+package bignum
+
+import (
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/scalar"
+)
+
+const (
+	// IntScalarName is the fully-qualified name of the scalar represented by Int.
+	IntScalarName = "cosmos.Int"
+	// DecScalarName is the fully-qualified name of the scalar represented by Dec.
+	DecScalarName = "cosmos.Dec"
+)
+
+// IntScalarDescriptor returns the declaration of the Int scalar, to be declared
+// with declare_scalar in cosmos/scalars.proto.
+func IntScalarDescriptor() *cosmos_proto.ScalarDescriptor {
+	return &cosmos_proto.ScalarDescriptor{
+		Name:        "Int",
+		Description: "Int is an integer of at most 256 bits encoded in base 10 without leading zeros or plus sign, zero being encoded as 0.",
+		FieldType:   []cosmos_proto.ScalarType{cosmos_proto.ScalarType_SCALAR_TYPE_STRING},
+	}
+}
+
+// DecScalarDescriptor returns the declaration of the Dec scalar, to be declared
+// with declare_scalar in cosmos/scalars.proto.
+func DecScalarDescriptor() *cosmos_proto.ScalarDescriptor {
+	return &cosmos_proto.ScalarDescriptor{
+		Name:        "Dec",
+		Description: "Dec is a decimal number with 18 decimal places encoded in base 10 without leading zeros in its integer part, trailing zeros in its fractional part or plus sign, zero being encoded as 0.",
+		FieldType:   []cosmos_proto.ScalarType{cosmos_proto.ScalarType_SCALAR_TYPE_STRING},
+	}
+}
+
+// RegisterScalars registers the validators of the Int and Dec scalars in r.
+func RegisterScalars(r *scalar.Registry) error {
+	if err := r.RegisterString(IntScalarName, func(value string) (string, error) {
+		i, err := ParseInt(value)
+		if err != nil {
+			return "", err
+		}
+		return i.String(), nil
+	}); err != nil {
+		return err
+	}
+	return r.RegisterString(DecScalarName, func(value string) (string, error) {
+		d, err := ParseDec(value)
+		if err != nil {
+			return "", err
+		}
+		return d.String(), nil
+	})
+}
@@ -0,0 +1,34 @@
+package bignum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-proto/scalar"
+)
+
+func TestRegisterScalars(t *testing.T) {
+	r := scalar.NewRegistry()
+	require.NoError(t, RegisterScalars(r))
+	require.Error(t, RegisterScalars(r))
+
+	validateInt, ok := r.FindString(IntScalarName)
+	require.True(t, ok)
+	canonical, err := validateInt("-10")
+	require.NoError(t, err)
+	require.Equal(t, "-10", canonical)
+	_, err = validateInt("010")
+	require.Error(t, err)
+
+	validateDec, ok := r.FindString(DecScalarName)
+	require.True(t, ok)
+	canonical, err = validateDec("1.5")
+	require.NoError(t, err)
+	require.Equal(t, "1.5", canonical)
+	_, err = validateDec("1.50")
+	require.Error(t, err)
+
+	require.Equal(t, IntScalarName, "cosmos."+IntScalarDescriptor().Name)
+	require.Equal(t, DecScalarName, "cosmos."+DecScalarDescriptor().Name)
+}
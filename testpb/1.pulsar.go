@@ -5,6 +5,7 @@ import (
 	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	fieldmask "github.com/cosmos/cosmos-proto/runtime/fieldmask"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -15,193 +16,6 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.Map = (*_A_18_map)(nil)
-
-type _A_18_map struct {
-	m *map[string]*B
-}
-
-func (x *_A_18_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_A_18_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_A_18_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_A_18_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_A_18_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_A_18_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*B)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_A_18_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if ok {
-		return protoreflect.ValueOfMessage(v.ProtoReflect())
-	}
-	newValue := new(B)
-	(*x.m)[concreteKey] = newValue
-	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
-}
-
-func (x *_A_18_map) NewValue() protoreflect.Value {
-	v := new(B)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_A_18_map) IsValid() bool {
-	return x.m != nil
-}
-
-var _ protoreflect.List = (*_A_19_list)(nil)
-
-type _A_19_list struct {
-	list *[]*B
-}
-
-func (x *_A_19_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_A_19_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_A_19_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*B)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_A_19_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*B)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_A_19_list) AppendMutable() protoreflect.Value {
-	v := new(B)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_A_19_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_A_19_list) NewElement() protoreflect.Value {
-	v := new(B)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_A_19_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_A_22_list)(nil)
-
-type _A_22_list struct {
-	list *[]Enumeration
-}
-
-func (x *_A_22_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_A_22_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
-}
-
-func (x *_A_22_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (Enumeration)(valueUnwrapped)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_A_22_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Enum()
-	concreteValue := (Enumeration)(valueUnwrapped)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_A_22_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message A at list field LIST_ENUM as it is not of Message kind"))
-}
-
-func (x *_A_22_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_A_22_list) NewElement() protoreflect.Value {
-	v := 0
-	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
-}
-
-func (x *_A_22_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
 	md_A              protoreflect.MessageDescriptor
 	fd_A_enum         protoreflect.FieldDescriptor
@@ -427,13 +241,13 @@ func (x *fastReflection_A) Range(f func(protoreflect.FieldDescriptor, protorefle
 		}
 	}
 	if len(x.MAP) != 0 {
-		value := protoreflect.ValueOfMap(&_A_18_map{m: &x.MAP})
+		value := protoreflect.ValueOfMap(runtime.NewMap[string, *B, runtime.StringValue, runtime.MessageValue[B, *B]](&x.MAP))
 		if !f(fd_A_MAP, value) {
 			return
 		}
 	}
 	if len(x.LIST) != 0 {
-		value := protoreflect.ValueOfList(&_A_19_list{list: &x.LIST})
+		value := protoreflect.ValueOfList(runtime.NewList[*B, runtime.MessageValue[B, *B]](&x.LIST))
 		if !f(fd_A_LIST, value) {
 			return
 		}
@@ -455,7 +269,7 @@ func (x *fastReflection_A) Range(f func(protoreflect.FieldDescriptor, protorefle
 		}
 	}
 	if len(x.LIST_ENUM) != 0 {
-		value := protoreflect.ValueOfList(&_A_22_list{list: &x.LIST_ENUM})
+		value := protoreflect.ValueOfList(runtime.NewList[Enumeration, runtime.EnumValue[Enumeration]](&x.LIST_ENUM))
 		if !f(fd_A_LIST_ENUM, value) {
 			return
 		}
@@ -680,15 +494,15 @@ func (x *fastReflection_A) Get(descriptor protoreflect.FieldDescriptor) protoref
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	case "A.MAP":
 		if len(x.MAP) == 0 {
-			return protoreflect.ValueOfMap(&_A_18_map{})
+			return protoreflect.ValueOfMap(runtime.NewMap[string, *B, runtime.StringValue, runtime.MessageValue[B, *B]](nil))
 		}
-		mapValue := &_A_18_map{m: &x.MAP}
+		mapValue := runtime.NewMap[string, *B, runtime.StringValue, runtime.MessageValue[B, *B]](&x.MAP)
 		return protoreflect.ValueOfMap(mapValue)
 	case "A.LIST":
 		if len(x.LIST) == 0 {
-			return protoreflect.ValueOfList(&_A_19_list{})
+			return protoreflect.ValueOfList(runtime.NewList[*B, runtime.MessageValue[B, *B]](nil))
 		}
-		listValue := &_A_19_list{list: &x.LIST}
+		listValue := runtime.NewList[*B, runtime.MessageValue[B, *B]](&x.LIST)
 		return protoreflect.ValueOfList(listValue)
 	case "A.ONEOF_B":
 		if x.ONEOF == nil {
@@ -708,9 +522,9 @@ func (x *fastReflection_A) Get(descriptor protoreflect.FieldDescriptor) protoref
 		}
 	case "A.LIST_ENUM":
 		if len(x.LIST_ENUM) == 0 {
-			return protoreflect.ValueOfList(&_A_22_list{})
+			return protoreflect.ValueOfList(runtime.NewList[Enumeration, runtime.EnumValue[Enumeration]](nil))
 		}
-		listValue := &_A_22_list{list: &x.LIST_ENUM}
+		listValue := runtime.NewList[Enumeration, runtime.EnumValue[Enumeration]](&x.LIST_ENUM)
 		return protoreflect.ValueOfList(listValue)
 	case "A.imported":
 		value := x.Imported
@@ -774,12 +588,12 @@ func (x *fastReflection_A) Set(fd protoreflect.FieldDescriptor, value protorefle
 		x.MESSAGE = value.Message().Interface().(*B)
 	case "A.MAP":
 		mv := value.Map()
-		cmv := mv.(*_A_18_map)
-		x.MAP = *cmv.m
+		cmv := mv.(*runtime.Map[string, *B, runtime.StringValue, runtime.MessageValue[B, *B]])
+		x.MAP = cmv.GoMap()
 	case "A.LIST":
 		lv := value.List()
-		clv := lv.(*_A_19_list)
-		x.LIST = *clv.list
+		clv := lv.(*runtime.List[*B, runtime.MessageValue[B, *B]])
+		x.LIST = clv.Slice()
 	case "A.ONEOF_B":
 		cv := value.Message().Interface().(*B)
 		x.ONEOF = &A_ONEOF_B{ONEOF_B: cv}
@@ -788,8 +602,8 @@ func (x *fastReflection_A) Set(fd protoreflect.FieldDescriptor, value protorefle
 		x.ONEOF = &A_ONEOF_STRING{ONEOF_STRING: cv}
 	case "A.LIST_ENUM":
 		lv := value.List()
-		clv := lv.(*_A_22_list)
-		x.LIST_ENUM = *clv.list
+		clv := lv.(*runtime.List[Enumeration, runtime.EnumValue[Enumeration]])
+		x.LIST_ENUM = clv.Slice()
 	case "A.imported":
 		x.Imported = value.Message().Interface().(*ImportedMessage)
 	case "A.type":
@@ -823,13 +637,13 @@ func (x *fastReflection_A) Mutable(fd protoreflect.FieldDescriptor) protoreflect
 		if x.MAP == nil {
 			x.MAP = make(map[string]*B)
 		}
-		value := &_A_18_map{m: &x.MAP}
+		value := runtime.NewMap[string, *B, runtime.StringValue, runtime.MessageValue[B, *B]](&x.MAP)
 		return protoreflect.ValueOfMap(value)
 	case "A.LIST":
 		if x.LIST == nil {
 			x.LIST = []*B{}
 		}
-		value := &_A_19_list{list: &x.LIST}
+		value := runtime.NewList[*B, runtime.MessageValue[B, *B]](&x.LIST)
 		return protoreflect.ValueOfList(value)
 	case "A.ONEOF_B":
 		if x.ONEOF == nil {
@@ -851,7 +665,7 @@ func (x *fastReflection_A) Mutable(fd protoreflect.FieldDescriptor) protoreflect
 		if x.LIST_ENUM == nil {
 			x.LIST_ENUM = []Enumeration{}
 		}
-		value := &_A_22_list{list: &x.LIST_ENUM}
+		value := runtime.NewList[Enumeration, runtime.EnumValue[Enumeration]](&x.LIST_ENUM)
 		return protoreflect.ValueOfList(value)
 	case "A.imported":
 		if x.Imported == nil {
@@ -944,10 +758,10 @@ func (x *fastReflection_A) NewField(fd protoreflect.FieldDescriptor) protoreflec
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "A.MAP":
 		m := make(map[string]*B)
-		return protoreflect.ValueOfMap(&_A_18_map{m: &m})
+		return protoreflect.ValueOfMap(runtime.NewMap[string, *B, runtime.StringValue, runtime.MessageValue[B, *B]](&m))
 	case "A.LIST":
 		list := []*B{}
-		return protoreflect.ValueOfList(&_A_19_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[*B, runtime.MessageValue[B, *B]](&list))
 	case "A.ONEOF_B":
 		value := &B{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
@@ -955,7 +769,7 @@ func (x *fastReflection_A) NewField(fd protoreflect.FieldDescriptor) protoreflec
 		return protoreflect.ValueOfString("")
 	case "A.LIST_ENUM":
 		list := []Enumeration{}
-		return protoreflect.ValueOfList(&_A_22_list{list: &list})
+		return protoreflect.ValueOfList(runtime.NewList[Enumeration, runtime.EnumValue[Enumeration]](&list))
 	case "A.imported":
 		m := new(ImportedMessage)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
@@ -980,9 +794,9 @@ func (x *fastReflection_A) WhichOneof(d protoreflect.OneofDescriptor) protorefle
 		}
 		switch x.ONEOF.(type) {
 		case *A_ONEOF_B:
-			return x.Descriptor().Fields().ByName("ONEOF_B")
+			return md_A.Fields().ByName("ONEOF_B")
 		case *A_ONEOF_STRING:
-			return x.Descriptor().Fields().ByName("ONEOF_STRING")
+			return md_A.Fields().ByName("ONEOF_STRING")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in A", d.FullName()))
@@ -1181,7 +995,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -1446,7 +1266,9 @@ func init() {
 			i--
 			dAtA[i] = 0x8
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -2515,7 +2337,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -2531,7 +2359,9 @@ func init() {
 			i--
 			dAtA[i] = 0xa
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -2650,6 +2480,157 @@ func init() {
 	}
 }
 
+// Field mask paths of A.
+const (
+	A_Enum_path         fieldmask.Path[*A]                          = "enum"
+	A_SomeBoolean_path  fieldmask.Path[*A]                          = "some_boolean"
+	A_INT32_path        fieldmask.Path[*A]                          = "INT32"
+	A_SINT32_path       fieldmask.Path[*A]                          = "SINT32"
+	A_UINT32_path       fieldmask.Path[*A]                          = "UINT32"
+	A_INT64_path        fieldmask.Path[*A]                          = "INT64"
+	A_SING64_path       fieldmask.Path[*A]                          = "SING64"
+	A_UINT64_path       fieldmask.Path[*A]                          = "UINT64"
+	A_SFIXED32_path     fieldmask.Path[*A]                          = "SFIXED32"
+	A_FIXED32_path      fieldmask.Path[*A]                          = "FIXED32"
+	A_FLOAT_path        fieldmask.Path[*A]                          = "FLOAT"
+	A_SFIXED64_path     fieldmask.Path[*A]                          = "SFIXED64"
+	A_FIXED64_path      fieldmask.Path[*A]                          = "FIXED64"
+	A_DOUBLE_path       fieldmask.Path[*A]                          = "DOUBLE"
+	A_STRING_path       fieldmask.Path[*A]                          = "STRING"
+	A_BYTES_path        fieldmask.Path[*A]                          = "BYTES"
+	A_MESSAGE_path      fieldmask.MessagePath[*A, *B]               = "MESSAGE"
+	A_MAP_path          fieldmask.Path[*A]                          = "MAP"
+	A_LIST_path         fieldmask.Path[*A]                          = "LIST"
+	A_ONEOF_B_path      fieldmask.MessagePath[*A, *B]               = "ONEOF_B"
+	A_ONEOF_STRING_path fieldmask.Path[*A]                          = "ONEOF_STRING"
+	A_LIST_ENUM_path    fieldmask.Path[*A]                          = "LIST_ENUM"
+	A_Imported_path     fieldmask.MessagePath[*A, *ImportedMessage] = "imported"
+	A_Type__path        fieldmask.Path[*A]                          = "type"
+)
+
+// Field mask paths of B.
+const (
+	B_X_path fieldmask.Path[*B] = "x"
+)
+
+// Full and short names for Enumeration.
+const (
+	Enumeration_enum_fullname = "Enumeration"
+	Enumeration_enum_name     = "Enumeration"
+)
+
+// Names for A.
+const (
+	A_message_name     protoreflect.Name     = "A"
+	A_message_fullname protoreflect.FullName = "A"
+)
+
+// Field names for A.
+const (
+	A_Enum_field_name         protoreflect.Name = "enum"
+	A_SomeBoolean_field_name  protoreflect.Name = "some_boolean"
+	A_INT32_field_name        protoreflect.Name = "INT32"
+	A_SINT32_field_name       protoreflect.Name = "SINT32"
+	A_UINT32_field_name       protoreflect.Name = "UINT32"
+	A_INT64_field_name        protoreflect.Name = "INT64"
+	A_SING64_field_name       protoreflect.Name = "SING64"
+	A_UINT64_field_name       protoreflect.Name = "UINT64"
+	A_SFIXED32_field_name     protoreflect.Name = "SFIXED32"
+	A_FIXED32_field_name      protoreflect.Name = "FIXED32"
+	A_FLOAT_field_name        protoreflect.Name = "FLOAT"
+	A_SFIXED64_field_name     protoreflect.Name = "SFIXED64"
+	A_FIXED64_field_name      protoreflect.Name = "FIXED64"
+	A_DOUBLE_field_name       protoreflect.Name = "DOUBLE"
+	A_STRING_field_name       protoreflect.Name = "STRING"
+	A_BYTES_field_name        protoreflect.Name = "BYTES"
+	A_MESSAGE_field_name      protoreflect.Name = "MESSAGE"
+	A_MAP_field_name          protoreflect.Name = "MAP"
+	A_LIST_field_name         protoreflect.Name = "LIST"
+	A_ONEOF_B_field_name      protoreflect.Name = "ONEOF_B"
+	A_ONEOF_STRING_field_name protoreflect.Name = "ONEOF_STRING"
+	A_LIST_ENUM_field_name    protoreflect.Name = "LIST_ENUM"
+	A_Imported_field_name     protoreflect.Name = "imported"
+	A_Type__field_name        protoreflect.Name = "type"
+
+	A_Enum_field_fullname         protoreflect.FullName = "A.enum"
+	A_SomeBoolean_field_fullname  protoreflect.FullName = "A.some_boolean"
+	A_INT32_field_fullname        protoreflect.FullName = "A.INT32"
+	A_SINT32_field_fullname       protoreflect.FullName = "A.SINT32"
+	A_UINT32_field_fullname       protoreflect.FullName = "A.UINT32"
+	A_INT64_field_fullname        protoreflect.FullName = "A.INT64"
+	A_SING64_field_fullname       protoreflect.FullName = "A.SING64"
+	A_UINT64_field_fullname       protoreflect.FullName = "A.UINT64"
+	A_SFIXED32_field_fullname     protoreflect.FullName = "A.SFIXED32"
+	A_FIXED32_field_fullname      protoreflect.FullName = "A.FIXED32"
+	A_FLOAT_field_fullname        protoreflect.FullName = "A.FLOAT"
+	A_SFIXED64_field_fullname     protoreflect.FullName = "A.SFIXED64"
+	A_FIXED64_field_fullname      protoreflect.FullName = "A.FIXED64"
+	A_DOUBLE_field_fullname       protoreflect.FullName = "A.DOUBLE"
+	A_STRING_field_fullname       protoreflect.FullName = "A.STRING"
+	A_BYTES_field_fullname        protoreflect.FullName = "A.BYTES"
+	A_MESSAGE_field_fullname      protoreflect.FullName = "A.MESSAGE"
+	A_MAP_field_fullname          protoreflect.FullName = "A.MAP"
+	A_LIST_field_fullname         protoreflect.FullName = "A.LIST"
+	A_ONEOF_B_field_fullname      protoreflect.FullName = "A.ONEOF_B"
+	A_ONEOF_STRING_field_fullname protoreflect.FullName = "A.ONEOF_STRING"
+	A_LIST_ENUM_field_fullname    protoreflect.FullName = "A.LIST_ENUM"
+	A_Imported_field_fullname     protoreflect.FullName = "A.imported"
+	A_Type__field_fullname        protoreflect.FullName = "A.type"
+)
+
+// Field numbers for A.
+const (
+	A_Enum_field_number         protoreflect.FieldNumber = 1
+	A_SomeBoolean_field_number  protoreflect.FieldNumber = 2
+	A_INT32_field_number        protoreflect.FieldNumber = 3
+	A_SINT32_field_number       protoreflect.FieldNumber = 4
+	A_UINT32_field_number       protoreflect.FieldNumber = 5
+	A_INT64_field_number        protoreflect.FieldNumber = 6
+	A_SING64_field_number       protoreflect.FieldNumber = 7
+	A_UINT64_field_number       protoreflect.FieldNumber = 8
+	A_SFIXED32_field_number     protoreflect.FieldNumber = 9
+	A_FIXED32_field_number      protoreflect.FieldNumber = 10
+	A_FLOAT_field_number        protoreflect.FieldNumber = 11
+	A_SFIXED64_field_number     protoreflect.FieldNumber = 12
+	A_FIXED64_field_number      protoreflect.FieldNumber = 13
+	A_DOUBLE_field_number       protoreflect.FieldNumber = 14
+	A_STRING_field_number       protoreflect.FieldNumber = 15
+	A_BYTES_field_number        protoreflect.FieldNumber = 16
+	A_MESSAGE_field_number      protoreflect.FieldNumber = 17
+	A_MAP_field_number          protoreflect.FieldNumber = 18
+	A_LIST_field_number         protoreflect.FieldNumber = 19
+	A_ONEOF_B_field_number      protoreflect.FieldNumber = 20
+	A_ONEOF_STRING_field_number protoreflect.FieldNumber = 21
+	A_LIST_ENUM_field_number    protoreflect.FieldNumber = 22
+	A_Imported_field_number     protoreflect.FieldNumber = 23
+	A_Type__field_number        protoreflect.FieldNumber = 24
+)
+
+// Oneof names for A.
+const (
+	A_ONEOF_oneof_name protoreflect.Name = "ONEOF"
+
+	A_ONEOF_oneof_fullname protoreflect.FullName = "A.ONEOF"
+)
+
+// Names for B.
+const (
+	B_message_name     protoreflect.Name     = "B"
+	B_message_fullname protoreflect.FullName = "B"
+)
+
+// Field names for B.
+const (
+	B_X_field_name protoreflect.Name = "x"
+
+	B_X_field_fullname protoreflect.FullName = "B.x"
+)
+
+// Field numbers for B.
+const (
+	B_X_field_number protoreflect.FieldNumber = 1
+)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
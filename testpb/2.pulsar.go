@@ -281,7 +281,13 @@ func init() {
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
-		dAtA := make([]byte, size)
+		var dAtA []byte
+		inPlace := input.Buf != nil && cap(input.Buf)-len(input.Buf) >= size
+		if inPlace {
+			dAtA = input.Buf[len(input.Buf) : len(input.Buf)+size]
+		} else {
+			dAtA = make([]byte, size)
+		}
 		i := len(dAtA)
 		_ = i
 		var l int
@@ -290,7 +296,9 @@ func init() {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if input.Buf != nil {
+		if inPlace {
+			input.Buf = input.Buf[:len(input.Buf)+size]
+		} else if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
 			input.Buf = dAtA
@@ -377,6 +385,12 @@ func init() {
 	}
 }
 
+// Names for ImportedMessage.
+const (
+	ImportedMessage_message_name     protoreflect.Name     = "ImportedMessage"
+	ImportedMessage_message_fullname protoreflect.FullName = "ImportedMessage"
+)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
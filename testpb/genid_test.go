@@ -0,0 +1,39 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestGenid(t *testing.T) {
+	md := (&A{}).ProtoReflect().Descriptor()
+	require.Equal(t, md.Name(), A_message_name)
+	require.Equal(t, md.FullName(), A_message_fullname)
+
+	for _, c := range []struct {
+		name     protoreflect.Name
+		fullName protoreflect.FullName
+		number   protoreflect.FieldNumber
+	}{
+		{A_Enum_field_name, A_Enum_field_fullname, A_Enum_field_number},
+		{A_MAP_field_name, A_MAP_field_fullname, A_MAP_field_number},
+		{A_ONEOF_B_field_name, A_ONEOF_B_field_fullname, A_ONEOF_B_field_number},
+		{A_Type__field_name, A_Type__field_fullname, A_Type__field_number},
+	} {
+		fd := md.Fields().ByName(c.name)
+		require.NotNil(t, fd, c.name)
+		require.Equal(t, c.fullName, fd.FullName())
+		require.Equal(t, c.number, fd.Number())
+	}
+
+	od := md.Oneofs().ByName(A_ONEOF_oneof_name)
+	require.NotNil(t, od)
+	require.Equal(t, A_ONEOF_oneof_fullname, od.FullName())
+
+	ed := Enumeration(0).Descriptor()
+	require.Equal(t, string(ed.Name()), Enumeration_enum_name)
+	require.Equal(t, string(ed.FullName()), Enumeration_enum_fullname)
+	require.Equal(t, (&ImportedMessage{}).ProtoReflect().Descriptor().FullName(), ImportedMessage_message_fullname)
+}
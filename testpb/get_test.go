@@ -422,3 +422,40 @@ func TestGetoneof(t *testing.T) {
 	v = x.ProtoReflect().Get(oneOf2)
 	t.Logf("%v", v.Message().IsValid()) // nil object, is valid
 }
+
+func TestGetDoesNotAllocate(t *testing.T) {
+	msg := &A{
+		MESSAGE:   &B{X: "message"},
+		MAP:       map[string]*B{"a": {X: "a"}},
+		LIST:      []*B{{X: "list"}},
+		LIST_ENUM: []Enumeration{Enumeration_One},
+	}
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	key := protoreflect.ValueOfString("a").MapKey()
+
+	for _, name := range []protoreflect.Name{"MESSAGE", "MAP", "LIST", "LIST_ENUM"} {
+		fd := fields.ByName(name)
+		allocs := testing.AllocsPerRun(100, func() {
+			v := msg.ProtoReflect().Get(fd)
+			switch {
+			case fd.IsMap():
+				_ = v.Map().Get(key)
+			case fd.IsList():
+				_ = v.List().Get(0)
+			default:
+				_ = v.Message().Get(fd.Message().Fields().ByName("x"))
+			}
+		})
+		require.Zero(t, allocs, name)
+	}
+
+	// empty fields return invalid lists and maps without allocating either
+	empty := &A{}
+	for _, name := range []protoreflect.Name{"MAP", "LIST"} {
+		fd := fields.ByName(name)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = empty.ProtoReflect().Get(fd)
+		})
+		require.Zero(t, allocs, name)
+	}
+}
@@ -37,6 +37,7 @@ func newSlowList() protoreflect.List {
 func Benchmark_List_Get_FR(b *testing.B) {
 	list := newFastList()
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -47,6 +48,7 @@ func Benchmark_List_Get_FR(b *testing.B) {
 func Benchmark_List_Get_SR(b *testing.B) {
 	list := newSlowList()
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -54,6 +56,30 @@ func Benchmark_List_Get_SR(b *testing.B) {
 	}
 }
 
+func Benchmark_List_GetField_FR(b *testing.B) {
+	msg := &A{LIST: []*B{{X: "test"}}}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("LIST")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = msg.ProtoReflect().Get(fd).List().Get(0)
+	}
+}
+
+func Benchmark_List_GetField_SR(b *testing.B) {
+	msg := &A{LIST: []*B{{X: "test"}}}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("LIST")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = msg.slowProtoReflect().Get(fd).List().Get(0)
+	}
+}
+
 func Benchmark_List_Append_FR(b *testing.B) {
 	list := newFastList()
 
@@ -26,6 +26,7 @@ func Benchmark_Map_Get_FR(b *testing.B) {
 	m := newFastMap()
 	key := (protoreflect.MapKey)(protoreflect.ValueOfString("1"))
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -37,6 +38,7 @@ func Benchmark_Map_Get_SR(b *testing.B) {
 	m := newSlowMap()
 	key := (protoreflect.MapKey)(protoreflect.ValueOfString("1"))
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -44,6 +46,32 @@ func Benchmark_Map_Get_SR(b *testing.B) {
 	}
 }
 
+func Benchmark_Map_GetField_FR(b *testing.B) {
+	msg := &A{MAP: map[string]*B{"1": {X: "a"}}}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("MAP")
+	key := (protoreflect.MapKey)(protoreflect.ValueOfString("1"))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = msg.ProtoReflect().Get(fd).Map().Get(key)
+	}
+}
+
+func Benchmark_Map_GetField_SR(b *testing.B) {
+	msg := &A{MAP: map[string]*B{"1": {X: "a"}}}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("MAP")
+	key := (protoreflect.MapKey)(protoreflect.ValueOfString("1"))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = msg.slowProtoReflect().Get(fd).Map().Get(key)
+	}
+}
+
 func Benchmark_Map_Has_FR(b *testing.B) {
 	m := newFastMap()
 	key := (protoreflect.MapKey)(protoreflect.ValueOfString("1"))
@@ -15,6 +15,7 @@ func Benchmark_Get_FR(b *testing.B) {
 
 	fd := msg.ProtoReflect().Descriptor().Fields().ByName("LIST")
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -31,6 +32,7 @@ func Benchmark_Get_SR(b *testing.B) {
 
 	fd := msg.ProtoReflect().Descriptor().Fields().ByName("LIST")
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -38,6 +40,30 @@ func Benchmark_Get_SR(b *testing.B) {
 	}
 }
 
+func Benchmark_Get_Message_FR(b *testing.B) {
+	msg := &A{MESSAGE: &B{X: "test"}}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("MESSAGE")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = msg.ProtoReflect().Get(fd).Message()
+	}
+}
+
+func Benchmark_Get_Message_SR(b *testing.B) {
+	msg := &A{MESSAGE: &B{X: "test"}}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("MESSAGE")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = msg.slowProtoReflect().Get(fd).Message()
+	}
+}
+
 func Benchmark_WhichOneof_FR(b *testing.B) {
 	msg := &A{ONEOF: &A_ONEOF_B{}}
 